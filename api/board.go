@@ -61,3 +61,123 @@ var GetGroupIssueBoardLists = func(client *gitlab.Client, groupID interface{}, b
 
 	return boardLists, nil
 }
+
+var UpdateIssueBoard = func(client *gitlab.Client, projectID interface{}, boardID int, opts *gitlab.UpdateIssueBoardOptions) (*gitlab.IssueBoard, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	board, _, err := client.Boards.UpdateIssueBoard(projectID, boardID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return board, nil
+}
+
+var UpdateGroupIssueBoard = func(client *gitlab.Client, groupID interface{}, boardID int, opts *gitlab.UpdateGroupIssueBoardOptions) (*gitlab.GroupIssueBoard, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	board, _, err := client.GroupIssueBoards.UpdateIssueBoard(groupID, boardID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return board, nil
+}
+
+var DeleteIssueBoard = func(client *gitlab.Client, projectID interface{}, boardID int) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	_, err := client.Boards.DeleteIssueBoard(projectID, boardID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var DeleteGroupIssueBoard = func(client *gitlab.Client, groupID interface{}, boardID int) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	_, err := client.GroupIssueBoards.DeleteIssueBoard(groupID, boardID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var CreateIssueBoardList = func(client *gitlab.Client, projectID interface{}, boardID int, opts *gitlab.CreateIssueBoardListOptions) (*gitlab.BoardList, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	boardList, _, err := client.Boards.CreateIssueBoardList(projectID, boardID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return boardList, nil
+}
+
+var CreateGroupIssueBoardList = func(client *gitlab.Client, groupID interface{}, boardID int, opts *gitlab.CreateGroupIssueBoardListOptions) (*gitlab.BoardList, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	boardList, _, err := client.GroupIssueBoards.CreateGroupIssueBoardList(groupID, boardID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return boardList, nil
+}
+
+var UpdateIssueBoardList = func(client *gitlab.Client, projectID interface{}, boardID, listID int, opts *gitlab.UpdateIssueBoardListOptions) (*gitlab.BoardList, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	boardList, _, err := client.Boards.UpdateIssueBoardList(projectID, boardID, listID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return boardList, nil
+}
+
+var UpdateGroupIssueBoardList = func(client *gitlab.Client, groupID interface{}, boardID, listID int, opts *gitlab.UpdateGroupIssueBoardListOptions) ([]*gitlab.BoardList, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	boardLists, _, err := client.GroupIssueBoards.UpdateIssueBoardList(groupID, boardID, listID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return boardLists, nil
+}
+
+var DeleteIssueBoardList = func(client *gitlab.Client, projectID interface{}, boardID, listID int) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	_, err := client.Boards.DeleteIssueBoardList(projectID, boardID, listID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var DeleteGroupIssueBoardList = func(client *gitlab.Client, groupID interface{}, boardID, listID int) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	_, err := client.GroupIssueBoards.DeleteGroupIssueBoardList(groupID, boardID, listID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -3,12 +3,12 @@ package api
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
 	"net/url"
-	"os"
 	"strconv"
 	"strings"
 	"time"
@@ -188,16 +188,10 @@ func NewClientWithCustomCA(host, token, caFile string, isGraphQL bool, isOAuth2
 	apiClient.isOauth2 = isOAuth2
 
 	if apiClient.httpClientOverride == nil {
-		caCert, err := os.ReadFile(apiClient.caFile)
-		if err != nil {
-			return nil, fmt.Errorf("error reading cert file: %w", err)
-		}
-		// use system cert pool as a baseline
-		caCertPool, err := x509.SystemCertPool()
+		caCertPool, err := LoadCACertificate(apiClient.caFile)
 		if err != nil {
 			return nil, err
 		}
-		caCertPool.AppendCertsFromPEM(caCert)
 
 		apiClient.httpClient = &http.Client{
 			Transport: &http.Transport{
@@ -223,7 +217,8 @@ func NewClientWithCustomCA(host, token, caFile string, isGraphQL bool, isOAuth2
 	return apiClient, err
 }
 
-// NewClientWithCustomCAClientCert initializes the global api client with a self-signed certificate and client certificates
+// NewClientWithCustomCAClientCert initializes the global api client with a self-signed certificate and client certificates.
+// Without a caFile, the system certificates are used.
 func NewClientWithCustomCAClientCert(host, token, caFile string, certFile string, keyFile string, isGraphQL bool, isOAuth2 bool) (*Client, error) {
 	apiClient.host = host
 	apiClient.token = token
@@ -232,18 +227,17 @@ func NewClientWithCustomCAClientCert(host, token, caFile string, certFile string
 	apiClient.isOauth2 = isOAuth2
 
 	if apiClient.httpClientOverride == nil {
-		caCert, err := os.ReadFile(apiClient.caFile)
-		if err != nil {
-			return nil, fmt.Errorf("error reading cert file: %w", err)
-		}
-		// use system cert pool as a baseline
-		caCertPool, err := x509.SystemCertPool()
-		if err != nil {
-			return nil, err
+		// without a CA certificate, the system cert pool is used
+		var caCertPool *x509.CertPool
+		if apiClient.caFile != "" {
+			var err error
+			caCertPool, err = LoadCACertificate(apiClient.caFile)
+			if err != nil {
+				return nil, err
+			}
 		}
-		caCertPool.AppendCertsFromPEM(caCert)
 
-		clientCert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		clientCert, err := LoadClientCertificate(certFile, keyFile)
 		if err != nil {
 			return nil, err
 		}
@@ -289,6 +283,8 @@ func NewClientWithCfg(repoHost string, cfg config.Config, isGraphQL bool) (clien
 	apiProtocol, _ := cfg.Get(repoHost, "api_protocol")
 	if apiProtocol != "" {
 		SetProtocol(apiProtocol)
+	} else {
+		apiProtocol = "https"
 	}
 
 	isOAuth2Cfg, _ := cfg.Get(repoHost, "is_oauth2")
@@ -301,7 +297,12 @@ func NewClientWithCfg(repoHost string, cfg config.Config, isGraphQL bool) (clien
 		}
 	}
 
-	token, _ := cfg.Get(repoHost, "token")
+	token, err := cfg.Get(repoHost, "token")
+	var accountErr *config.AccountNotFoundError
+	var storeErr *config.CredentialStoreError
+	if errors.As(err, &accountErr) || errors.As(err, &storeErr) {
+		return nil, err
+	}
 	jobToken, _ := cfg.Get(repoHost, "job_token")
 	tlsVerify, _ := cfg.Get(repoHost, "skip_tls_verify")
 	skipTlsVerify := tlsVerify == "true" || tlsVerify == "1"
@@ -309,7 +310,14 @@ func NewClientWithCfg(repoHost string, cfg config.Config, isGraphQL bool) (clien
 	clientCert, _ := cfg.Get(repoHost, "client_cert")
 	keyFile, _ := cfg.Get(repoHost, "client_key")
 
-	if caCert != "" && clientCert != "" && keyFile != "" {
+	proxy, _, _ := cfg.GetWithSource(repoHost, "proxy", false)
+	noProxy, _, _ := cfg.GetWithSource(repoHost, "no_proxy", false)
+	proxyURL, err := proxyFunc(proxy, noProxy)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy for %s: %w", repoHost, err)
+	}
+
+	if clientCert != "" && keyFile != "" {
 		client, err = NewClientWithCustomCAClientCert(apiHost, token, caCert, clientCert, keyFile, isGraphQL, isOAuth2)
 	} else if caCert != "" {
 		client, err = NewClientWithCustomCA(apiHost, token, caCert, isGraphQL, isOAuth2)
@@ -318,6 +326,25 @@ func NewClientWithCfg(repoHost string, cfg config.Config, isGraphQL bool) (clien
 	} else {
 		client, err = NewClient(apiHost, token, skipTlsVerify, isGraphQL, isOAuth2, false)
 	}
+	if err == nil && client.httpClientOverride == nil {
+		if transport, ok := client.httpClient.Transport.(*http.Transport); ok {
+			transport.Proxy = proxyURL
+		}
+		client.httpClient.Transport = &tlsErrorTransport{
+			base:     client.httpClient.Transport,
+			host:     apiHost,
+			caFile:   caCert,
+			certFile: clientCert,
+		}
+	}
+	if err == nil && isOAuth2 && client.httpClientOverride == nil {
+		// refresh the token during long-running commands, and after a 401
+		transport, err := oauth2.NewTransport(client.httpClient.Transport, repoHost, apiProtocol, cfg)
+		if err != nil {
+			return nil, err
+		}
+		client.httpClient.Transport = transport
+	}
 	return
 }
 
@@ -0,0 +1,104 @@
+package api
+
+import gitlab "gitlab.com/gitlab-org/api/client-go"
+
+var ListGroupEpics = func(client *gitlab.Client, groupID interface{}, opts *gitlab.ListGroupEpicsOptions) ([]*gitlab.Epic, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	if opts.PerPage == 0 {
+		opts.PerPage = DefaultListLimit
+	}
+
+	epics, _, err := client.Epics.ListGroupEpics(groupID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return epics, nil
+}
+
+var GetEpic = func(client *gitlab.Client, groupID interface{}, epicIID int) (*gitlab.Epic, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	epic, _, err := client.Epics.GetEpic(groupID, epicIID)
+	if err != nil {
+		return nil, err
+	}
+	return epic, nil
+}
+
+var CreateEpic = func(client *gitlab.Client, groupID interface{}, opts *gitlab.CreateEpicOptions) (*gitlab.Epic, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	epic, _, err := client.Epics.CreateEpic(groupID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return epic, nil
+}
+
+// ListEpicChildren returns the child epics of an epic.
+var ListEpicChildren = func(client *gitlab.Client, groupID interface{}, epicIID int) ([]*gitlab.Epic, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	epics, _, err := client.Epics.GetEpicLinks(groupID, epicIID)
+	if err != nil {
+		return nil, err
+	}
+	return epics, nil
+}
+
+// ListEpicIssues returns all the issues of an epic.
+var ListEpicIssues = func(client *gitlab.Client, groupID interface{}, epicIID int) ([]*gitlab.Issue, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	opts := &gitlab.ListOptions{PerPage: 100}
+	issues := make([]*gitlab.Issue, 0, opts.PerPage)
+	for {
+		results, response, err := client.EpicIssues.ListEpicIssues(groupID, epicIID, opts)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, results...)
+
+		if response.NextPage == 0 {
+			break
+		}
+		opts.Page = response.NextPage
+	}
+
+	return issues, nil
+}
+
+var AssignEpicIssue = func(client *gitlab.Client, groupID interface{}, epicIID, issueID int) (*gitlab.EpicIssueAssignment, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	assignment, _, err := client.EpicIssues.AssignEpicIssue(groupID, epicIID, issueID)
+	if err != nil {
+		return nil, err
+	}
+	return assignment, nil
+}
+
+var RemoveEpicIssue = func(client *gitlab.Client, groupID interface{}, epicIID, epicIssueID int) (*gitlab.EpicIssueAssignment, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	assignment, _, err := client.EpicIssues.RemoveEpicIssue(groupID, epicIID, epicIssueID)
+	if err != nil {
+		return nil, err
+	}
+	return assignment, nil
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/pkg/glinstance"
+)
+
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// graphQL runs a GraphQL query with the REST client, which holds the host and
+// the credentials, and decodes the data of the response into data.
+func graphQL(client *gitlab.Client, query string, variables map[string]interface{}, data interface{}) error {
+	req, err := client.NewRequest(http.MethodPost, "", map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	endpoint, err := url.Parse(glinstance.GraphQLEndpoint(client.BaseURL().Host, client.BaseURL().Scheme))
+	if err != nil {
+		return err
+	}
+	req.URL = endpoint
+
+	var resp graphQLResponse
+	if _, err := client.Do(req, &resp); err != nil {
+		return err
+	}
+
+	if len(resp.Errors) > 0 {
+		messages := make([]string, 0, len(resp.Errors))
+		for _, e := range resp.Errors {
+			messages = append(messages, e.Message)
+		}
+		return errors.New(strings.Join(messages, "; "))
+	}
+
+	return json.Unmarshal(resp.Data, data)
+}
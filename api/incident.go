@@ -0,0 +1,249 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// Incident is an incident with the incident management fields that only the GraphQL API returns.
+type Incident struct {
+	IID              string    `json:"iid"`
+	Title            string    `json:"title"`
+	Severity         string    `json:"severity"`
+	EscalationStatus string    `json:"escalationStatus"`
+	CreatedAt        time.Time `json:"createdAt"`
+	WebURL           string    `json:"webUrl"`
+	Assignees        struct {
+		Nodes []struct {
+			Username string `json:"username"`
+		} `json:"nodes"`
+	} `json:"assignees"`
+}
+
+// IncidentTimelineEvent is an event of the timeline of an incident.
+type IncidentTimelineEvent struct {
+	ID         string    `json:"id"`
+	Note       string    `json:"note"`
+	Action     string    `json:"action"`
+	OccurredAt time.Time `json:"occurredAt"`
+	CreatedAt  time.Time `json:"createdAt"`
+	Author     struct {
+		Username string `json:"username"`
+	} `json:"author"`
+	TimelineEventTags struct {
+		Nodes []struct {
+			Name string `json:"name"`
+		} `json:"nodes"`
+	} `json:"timelineEventTags"`
+}
+
+// Tags returns the names of the tags of the event.
+func (e *IncidentTimelineEvent) Tags() []string {
+	tags := make([]string, 0, len(e.TimelineEventTags.Nodes))
+	for _, t := range e.TimelineEventTags.Nodes {
+		tags = append(tags, t.Name)
+	}
+	return tags
+}
+
+// CreateIncidentTimelineEventOptions are the options to add an event to the timeline of an incident.
+type CreateIncidentTimelineEventOptions struct {
+	Note       string
+	OccurredAt time.Time
+	Tags       []string
+}
+
+// mutationErrors returns the errors of the payload of a GraphQL mutation, which
+// are returned as data instead of GraphQL errors.
+func mutationErrors(errs []string) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(errs, "; "))
+}
+
+// issueGlobalID returns the GraphQL ID of an issue, from its ID.
+func issueGlobalID(issueID int) string {
+	return fmt.Sprintf("gid://gitlab/Issue/%d", issueID)
+}
+
+const setIncidentSeverityMutation = `mutation($projectPath: ID!, $iid: String!, $severity: IssuableSeverity!) {
+  issueSetSeverity(input: {projectPath: $projectPath, iid: $iid, severity: $severity}) {
+    issue { severity }
+    errors
+  }
+}`
+
+// SetIncidentSeverity sets the severity of an incident: CRITICAL, HIGH, MEDIUM, LOW, or UNKNOWN.
+var SetIncidentSeverity = func(client *gitlab.Client, projectPath string, iid int, severity string) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	var data struct {
+		IssueSetSeverity struct {
+			Errors []string `json:"errors"`
+		} `json:"issueSetSeverity"`
+	}
+	err := graphQL(client, setIncidentSeverityMutation, map[string]interface{}{
+		"projectPath": projectPath,
+		"iid":         fmt.Sprint(iid),
+		"severity":    strings.ToUpper(severity),
+	}, &data)
+	if err != nil {
+		return err
+	}
+	return mutationErrors(data.IssueSetSeverity.Errors)
+}
+
+const setIncidentEscalationStatusMutation = `mutation($projectPath: ID!, $iid: String!, $status: IssueEscalationStatus!) {
+  issueSetEscalationStatus(input: {projectPath: $projectPath, iid: $iid, status: $status}) {
+    issue { escalationStatus }
+    errors
+  }
+}`
+
+// SetIncidentEscalationStatus sets the escalation status of an incident: TRIGGERED,
+// ACKNOWLEDGED, RESOLVED, or IGNORED.
+var SetIncidentEscalationStatus = func(client *gitlab.Client, projectPath string, iid int, status string) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	var data struct {
+		IssueSetEscalationStatus struct {
+			Errors []string `json:"errors"`
+		} `json:"issueSetEscalationStatus"`
+	}
+	err := graphQL(client, setIncidentEscalationStatusMutation, map[string]interface{}{
+		"projectPath": projectPath,
+		"iid":         fmt.Sprint(iid),
+		"status":      strings.ToUpper(status),
+	}, &data)
+	if err != nil {
+		return err
+	}
+	return mutationErrors(data.IssueSetEscalationStatus.Errors)
+}
+
+const incidentTimelineEventFields = `id note action occurredAt createdAt author { username } timelineEventTags { nodes { name } }`
+
+const createIncidentTimelineEventMutation = `mutation($incidentId: IssueID!, $note: String!, $occurredAt: Time!, $tags: [String!]) {
+  timelineEventCreate(input: {incidentId: $incidentId, note: $note, occurredAt: $occurredAt, timelineEventTagNames: $tags}) {
+    timelineEvent { ` + incidentTimelineEventFields + ` }
+    errors
+  }
+}`
+
+// CreateIncidentTimelineEvent adds an event to the timeline of an incident, by the ID of the incident.
+var CreateIncidentTimelineEvent = func(client *gitlab.Client, incidentID int, opts *CreateIncidentTimelineEventOptions) (*IncidentTimelineEvent, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	variables := map[string]interface{}{
+		"incidentId": issueGlobalID(incidentID),
+		"note":       opts.Note,
+		"occurredAt": opts.OccurredAt.Format(time.RFC3339),
+	}
+	if len(opts.Tags) > 0 {
+		variables["tags"] = opts.Tags
+	}
+
+	var data struct {
+		TimelineEventCreate struct {
+			TimelineEvent *IncidentTimelineEvent `json:"timelineEvent"`
+			Errors        []string               `json:"errors"`
+		} `json:"timelineEventCreate"`
+	}
+	if err := graphQL(client, createIncidentTimelineEventMutation, variables, &data); err != nil {
+		return nil, err
+	}
+	if err := mutationErrors(data.TimelineEventCreate.Errors); err != nil {
+		return nil, err
+	}
+	return data.TimelineEventCreate.TimelineEvent, nil
+}
+
+const listIncidentTimelineEventsQuery = `query($fullPath: ID!, $incidentId: IssueID!) {
+  project(fullPath: $fullPath) {
+    incidentManagementTimelineEvents(incidentId: $incidentId) {
+      nodes { ` + incidentTimelineEventFields + ` }
+    }
+  }
+}`
+
+// ListIncidentTimelineEvents returns the events of the timeline of an incident, by the ID of the incident.
+var ListIncidentTimelineEvents = func(client *gitlab.Client, projectPath string, incidentID int) ([]*IncidentTimelineEvent, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	var data struct {
+		Project *struct {
+			IncidentManagementTimelineEvents struct {
+				Nodes []*IncidentTimelineEvent `json:"nodes"`
+			} `json:"incidentManagementTimelineEvents"`
+		} `json:"project"`
+	}
+	err := graphQL(client, listIncidentTimelineEventsQuery, map[string]interface{}{
+		"fullPath":   projectPath,
+		"incidentId": issueGlobalID(incidentID),
+	}, &data)
+	if err != nil {
+		return nil, err
+	}
+	if data.Project == nil {
+		return nil, fmt.Errorf("project %q not found.", projectPath)
+	}
+	return data.Project.IncidentManagementTimelineEvents.Nodes, nil
+}
+
+const listOpenIncidentsQuery = `query($fullPath: ID!, $after: String) {
+  project(fullPath: $fullPath) {
+    issues(types: [INCIDENT], state: opened, first: 100, after: $after) {
+      nodes { iid title severity escalationStatus createdAt webUrl assignees { nodes { username } } }
+      pageInfo { hasNextPage endCursor }
+    }
+  }
+}`
+
+// ListOpenIncidents returns the open incidents of a project, with their severity and escalation status.
+var ListOpenIncidents = func(client *gitlab.Client, projectPath string) ([]*Incident, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	variables := map[string]interface{}{"fullPath": projectPath}
+	var incidents []*Incident
+	for {
+		var data struct {
+			Project *struct {
+				Issues struct {
+					Nodes    []*Incident `json:"nodes"`
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+				} `json:"issues"`
+			} `json:"project"`
+		}
+		if err := graphQL(client, listOpenIncidentsQuery, variables, &data); err != nil {
+			return nil, err
+		}
+		if data.Project == nil {
+			return nil, fmt.Errorf("project %q not found.", projectPath)
+		}
+
+		page := data.Project.Issues
+		incidents = append(incidents, page.Nodes...)
+		if !page.PageInfo.HasNextPage {
+			return incidents, nil
+		}
+		variables["after"] = page.PageInfo.EndCursor
+	}
+}
@@ -0,0 +1,81 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+)
+
+func TestSetIncidentSeverity(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		wantErr  string
+	}{
+		{
+			name:     "severity set",
+			response: `{"data": {"issueSetSeverity": {"issue": {"severity": "CRITICAL"}, "errors": []}}}`,
+		},
+		{
+			name:     "mutation errors",
+			response: `{"data": {"issueSetSeverity": {"issue": null, "errors": ["Severity is not supported for this issue"]}}}`,
+			wantErr:  "Severity is not supported for this issue",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.HostAndPath}
+			defer fakeHTTP.Verify(t)
+			fakeHTTP.RegisterResponderWithBody(http.MethodPost, "/api/graphql/",
+				`{"query": `+jsonString(setIncidentSeverityMutation)+`, "variables": {"projectPath": "OWNER/REPO", "iid": "12", "severity": "CRITICAL"}}`,
+				httpmock.NewStringResponse(http.StatusOK, tc.response))
+
+			client, err := gitlab.NewClient("token",
+				gitlab.WithHTTPClient(&http.Client{Transport: fakeHTTP}),
+				gitlab.WithBaseURL("https://gitlab.com/api/v4"))
+			require.NoError(t, err)
+
+			err = SetIncidentSeverity(client, "OWNER/REPO", 12, "critical")
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestListIncidentTimelineEvents(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.HostAndPath}
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponderWithBody(http.MethodPost, "/api/graphql/",
+		`{"query": `+jsonString(listIncidentTimelineEventsQuery)+`, "variables": {"fullPath": "OWNER/REPO", "incidentId": "gid://gitlab/Issue/4567"}}`,
+		httpmock.NewStringResponse(http.StatusOK, `{"data": {"project": {"incidentManagementTimelineEvents": {"nodes": [
+			{"id": "gid://gitlab/IncidentManagement::TimelineEvent/1", "note": "Alert fired", "action": "comment", "occurredAt": "2025-06-20T09:42:00Z", "createdAt": "2025-06-20T09:50:00Z", "author": {"username": "alice"}, "timelineEventTags": {"nodes": [{"name": "Impact detected"}]}}
+		]}}}}`))
+
+	client, err := gitlab.NewClient("token",
+		gitlab.WithHTTPClient(&http.Client{Transport: fakeHTTP}),
+		gitlab.WithBaseURL("https://gitlab.com/api/v4"))
+	require.NoError(t, err)
+
+	events, err := ListIncidentTimelineEvents(client, "OWNER/REPO", 4567)
+	require.NoError(t, err)
+
+	require.Len(t, events, 1)
+	assert.Equal(t, "Alert fired", events[0].Note)
+	assert.Equal(t, "alice", events[0].Author.Username)
+	assert.Equal(t, []string{"Impact detected"}, events[0].Tags())
+}
+
+func jsonString(s string) string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
@@ -71,6 +71,7 @@ var ProjectListIssueOptionsToGroup = func(l *gitlab.ListProjectIssuesOptions) *g
 		UpdatedAfter:       l.UpdatedAfter,
 		UpdatedBefore:      l.UpdatedBefore,
 		IssueType:          l.IssueType,
+		IterationID:        l.IterationID,
 	}
 }
 
@@ -193,6 +194,28 @@ var LinkIssues = func(client *gitlab.Client, projectID interface{}, issueIDD int
 	return issueLink.SourceIssue, issueLink.TargetIssue, nil
 }
 
+var ListIssueRelations = func(client *gitlab.Client, projectID interface{}, issueIID int) ([]*gitlab.IssueRelation, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	relations, _, err := client.IssueLinks.ListIssueRelations(projectID, issueIID)
+	if err != nil {
+		return nil, err
+	}
+
+	return relations, nil
+}
+
+var DeleteIssueLink = func(client *gitlab.Client, projectID interface{}, issueIID, issueLinkID int) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	_, _, err := client.IssueLinks.DeleteIssueLink(projectID, issueIID, issueLinkID)
+	return err
+}
+
 var SetIssueTimeEstimate = func(client *gitlab.Client, projectID interface{}, issueIDD int, opts *gitlab.SetTimeEstimateOptions) (*gitlab.TimeStats, error) {
 	if client == nil {
 		client = apiClient.Lab()
@@ -218,3 +241,29 @@ var AddIssueTimeSpent = func(client *gitlab.Client, projectID interface{}, issue
 
 	return timeStats, nil
 }
+
+var ResetIssueTimeEstimate = func(client *gitlab.Client, projectID interface{}, issueIID int) (*gitlab.TimeStats, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	timeStats, _, err := client.Issues.ResetTimeEstimate(projectID, issueIID)
+	if err != nil {
+		return nil, err
+	}
+
+	return timeStats, nil
+}
+
+var ResetIssueTimeSpent = func(client *gitlab.Client, projectID interface{}, issueIID int) (*gitlab.TimeStats, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	timeStats, _, err := client.Issues.ResetSpentTime(projectID, issueIID)
+	if err != nil {
+		return nil, err
+	}
+
+	return timeStats, nil
+}
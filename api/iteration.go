@@ -0,0 +1,89 @@
+package api
+
+import (
+	"fmt"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// Iteration states, as returned by the API.
+const (
+	IterationStateUpcoming = 1
+	IterationStateCurrent  = 2
+	IterationStateClosed   = 3
+)
+
+// IterationCadence is a cadence of automatic or manual iterations of a group.
+type IterationCadence struct {
+	ID                  string `json:"id"`
+	Title               string `json:"title"`
+	Active              bool   `json:"active"`
+	Automatic           bool   `json:"automatic"`
+	DurationInWeeks     int    `json:"durationInWeeks"`
+	IterationsInAdvance int    `json:"iterationsInAdvance"`
+	StartDate           string `json:"startDate"`
+}
+
+var ListGroupIterations = func(client *gitlab.Client, groupID interface{}, opts *gitlab.ListGroupIterationsOptions) ([]*gitlab.GroupIteration, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	if opts.PerPage == 0 {
+		opts.PerPage = DefaultListLimit
+	}
+
+	iterations, _, err := client.GroupIterations.ListGroupIterations(groupID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return iterations, nil
+}
+
+var ListProjectIterations = func(client *gitlab.Client, projectID interface{}, opts *gitlab.ListProjectIterationsOptions) ([]*gitlab.ProjectIteration, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	if opts.PerPage == 0 {
+		opts.PerPage = DefaultListLimit
+	}
+
+	iterations, _, err := client.ProjectIterations.ListProjectIterations(projectID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return iterations, nil
+}
+
+const iterationCadencesQuery = `query($fullPath: ID!) {
+  group(fullPath: $fullPath) {
+    iterationCadences(includeAncestorGroups: true) {
+      nodes { id title active automatic durationInWeeks iterationsInAdvance startDate }
+    }
+  }
+}`
+
+// ListGroupIterationCadences returns the iteration cadences of a group and of its ancestors.
+// The REST API has no endpoint for cadences, so they come from the GraphQL API.
+var ListGroupIterationCadences = func(client *gitlab.Client, groupPath string) ([]*IterationCadence, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	var data struct {
+		Group *struct {
+			IterationCadences struct {
+				Nodes []*IterationCadence `json:"nodes"`
+			} `json:"iterationCadences"`
+		} `json:"group"`
+	}
+	err := graphQL(client, iterationCadencesQuery, map[string]interface{}{"fullPath": groupPath}, &data)
+	if err != nil {
+		return nil, err
+	}
+	if data.Group == nil {
+		return nil, fmt.Errorf("group %q not found.", groupPath)
+	}
+	return data.Group.IterationCadences.Nodes, nil
+}
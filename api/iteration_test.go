@@ -0,0 +1,69 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+)
+
+func TestListGroupIterationCadences(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		want     []*IterationCadence
+		wantErr  string
+	}{
+		{
+			name: "cadences",
+			response: `{"data": {"group": {"iterationCadences": {"nodes": [
+				{"id": "gid://gitlab/Iterations::Cadence/1", "title": "Sprints", "active": true, "automatic": true, "durationInWeeks": 2, "iterationsInAdvance": 3, "startDate": "2025-01-06"}
+			]}}}}`,
+			want: []*IterationCadence{{
+				ID:                  "gid://gitlab/Iterations::Cadence/1",
+				Title:               "Sprints",
+				Active:              true,
+				Automatic:           true,
+				DurationInWeeks:     2,
+				IterationsInAdvance: 3,
+				StartDate:           "2025-01-06",
+			}},
+		},
+		{
+			name:     "unknown group",
+			response: `{"data": {"group": null}}`,
+			wantErr:  `group "my-group" not found.`,
+		},
+		{
+			name:     "GraphQL errors",
+			response: `{"errors": [{"message": "forbidden"}, {"message": "try again"}]}`,
+			wantErr:  "forbidden; try again",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.HostAndPath}
+			defer fakeHTTP.Verify(t)
+			fakeHTTP.RegisterResponder(http.MethodPost, "https://gitlab.com/api/graphql/",
+				httpmock.NewStringResponse(http.StatusOK, tc.response))
+
+			client, err := gitlab.NewClient("token",
+				gitlab.WithHTTPClient(&http.Client{Transport: fakeHTTP}),
+				gitlab.WithBaseURL("https://gitlab.com/api/v4"))
+			require.NoError(t, err)
+
+			cadences, err := ListGroupIterationCadences(client, "my-group")
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, cadences)
+		})
+	}
+}
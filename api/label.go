@@ -68,3 +68,23 @@ var ListGroupLabels = func(client *gitlab.Client, groupID interface{}, opts *Lis
 	}
 	return labels, nil
 }
+
+var GetLabel = func(client *gitlab.Client, projectID interface{}, labelID interface{}) (*gitlab.Label, error) {
+	client = getClient(client)
+
+	label, _, err := client.Labels.GetLabel(projectID, labelID)
+	if err != nil {
+		return nil, err
+	}
+	return label, nil
+}
+
+var GetGroupLabel = func(client *gitlab.Client, groupID interface{}, labelID interface{}) (*gitlab.GroupLabel, error) {
+	client = getClient(client)
+
+	label, _, err := client.GroupLabels.GetGroupLabel(groupID, labelID)
+	if err != nil {
+		return nil, err
+	}
+	return label, nil
+}
@@ -409,6 +409,58 @@ var MRTodo = func(client *gitlab.Client, projectID interface{}, mrID int, opts g
 	return mr, nil
 }
 
+var SetMRTimeEstimate = func(client *gitlab.Client, projectID interface{}, mrID int, opts *gitlab.SetTimeEstimateOptions) (*gitlab.TimeStats, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	timeStats, _, err := client.MergeRequests.SetTimeEstimate(projectID, mrID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return timeStats, nil
+}
+
+var ResetMRTimeEstimate = func(client *gitlab.Client, projectID interface{}, mrID int) (*gitlab.TimeStats, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	timeStats, _, err := client.MergeRequests.ResetTimeEstimate(projectID, mrID)
+	if err != nil {
+		return nil, err
+	}
+
+	return timeStats, nil
+}
+
+var AddMRTimeSpent = func(client *gitlab.Client, projectID interface{}, mrID int, opts *gitlab.AddSpentTimeOptions) (*gitlab.TimeStats, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	timeStats, _, err := client.MergeRequests.AddSpentTime(projectID, mrID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return timeStats, nil
+}
+
+var ResetMRTimeSpent = func(client *gitlab.Client, projectID interface{}, mrID int) (*gitlab.TimeStats, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	timeStats, _, err := client.MergeRequests.ResetSpentTime(projectID, mrID)
+	if err != nil {
+		return nil, err
+	}
+
+	return timeStats, nil
+}
+
 type cliListMROptions struct {
 	assigneeIds []int
 	reviewerIds []int
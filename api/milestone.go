@@ -123,6 +123,29 @@ var ProjectMilestoneByTitle = func(client *gitlab.Client, projectID interface{},
 	return milestones[0], nil
 }
 
+var GroupMilestoneByTitle = func(client *gitlab.Client, groupID interface{}, name string) (*gitlab.GroupMilestone, error) {
+	opts := &gitlab.ListGroupMilestonesOptions{Title: gitlab.Ptr(name)}
+
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	if opts.PerPage == 0 {
+		opts.PerPage = DefaultListLimit
+	}
+
+	milestones, _, err := client.GroupMilestones.ListGroupMilestones(groupID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(milestones) != 1 {
+		return nil, fmt.Errorf("failed to find milestone by title: %s", name)
+	}
+
+	return milestones[0], nil
+}
+
 var ListAllMilestones = func(client *gitlab.Client, projectID interface{}, opts *ListMilestonesOptions) ([]*Milestone, error) {
 	project, err := GetProject(client, projectID)
 	if err != nil {
@@ -162,3 +185,171 @@ var ListAllMilestones = func(client *gitlab.Client, projectID interface{}, opts
 
 	return milestones, nil
 }
+
+var GetProjectMilestone = func(client *gitlab.Client, projectID interface{}, milestoneID int) (*gitlab.Milestone, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	milestone, _, err := client.Milestones.GetMilestone(projectID, milestoneID)
+	if err != nil {
+		return nil, err
+	}
+	return milestone, nil
+}
+
+var GetGroupMilestone = func(client *gitlab.Client, groupID interface{}, milestoneID int) (*gitlab.GroupMilestone, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	milestone, _, err := client.GroupMilestones.GetGroupMilestone(groupID, milestoneID)
+	if err != nil {
+		return nil, err
+	}
+	return milestone, nil
+}
+
+var CreateProjectMilestone = func(client *gitlab.Client, projectID interface{}, opts *gitlab.CreateMilestoneOptions) (*gitlab.Milestone, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	milestone, _, err := client.Milestones.CreateMilestone(projectID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return milestone, nil
+}
+
+var CreateGroupMilestone = func(client *gitlab.Client, groupID interface{}, opts *gitlab.CreateGroupMilestoneOptions) (*gitlab.GroupMilestone, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	milestone, _, err := client.GroupMilestones.CreateGroupMilestone(groupID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return milestone, nil
+}
+
+var UpdateProjectMilestone = func(client *gitlab.Client, projectID interface{}, milestoneID int, opts *gitlab.UpdateMilestoneOptions) (*gitlab.Milestone, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	milestone, _, err := client.Milestones.UpdateMilestone(projectID, milestoneID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return milestone, nil
+}
+
+var UpdateGroupMilestone = func(client *gitlab.Client, groupID interface{}, milestoneID int, opts *gitlab.UpdateGroupMilestoneOptions) (*gitlab.GroupMilestone, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	milestone, _, err := client.GroupMilestones.UpdateGroupMilestone(groupID, milestoneID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return milestone, nil
+}
+
+// ListProjectMilestoneIssues returns all the issues assigned to a project milestone.
+var ListProjectMilestoneIssues = func(client *gitlab.Client, projectID interface{}, milestoneID int) ([]*gitlab.Issue, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	opts := &gitlab.GetMilestoneIssuesOptions{PerPage: 100}
+	issues := make([]*gitlab.Issue, 0, opts.PerPage)
+	for {
+		results, response, err := client.Milestones.GetMilestoneIssues(projectID, milestoneID, opts)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, results...)
+
+		if response.NextPage == 0 {
+			break
+		}
+		opts.Page = response.NextPage
+	}
+
+	return issues, nil
+}
+
+// ListGroupMilestoneIssues returns all the issues assigned to a group milestone.
+var ListGroupMilestoneIssues = func(client *gitlab.Client, groupID interface{}, milestoneID int) ([]*gitlab.Issue, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	opts := &gitlab.GetGroupMilestoneIssuesOptions{PerPage: 100}
+	issues := make([]*gitlab.Issue, 0, opts.PerPage)
+	for {
+		results, response, err := client.GroupMilestones.GetGroupMilestoneIssues(groupID, milestoneID, opts)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, results...)
+
+		if response.NextPage == 0 {
+			break
+		}
+		opts.Page = response.NextPage
+	}
+
+	return issues, nil
+}
+
+// ListProjectMilestoneMergeRequests returns all the merge requests assigned to a project milestone.
+var ListProjectMilestoneMergeRequests = func(client *gitlab.Client, projectID interface{}, milestoneID int) ([]*gitlab.MergeRequest, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	opts := &gitlab.GetMilestoneMergeRequestsOptions{PerPage: 100}
+	mrs := make([]*gitlab.MergeRequest, 0, opts.PerPage)
+	for {
+		results, response, err := client.Milestones.GetMilestoneMergeRequests(projectID, milestoneID, opts)
+		if err != nil {
+			return nil, err
+		}
+		mrs = append(mrs, results...)
+
+		if response.NextPage == 0 {
+			break
+		}
+		opts.Page = response.NextPage
+	}
+
+	return mrs, nil
+}
+
+// ListGroupMilestoneMergeRequests returns all the merge requests assigned to a group milestone.
+var ListGroupMilestoneMergeRequests = func(client *gitlab.Client, groupID interface{}, milestoneID int) ([]*gitlab.MergeRequest, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	opts := &gitlab.GetGroupMilestoneMergeRequestsOptions{PerPage: 100}
+	mrs := make([]*gitlab.MergeRequest, 0, opts.PerPage)
+	for {
+		results, response, err := client.GroupMilestones.GetGroupMilestoneMergeRequests(groupID, milestoneID, opts)
+		if err != nil {
+			return nil, err
+		}
+		mrs = append(mrs, results...)
+
+		if response.NextPage == 0 {
+			break
+		}
+		opts.Page = response.NextPage
+	}
+
+	return mrs, nil
+}
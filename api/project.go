@@ -93,3 +93,99 @@ var ListProjectMembers = func(client *gitlab.Client, projectID interface{}, opts
 	}
 	return members, nil
 }
+
+var ListAllProjects = func(client *gitlab.Client, opts *gitlab.ListProjectsOptions) ([]*gitlab.Project, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	if opts.PerPage == 0 {
+		opts.PerPage = 100
+	}
+	projects := make([]*gitlab.Project, 0, opts.PerPage)
+	for {
+		results, response, err := client.Projects.ListProjects(opts)
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, results...)
+
+		if response.NextPage == 0 {
+			break
+		}
+		opts.Page = response.NextPage
+	}
+
+	return projects, nil
+}
+
+var ListAllGroups = func(client *gitlab.Client, opts *gitlab.ListGroupsOptions) ([]*gitlab.Group, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	if opts.PerPage == 0 {
+		opts.PerPage = 100
+	}
+	groups := make([]*gitlab.Group, 0, opts.PerPage)
+	for {
+		results, response, err := client.Groups.ListGroups(opts)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, results...)
+
+		if response.NextPage == 0 {
+			break
+		}
+		opts.Page = response.NextPage
+	}
+
+	return groups, nil
+}
+
+var ListAllDescendantGroups = func(client *gitlab.Client, groupID interface{}, opts *gitlab.ListDescendantGroupsOptions) ([]*gitlab.Group, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	if opts.PerPage == 0 {
+		opts.PerPage = 100
+	}
+	groups := make([]*gitlab.Group, 0, opts.PerPage)
+	for {
+		results, response, err := client.Groups.ListDescendantGroups(groupID, opts)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, results...)
+
+		if response.NextPage == 0 {
+			break
+		}
+		opts.Page = response.NextPage
+	}
+
+	return groups, nil
+}
+
+var ListAllGroupProjects = func(client *gitlab.Client, groupID interface{}, opts *gitlab.ListGroupProjectsOptions) ([]*gitlab.Project, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	if opts.PerPage == 0 {
+		opts.PerPage = 100
+	}
+	projects := make([]*gitlab.Project, 0, opts.PerPage)
+	for {
+		results, response, err := client.Groups.ListGroupProjects(groupID, opts)
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, results...)
+
+		if response.NextPage == 0 {
+			break
+		}
+		opts.Page = response.NextPage
+	}
+
+	return projects, nil
+}
@@ -0,0 +1,44 @@
+package api
+
+import gitlab "gitlab.com/gitlab-org/api/client-go"
+
+const (
+	// IssueTemplates is the type of the issue description templates in the project templates API.
+	IssueTemplates = "issues"
+	// MergeRequestTemplates is the type of the merge request description templates in the project templates API.
+	MergeRequestTemplates = "merge_requests"
+)
+
+// ListProjectTemplates returns the templates of a type available to a project: the templates
+// of the project, and the templates of its group and of the instance.
+var ListProjectTemplates = func(client *gitlab.Client, projectID interface{}, templateType string) ([]*gitlab.ProjectTemplate, error) {
+	client = getClient(client)
+
+	opts := &gitlab.ListProjectTemplatesOptions{}
+	opts.PerPage = 100
+
+	var templates []*gitlab.ProjectTemplate
+	for {
+		page, response, err := client.ProjectTemplates.ListTemplates(projectID, templateType, opts)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, page...)
+		if response.NextPage == 0 {
+			return templates, nil
+		}
+		opts.Page = response.NextPage
+	}
+}
+
+// GetProjectTemplate returns a template available to a project, with its content.
+var GetProjectTemplate = func(client *gitlab.Client, projectID interface{}, templateType, name string) (*gitlab.ProjectTemplate, error) {
+	client = getClient(client)
+
+	// the client doesn't escape the name of the template, which often contains spaces
+	template, _, err := client.ProjectTemplates.GetProjectTemplate(projectID, templateType, gitlab.PathEscape(name))
+	if err != nil {
+		return nil, err
+	}
+	return template, nil
+}
@@ -0,0 +1,120 @@
+package api
+
+import (
+	"fmt"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// Timelog is time spent on an issue or a merge request.
+type Timelog struct {
+	SpentAt time.Time `json:"spentAt"`
+	// TimeSpent is in seconds.
+	TimeSpent int    `json:"timeSpent"`
+	Summary   string `json:"summary"`
+	User      struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	Project struct {
+		FullPath string `json:"fullPath"`
+	} `json:"project"`
+	Issue        *TimelogIssuable `json:"issue"`
+	MergeRequest *TimelogIssuable `json:"mergeRequest"`
+}
+
+// TimelogIssuable is the issue or the merge request of a timelog.
+type TimelogIssuable struct {
+	Title     string `json:"title"`
+	WebURL    string `json:"webUrl"`
+	Reference string `json:"reference"`
+}
+
+// Issuable returns the issue or the merge request the time is spent on.
+func (t *Timelog) Issuable() *TimelogIssuable {
+	if t.Issue != nil {
+		return t.Issue
+	}
+	if t.MergeRequest != nil {
+		return t.MergeRequest
+	}
+	return &TimelogIssuable{}
+}
+
+// ListTimelogsOptions selects the timelogs of a project or of a group.
+type ListTimelogsOptions struct {
+	// Group is the full path of the group. When it is empty, the timelogs of Project are listed.
+	Group   string
+	Project string
+	// Username only lists the time spent by a user.
+	Username string
+	// StartDate and EndDate, in the YYYY-MM-DD format, include the time spent on these days.
+	StartDate string
+	EndDate   string
+}
+
+const timelogsQuery = `query($fullPath: ID!, $startDate: Time, $endDate: Time, $username: String, $after: String) {
+  %s(fullPath: $fullPath) {
+    timelogs(startDate: $startDate, endDate: $endDate, username: $username, first: 100, after: $after) {
+      nodes {
+        spentAt timeSpent summary
+        user { username }
+        project { fullPath }
+        issue { title webUrl reference(full: true) }
+        mergeRequest { title webUrl reference(full: true) }
+      }
+      pageInfo { hasNextPage endCursor }
+    }
+  }
+}`
+
+// ListTimelogs returns the time spent on the issues and merge requests of a project or
+// of a group. The REST API has no endpoint for timelogs, so they come from the GraphQL API.
+var ListTimelogs = func(client *gitlab.Client, opts *ListTimelogsOptions) ([]*Timelog, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	namespace, fullPath := "project", opts.Project
+	if opts.Group != "" {
+		namespace, fullPath = "group", opts.Group
+	}
+
+	variables := map[string]interface{}{"fullPath": fullPath}
+	if opts.StartDate != "" {
+		variables["startDate"] = opts.StartDate
+	}
+	if opts.EndDate != "" {
+		variables["endDate"] = opts.EndDate
+	}
+	if opts.Username != "" {
+		variables["username"] = opts.Username
+	}
+
+	var timelogs []*Timelog
+	for {
+		var data map[string]*struct {
+			Timelogs struct {
+				Nodes    []*Timelog `json:"nodes"`
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			} `json:"timelogs"`
+		}
+		err := graphQL(client, fmt.Sprintf(timelogsQuery, namespace), variables, &data)
+		if err != nil {
+			return nil, err
+		}
+		if data[namespace] == nil {
+			return nil, fmt.Errorf("%s %q not found.", namespace, fullPath)
+		}
+
+		page := data[namespace].Timelogs
+		timelogs = append(timelogs, page.Nodes...)
+		if !page.PageInfo.HasNextPage {
+			return timelogs, nil
+		}
+		variables["after"] = page.PageInfo.EndCursor
+	}
+}
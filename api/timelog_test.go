@@ -0,0 +1,67 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+)
+
+func TestListTimelogs(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.HostAndPath}
+	defer fakeHTTP.Verify(t)
+
+	body := func(variables map[string]interface{}) string {
+		b, _ := json.Marshal(map[string]interface{}{
+			"query":     fmt.Sprintf(timelogsQuery, "group"),
+			"variables": variables,
+		})
+		return string(b)
+	}
+	fakeHTTP.RegisterResponderWithBody(http.MethodPost, "/api/graphql/",
+		body(map[string]interface{}{"fullPath": "my-group", "username": "alice", "startDate": "2025-06-01"}),
+		httpmock.NewStringResponse(http.StatusOK, `{"data": {"group": {"timelogs": {
+			"nodes": [{"spentAt": "2025-06-02T10:00:00Z", "timeSpent": 3600, "summary": "Review", "user": {"username": "alice"}, "project": {"fullPath": "my-group/app"}, "issue": null, "mergeRequest": {"title": "Fix", "webUrl": "https://gitlab.com/my-group/app/-/merge_requests/3", "reference": "my-group/app!3"}}],
+			"pageInfo": {"hasNextPage": true, "endCursor": "cursor1"}
+		}}}}`))
+	fakeHTTP.RegisterResponderWithBody(http.MethodPost, "/api/graphql/",
+		body(map[string]interface{}{"fullPath": "my-group", "username": "alice", "startDate": "2025-06-01", "after": "cursor1"}),
+		httpmock.NewStringResponse(http.StatusOK, `{"data": {"group": {"timelogs": {
+			"nodes": [{"spentAt": "2025-06-03T10:00:00Z", "timeSpent": 1800, "summary": "", "user": {"username": "alice"}, "project": {"fullPath": "my-group/app"}, "issue": {"title": "Bug", "webUrl": "https://gitlab.com/my-group/app/-/issues/12", "reference": "my-group/app#12"}, "mergeRequest": null}],
+			"pageInfo": {"hasNextPage": false, "endCursor": "cursor2"}
+		}}}}`))
+
+	client, err := gitlab.NewClient("token",
+		gitlab.WithHTTPClient(&http.Client{Transport: fakeHTTP}),
+		gitlab.WithBaseURL("https://gitlab.com/api/v4"))
+	require.NoError(t, err)
+
+	timelogs, err := ListTimelogs(client, &ListTimelogsOptions{Group: "my-group", Username: "alice", StartDate: "2025-06-01"})
+	require.NoError(t, err)
+
+	require.Len(t, timelogs, 2)
+	assert.Equal(t, "my-group/app!3", timelogs[0].Issuable().Reference)
+	assert.Equal(t, "my-group/app#12", timelogs[1].Issuable().Reference)
+	assert.Equal(t, 1800, timelogs[1].TimeSpent)
+}
+
+func TestListTimelogs_NotFound(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.HostAndPath}
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodPost, "https://gitlab.com/api/graphql/",
+		httpmock.NewStringResponse(http.StatusOK, `{"data": {"project": null}}`))
+
+	client, err := gitlab.NewClient("token",
+		gitlab.WithHTTPClient(&http.Client{Transport: fakeHTTP}),
+		gitlab.WithBaseURL("https://gitlab.com/api/v4"))
+	require.NoError(t, err)
+
+	_, err = ListTimelogs(client, &ListTimelogsOptions{Project: "OWNER/REPO"})
+	assert.EqualError(t, err, `project "OWNER/REPO" not found.`)
+}
@@ -0,0 +1,172 @@
+package api
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"golang.org/x/net/http/httpproxy"
+
+	"gitlab.com/gitlab-org/cli/internal/config"
+)
+
+// CertificateError is returned when the TLS connection to a host fails because of a
+// certificate. It explains which certificate failed, and how to fix it.
+type CertificateError struct {
+	Host    string
+	Message string
+	Err     error
+}
+
+func (e *CertificateError) Error() string {
+	return e.Message
+}
+
+func (e *CertificateError) Unwrap() error {
+	return e.Err
+}
+
+// ExplainTLSError returns a CertificateError for the TLS errors caused by a certificate.
+// caFile and certFile are the CA and client certificates configured for the host, if any.
+// Other errors are returned unchanged.
+func ExplainTLSError(host, caFile, certFile string, err error) error {
+	var certErr *CertificateError
+	if errors.As(err, &certErr) {
+		return err
+	}
+
+	var message string
+	var unknownAuthority x509.UnknownAuthorityError
+	var invalid x509.CertificateInvalidError
+	var hostname x509.HostnameError
+	var opErr *net.OpError
+	switch {
+	case errors.As(err, &unknownAuthority):
+		if caFile == "" {
+			message = fmt.Sprintf("the certificate of %s isn't signed by a trusted certificate authority. Set the CA certificate with 'glab auth login --hostname %s --ca-cert <file>'.", host, host)
+		} else {
+			message = fmt.Sprintf("the certificate of %s isn't signed by the CA certificate %s, or by a trusted certificate authority.", host, caFile)
+		}
+	case errors.As(err, &invalid):
+		message = fmt.Sprintf("the certificate of %s isn't valid: %s.", host, invalid.Error())
+	case errors.As(err, &hostname):
+		message = fmt.Sprintf("the certificate of %s isn't valid for its hostname: %s.", host, hostname.Error())
+	case errors.As(err, &opErr) && opErr.Op == "remote error" && strings.Contains(opErr.Err.Error(), "certificate"):
+		// the host rejected the client certificate with a TLS alert
+		if certFile == "" {
+			message = fmt.Sprintf("%s requires a client certificate. Set it with 'glab auth login --hostname %s --client-cert <file> --client-key <file>'.", host, host)
+		} else {
+			message = fmt.Sprintf("%s rejected the client certificate %s: %s.", host, certFile, opErr.Err)
+		}
+	default:
+		return err
+	}
+	return &CertificateError{Host: host, Message: message, Err: err}
+}
+
+// tlsErrorTransport explains the certificate errors of the requests to a host.
+type tlsErrorTransport struct {
+	base     http.RoundTripper
+	host     string
+	caFile   string
+	certFile string
+}
+
+func (t *tlsErrorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, ExplainTLSError(t.host, t.caFile, t.certFile, err)
+	}
+	return resp, nil
+}
+
+// proxyFunc returns the proxy of the requests to a host. The proxy and noProxy settings of
+// the host take precedence over the HTTPS_PROXY, HTTP_PROXY, and NO_PROXY environment variables.
+func proxyFunc(proxy, noProxy string) (func(*http.Request) (*url.URL, error), error) {
+	if proxy == "" && noProxy == "" {
+		return http.ProxyFromEnvironment, nil
+	}
+
+	proxyCfg := httpproxy.FromEnvironment()
+	if proxy != "" {
+		if err := config.ValidateKey("proxy", proxy); err != nil {
+			return nil, err
+		}
+		proxyCfg.HTTPProxy = proxy
+		proxyCfg.HTTPSProxy = proxy
+	}
+	if noProxy != "" {
+		proxyCfg.NoProxy = noProxy
+	}
+
+	proxyURL := proxyCfg.ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return proxyURL(req.URL)
+	}, nil
+}
+
+// LoadCACertificate returns the system certificate pool, with the certificates of caFile.
+func LoadCACertificate(caFile string) (*x509.CertPool, error) {
+	caCert, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading cert file: %w", err)
+	}
+	// use system cert pool as a baseline
+	caCertPool, err := x509.SystemCertPool()
+	if err != nil {
+		return nil, err
+	}
+	if !caCertPool.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("no PEM certificates found in the CA certificate %s.", caFile)
+	}
+	return caCertPool, nil
+}
+
+// LoadClientCertificate loads a client certificate and its key.
+func LoadClientCertificate(certFile, keyFile string) (tls.Certificate, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("failed to load the client certificate %s with the key %s: %w", certFile, keyFile, err)
+	}
+	return cert, nil
+}
+
+// ParseCertificateFile returns the first certificate of a PEM file.
+func ParseCertificateFile(certFile string) (*x509.Certificate, error) {
+	data, err := os.ReadFile(certFile)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return nil, fmt.Errorf("no PEM certificate found in %s.", certFile)
+		}
+		if block.Type == "CERTIFICATE" {
+			return x509.ParseCertificate(block.Bytes)
+		}
+	}
+}
+
+// TLSState returns the state of the TLS connection to the API endpoint of the client, with
+// the certificates of the host. It returns nil when the endpoint doesn't use TLS.
+func (c *Client) TLSState() (*tls.ConnectionState, error) {
+	req, err := http.NewRequest(http.MethodHead, c.Lab().BaseURL().String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.HTTPClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return resp.TLS, nil
+}
@@ -0,0 +1,112 @@
+package api
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_tlsErrorTransport(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	server.StartTLS()
+	defer server.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0o600))
+
+	mtlsServer := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	mtlsServer.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	mtlsServer.Config.ErrorLog = log.New(io.Discard, "", 0)
+	mtlsServer.StartTLS()
+	defer mtlsServer.Close()
+
+	tests := []struct {
+		name      string
+		url       string
+		rootCAs   *x509.CertPool
+		caFile    string
+		certFile  string
+		wantError string
+	}{
+		{
+			name:      "unknown authority",
+			url:       server.URL,
+			wantError: "the certificate of gitlab.example.org isn't signed by a trusted certificate authority. Set the CA certificate with 'glab auth login --hostname gitlab.example.org --ca-cert <file>'.",
+		},
+		{
+			name:      "unknown authority with a CA certificate",
+			url:       server.URL,
+			rootCAs:   x509.NewCertPool(),
+			caFile:    caFile,
+			wantError: "the certificate of gitlab.example.org isn't signed by the CA certificate " + caFile + ", or by a trusted certificate authority.",
+		},
+		{
+			name:      "client certificate required",
+			url:       mtlsServer.URL,
+			rootCAs:   mtlsServer.Client().Transport.(*http.Transport).TLSClientConfig.RootCAs,
+			wantError: "gitlab.example.org requires a client certificate. Set it with 'glab auth login --hostname gitlab.example.org --client-cert <file> --client-key <file>'.",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &http.Client{
+				Transport: &tlsErrorTransport{
+					base: &http.Transport{
+						TLSClientConfig: &tls.Config{RootCAs: tt.rootCAs},
+					},
+					host:     "gitlab.example.org",
+					caFile:   tt.caFile,
+					certFile: tt.certFile,
+				},
+			}
+
+			_, err := client.Get(tt.url)
+			require.Error(t, err)
+
+			var certErr *CertificateError
+			require.ErrorAs(t, err, &certErr)
+			assert.Equal(t, tt.wantError, certErr.Error())
+		})
+	}
+}
+
+func Test_proxyFunc(t *testing.T) {
+	t.Setenv("HTTPS_PROXY", "http://env-proxy.example.com:3128")
+	t.Setenv("NO_PROXY", "")
+
+	proxy, err := proxyFunc("http://proxy.example.com:3128", "internal.example.com")
+	require.NoError(t, err)
+
+	req, _ := http.NewRequest(http.MethodGet, "https://gitlab.example.com/api/v4/user", nil)
+	proxyURL, err := proxy(req)
+	require.NoError(t, err)
+	assert.Equal(t, &url.URL{Scheme: "http", Host: "proxy.example.com:3128"}, proxyURL)
+
+	req, _ = http.NewRequest(http.MethodGet, "https://gitlab.internal.example.com/api/v4/user", nil)
+	proxyURL, err = proxy(req)
+	require.NoError(t, err)
+	assert.Nil(t, proxyURL)
+
+	_, err = proxyFunc("proxy.example.com", "")
+	assert.EqualError(t, err, `invalid value "proxy.example.com" for proxy: use an http, https, or socks5 URL, like http://proxy.example.com:3128.`)
+}
+
+func Test_LoadCACertificate(t *testing.T) {
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, []byte("not a certificate"), 0o600))
+
+	_, err := LoadCACertificate(caFile)
+	assert.EqualError(t, err, "no PEM certificates found in the CA certificate "+caFile+".")
+}
@@ -1,6 +1,9 @@
 package api
 
 import (
+	"net/http"
+	"net/url"
+
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 )
 
@@ -150,3 +153,40 @@ var RotatePersonalAccessToken = func(client *gitlab.Client, id int, opts *gitlab
 	token, _, err := client.PersonalAccessTokens.RotatePersonalAccessToken(id, opts)
 	return token, err
 }
+
+// GetCurrentPersonalAccessToken returns the personal, project, or group access token used
+// to authenticate.
+var GetCurrentPersonalAccessToken = func(client *gitlab.Client) (*gitlab.PersonalAccessToken, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	token, _, err := client.PersonalAccessTokens.GetSinglePersonalAccessToken()
+	return token, err
+}
+
+// OAuthTokenInfo is the information of the OAuth token used to authenticate.
+type OAuthTokenInfo struct {
+	Scope     []string `json:"scope"`
+	ExpiresIn *int     `json:"expires_in"`
+}
+
+// GetOAuthTokenInfo returns the information of the OAuth token used to authenticate. The
+// endpoint is outside of the REST API, at /oauth/token/info.
+var GetOAuthTokenInfo = func(client *gitlab.Client) (*OAuthTokenInfo, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	req, err := client.NewRequest(http.MethodGet, "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.URL = client.BaseURL().ResolveReference(&url.URL{Path: "/oauth/token/info"})
+
+	info := &OAuthTokenInfo{}
+	_, err = client.Do(req, info)
+	if err != nil {
+		return nil, err
+	}
+	return info, nil
+}
@@ -18,6 +18,17 @@ var CurrentUser = func(client *gitlab.Client) (*gitlab.User, error) {
 	return u, nil
 }
 
+var UserByID = func(client *gitlab.Client, id int) (*gitlab.User, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	u, _, err := client.Users.GetUser(id, gitlab.GetUsersOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
 var UserByName = func(client *gitlab.Client, name string) (*gitlab.User, error) {
 	opts := &gitlab.ListUsersOptions{Username: gitlab.Ptr(name)}
 
@@ -71,16 +71,11 @@ var UpdateProjectVariable = func(client *gitlab.Client, projectID interface{}, k
 		client = apiClient.Lab()
 	}
 
-	filter := func(request *retryablehttp.Request) error {
-		q := request.URL.Query()
-		q.Add("filter[environment_scope]", *opts.EnvironmentScope)
-
-		request.URL.RawQuery = q.Encode()
-
-		return nil
+	var scope string
+	if opts.EnvironmentScope != nil {
+		scope = *opts.EnvironmentScope
 	}
-
-	vars, _, err := client.ProjectVariables.UpdateVariable(projectID, key, opts, filter)
+	vars, _, err := client.ProjectVariables.UpdateVariable(projectID, key, opts, environmentScopeFilter(scope))
 	if err != nil {
 		return nil, err
 	}
@@ -130,12 +125,14 @@ var GetGroupVariable = func(client *gitlab.Client, groupID interface{}, key stri
 	return vars, nil
 }
 
-var DeleteGroupVariable = func(client *gitlab.Client, groupID interface{}, key string) error {
+// DeleteGroupVariable deletes the variable of a group with the environment scope. With an
+// empty scope, the first variable with the key is deleted.
+var DeleteGroupVariable = func(client *gitlab.Client, groupID interface{}, key string, scope string) error {
 	if client == nil {
 		client = apiClient.Lab()
 	}
 
-	_, err := client.GroupVariables.RemoveVariable(groupID, key)
+	_, err := client.GroupVariables.RemoveVariable(groupID, key, environmentScopeFilter(scope))
 	if err != nil {
 		return err
 	}
@@ -148,7 +145,89 @@ var UpdateGroupVariable = func(client *gitlab.Client, groupID interface{}, key s
 		client = apiClient.Lab()
 	}
 
-	vars, _, err := client.GroupVariables.UpdateVariable(groupID, key, opts)
+	var scope string
+	if opts.EnvironmentScope != nil {
+		scope = *opts.EnvironmentScope
+	}
+	vars, _, err := client.GroupVariables.UpdateVariable(groupID, key, opts, environmentScopeFilter(scope))
+	if err != nil {
+		return nil, err
+	}
+
+	return vars, nil
+}
+
+// environmentScopeFilter selects the variable with the environment scope, for the group
+// variables endpoints whose options have no filter.
+func environmentScopeFilter(scope string) gitlab.RequestOptionFunc {
+	return func(request *retryablehttp.Request) error {
+		if scope == "" {
+			return nil
+		}
+		q := request.URL.Query()
+		q.Add("filter[environment_scope]", scope)
+
+		request.URL.RawQuery = q.Encode()
+
+		return nil
+	}
+}
+
+var ListInstanceVariables = func(client *gitlab.Client, opts *gitlab.ListInstanceVariablesOptions) ([]*gitlab.InstanceVariable, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	vars, _, err := client.InstanceVariables.ListVariables(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return vars, nil
+}
+
+var CreateInstanceVariable = func(client *gitlab.Client, opts *gitlab.CreateInstanceVariableOptions) (*gitlab.InstanceVariable, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	vars, _, err := client.InstanceVariables.CreateVariable(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return vars, nil
+}
+
+var GetInstanceVariable = func(client *gitlab.Client, key string) (*gitlab.InstanceVariable, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	vars, _, err := client.InstanceVariables.GetVariable(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return vars, nil
+}
+
+var DeleteInstanceVariable = func(client *gitlab.Client, key string) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	_, err := client.InstanceVariables.RemoveVariable(key)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var UpdateInstanceVariable = func(client *gitlab.Client, key string, opts *gitlab.UpdateInstanceVariableOptions) (*gitlab.InstanceVariable, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	vars, _, err := client.InstanceVariables.UpdateVariable(key, opts)
 	if err != nil {
 		return nil, err
 	}
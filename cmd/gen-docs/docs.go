@@ -158,7 +158,17 @@ func fatal(err error) {
 // adapted from: github.com/spf13/cobra/blob/main/doc/md_docs.go
 // GenMarkdownTreeCustom is the the same as GenMarkdownTree, but
 // with custom filePrepender and linkHandler.
+// Commands with subcommands get their own directory, with an index.md.
 func GenMarkdownTreeCustom(cmd *cobra.Command, dir string) error {
+	basename := strings.ReplaceAll(cmd.Name(), " ", "_") + ".md"
+	if cmd.HasAvailableSubCommands() {
+		dir = filepath.Join(dir, cmd.Name())
+		if err := os.MkdirAll(dir, 0o750); err != nil {
+			return err
+		}
+		basename = "index.md"
+	}
+
 	for _, c := range cmd.Commands() {
 		if !c.IsAvailableCommand() || c.IsAdditionalHelpTopicCommand() {
 			continue
@@ -168,7 +178,6 @@ func GenMarkdownTreeCustom(cmd *cobra.Command, dir string) error {
 		}
 	}
 
-	basename := strings.ReplaceAll(cmd.Name(), " ", "_") + ".md"
 	filename := filepath.Join(dir, basename)
 	f, err := os.Create(filename)
 	if err != nil {
@@ -4,12 +4,19 @@ import (
 	"bytes"
 	"fmt"
 	"net"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/pkg/glinstance"
 	"gitlab.com/gitlab-org/cli/pkg/iostreams"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gitlab.com/gitlab-org/cli/commands/cmdutils"
 	"go.uber.org/goleak"
 )
@@ -97,6 +104,38 @@ x lookup https://gitlab.com/api/v4:
 	}
 }
 
+func Test_maybeOverrideDefaultHost_profile(t *testing.T) {
+	t.Setenv("GITLAB_TOKEN", "glpat-123")
+	defer config.StubConfig(`---
+hosts:
+  gitlab.com:
+    git_protocol: ssh
+`, "")()
+	defer func() {
+		glinstance.OverrideDefault("")
+		glinstance.OverrideDefaultProtocol("")
+	}()
+
+	profile := filepath.Join(t.TempDir(), config.ProfileFileName)
+	require.NoError(t, os.WriteFile(profile, []byte("host: http://evil.example.com\n"), 0o644))
+	config.ProfileFiles = func() []string {
+		return []string{profile}
+	}
+
+	cfg, err := config.ParseConfig("config.yml")
+	require.NoError(t, err)
+	f := &cmdutils.Factory{
+		BaseRepo: func() (glrepo.Interface, error) {
+			return glrepo.NewWithHost("owner", "repo", "gitlab.com"), nil
+		},
+	}
+
+	maybeOverrideDefaultHost(f, cfg)
+
+	assert.Equal(t, "gitlab.com", glinstance.OverridableDefault())
+	assert.Equal(t, "https", glinstance.OverridableDefaultProtocol())
+}
+
 // Test started when the test binary is started
 // and calls the main function
 func TestGlab(t *testing.T) {
@@ -3,7 +3,10 @@ package auth
 import (
 	"github.com/spf13/cobra"
 	authLoginCmd "gitlab.com/gitlab-org/cli/commands/auth/login"
+	authLogoutCmd "gitlab.com/gitlab-org/cli/commands/auth/logout"
+	authMigrateCmd "gitlab.com/gitlab-org/cli/commands/auth/migrate"
 	authStatusCmd "gitlab.com/gitlab-org/cli/commands/auth/status"
+	authSwitchCmd "gitlab.com/gitlab-org/cli/commands/auth/switch"
 	"gitlab.com/gitlab-org/cli/commands/cmdutils"
 )
 
@@ -13,9 +16,33 @@ func NewCmdAuth(f *cmdutils.Factory) *cobra.Command {
 		Short: "Manage glab's authentication state.",
 	}
 
+	cmd.PersistentFlags().Var(&accountFlag{f: f}, "account", "Use this account of the GitLab host, instead of the active account.")
+
 	cmd.AddCommand(authLoginCmd.NewCmdLogin(f))
+	cmd.AddCommand(authLogoutCmd.NewCmdLogout(f))
+	cmd.AddCommand(authMigrateCmd.NewCmdMigrate(f))
 	cmd.AddCommand(authStatusCmd.NewCmdStatus(f, nil))
+	cmd.AddCommand(authSwitchCmd.NewCmdSwitch(f))
 	cmd.AddCommand(authLoginCmd.NewCmdCredential(f, nil))
 
 	return cmd
 }
+
+// accountFlag selects the account of the --account flag in the config of the factory.
+type accountFlag struct {
+	f     *cmdutils.Factory
+	value string
+}
+
+func (a *accountFlag) String() string { return a.value }
+func (a *accountFlag) Type() string   { return "string" }
+
+func (a *accountFlag) Set(value string) error {
+	cfg, err := a.f.Config()
+	if err != nil {
+		return err
+	}
+	cfg.UseAccount(value)
+	a.value = value
+	return nil
+}
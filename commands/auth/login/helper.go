@@ -2,41 +2,66 @@ package login
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 
+	"gitlab.com/gitlab-org/cli/api"
 	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
 	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/pkg/oauth2"
 
+	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
 )
 
 const tokenUser = "oauth2"
 
-type configExt interface {
-	Get(string, string) (string, error)
-}
+// projectTokenName is the name of the project access tokens created for Git.
+const projectTokenName = "glab git-credential"
 
 type CredentialOptions struct {
-	IO     *iostreams.IOStreams
-	Config func() (configExt, error)
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+	HttpClient func(hostname string, cfg config.Config) (*gitlab.Client, error)
 
 	Operation string
 }
 
 func NewCmdCredential(f *cmdutils.Factory, runF func(*CredentialOptions) error) *cobra.Command {
 	opts := &CredentialOptions{
-		IO: f.IO,
-		Config: func() (configExt, error) {
-			return f.Config()
+		IO:     f.IO,
+		Config: f.Config,
+		HttpClient: func(hostname string, cfg config.Config) (*gitlab.Client, error) {
+			return cmdutils.LabClientFunc(hostname, cfg, false)
 		},
 	}
 
 	cmd := &cobra.Command{
-		Use:    "git-credential",
-		Args:   cobra.ExactArgs(1),
-		Short:  "Implements Git credential helper manager.",
+		Use:   "git-credential",
+		Args:  cobra.ExactArgs(1),
+		Short: "Implements Git credential helper manager.",
+		Long: heredoc.Doc(`
+			Implements the get, store, and erase operations of a Git credential helper.
+
+			By default, get returns the token of the account. For an account that logged in with
+			OAuth, get returns its OAuth access token, refreshed when it expires. The helper
+			doesn't create other OAuth tokens.
+
+			When the git_credential key of the host is project_token, get creates a project access
+			token for the repository, which expires the next day. Project tokens are kept in the
+			credential store of the host, and rotated before they expire. When Git erases a project
+			token that was rejected, the token is revoked.
+
+			When a host has multiple accounts, get uses the account whose git_paths key matches
+			the path of the repository. For Git to send the path of the repository, run:
+
+			  git config --global credential.https://gitlab.example.com.useHttpPath true
+		`),
 		Hidden: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Operation = args[0]
@@ -52,15 +77,79 @@ func NewCmdCredential(f *cmdutils.Factory, runF func(*CredentialOptions) error)
 }
 
 func helperRun(opts *CredentialOptions) error {
-	if opts.Operation == "store" {
-		// We pretend to implement the "store" operation, but do nothing since we already have a cached token.
+	switch opts.Operation {
+	case "get", "store", "erase":
+	default:
+		return fmt.Errorf("glab auth git-credential: %q is an invalid operation.", opts.Operation)
+	}
+
+	expectedParams, err := readCredential(opts)
+	if err != nil {
+		return err
+	}
+
+	if expectedParams["protocol"] != "https" && expectedParams["protocol"] != "http" {
+		if opts.Operation == "get" {
+			return cmdutils.SilentError
+		}
+		return nil
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host := expectedParams["host"]
+	project := strings.TrimSuffix(strings.Trim(expectedParams["path"], "/"), ".git")
+	if account := pathAccount(cfg, host, project); account != "" {
+		cfg = cfg.ForAccount(account)
+	}
+
+	switch opts.Operation {
+	case "store":
+		// The tokens are already stored by glab, or cached when they are created.
+		return nil
+	case "erase":
+		return eraseCredential(opts, cfg, host, project, expectedParams["password"])
+	}
+
+	var gotToken string
+	var expiry time.Time
+	mode, _ := cfg.Get(host, "git_credential")
+	switch {
+	case mode == "project_token":
+		gotToken, expiry, err = projectToken(opts, cfg, host, project)
+		if err != nil {
+			return err
+		}
+	default:
+		if isOAuth2, _ := cfg.Get(host, "is_oauth2"); isOAuth2 == "true" {
+			if err := oauth2.RefreshToken(host, cfg, expectedParams["protocol"]); err != nil {
+				return err
+			}
+			expiry, _ = oauth2.ExpiryDate(host, cfg)
+		}
+		gotToken, _ = cfg.Get(host, "token")
+	}
+
+	if gotToken == "" {
 		return cmdutils.SilentError
 	}
 
-	if opts.Operation != "get" {
-		return fmt.Errorf("glab auth git-credential: %q is an invalid operation.", opts.Operation)
+	fmt.Fprintf(opts.IO.StdOut, "protocol=%s\n", expectedParams["protocol"])
+	fmt.Fprintf(opts.IO.StdOut, "host=%s\n", host)
+	fmt.Fprintf(opts.IO.StdOut, "username=%s\n", tokenUser)
+	fmt.Fprintf(opts.IO.StdOut, "password=%s\n", gotToken)
+	if !expiry.IsZero() {
+		fmt.Fprintf(opts.IO.StdOut, "password_expiry_utc=%d\n", expiry.Unix())
 	}
 
+	return nil
+}
+
+// readCredential reads the attributes Git sends to the credential helper.
+func readCredential(opts *CredentialOptions) (map[string]string, error) {
 	expectedParams := map[string]string{}
 
 	s := bufio.NewScanner(opts.IO.In)
@@ -77,7 +166,7 @@ func helperRun(opts *CredentialOptions) error {
 		if key == "url" {
 			u, err := url.Parse(value)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			expectedParams["protocol"] = u.Scheme
 			expectedParams["host"] = u.Host
@@ -89,28 +178,130 @@ func helperRun(opts *CredentialOptions) error {
 		}
 	}
 	if err := s.Err(); err != nil {
-		return err
+		return nil, err
 	}
+	return expectedParams, nil
+}
 
-	if expectedParams["protocol"] != "https" && expectedParams["protocol"] != "http" {
-		return cmdutils.SilentError
+// pathAccount returns the account of a host whose git_paths match the path of a project,
+// preferring the longest match. It is empty when an account is selected with --account
+// or GLAB_ACCOUNT, or when no account matches.
+func pathAccount(cfg config.Config, host, project string) string {
+	if project == "" || cfg.AccountOverride() != "" || config.GetFromEnv("account") != "" {
+		return ""
+	}
+	accounts, err := cfg.Accounts(host)
+	if err != nil || len(accounts) < 2 {
+		return ""
 	}
 
-	cfg, err := opts.Config()
+	account, longest := "", 0
+	for _, a := range accounts {
+		paths, _ := cfg.ForAccount(a).Get(host, "git_paths")
+		for _, p := range strings.Split(paths, ",") {
+			p = strings.Trim(strings.TrimSpace(p), "/")
+			if p == "" || len(p) <= longest {
+				continue
+			}
+			if project == p || strings.HasPrefix(project, p+"/") {
+				account, longest = a, len(p)
+			}
+		}
+	}
+	return account
+}
+
+// projectToken returns the cached project access token of a project. A token that expires
+// soon is rotated, and a token is created when the project has none.
+func projectToken(opts *CredentialOptions, cfg config.Config, host, project string) (string, time.Time, error) {
+	if project == "" {
+		return "", time.Time{}, fmt.Errorf("the path of the repository is required to create a project access token. Run `git config --global credential.https://%s.useHttpPath true` to send it.", host)
+	}
+
+	cache, err := loadCredentialCache()
 	if err != nil {
-		return err
+		return "", time.Time{}, err
+	}
+	cache.removeExpired(cfg)
+	key := cacheKey(host, cfg.Account(host), project)
+	cached, ok := cache[key]
+	if ok && time.Until(cached.ExpiresAt) > credentialCacheLeeway {
+		if token, err := cache.token(cfg, key); err == nil && token != "" {
+			return token, cached.ExpiresAt, nil
+		}
+	}
+
+	client, err := opts.HttpClient(host, cfg)
+	if err != nil {
+		return "", time.Time{}, err
 	}
 
-	gotToken, _ := cfg.Get(expectedParams["host"], "token")
+	// Project tokens expire at midnight UTC of their expiry date, so they are valid for one to two days.
+	expiresAt := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 2)
+	expirationDate := gitlab.ISOTime(expiresAt)
 
-	if gotToken == "" {
-		return cmdutils.SilentError
+	// rotate the token of the project, so Git doesn't leave a token behind each day
+	var token *gitlab.ProjectAccessToken
+	if ok && cached.ID != 0 {
+		token, _ = api.RotateProjectAccessToken(client, project, cached.ID, &gitlab.RotateProjectAccessTokenOptions{
+			ExpiresAt: &expirationDate,
+		})
+	}
+	if token == nil {
+		token, err = api.CreateProjectAccessToken(client, project, &gitlab.CreateProjectAccessTokenOptions{
+			Name:        gitlab.Ptr(projectTokenName),
+			Scopes:      &[]string{"read_repository", "write_repository"},
+			AccessLevel: gitlab.Ptr(gitlab.DeveloperPermissions),
+			ExpiresAt:   &expirationDate,
+		})
+	}
+	if err != nil {
+		var respErr *gitlab.ErrorResponse
+		if errors.As(err, &respErr) && respErr.Response != nil && respErr.Response.StatusCode == 403 {
+			return "", time.Time{}, fmt.Errorf("failed to create a project access token for %s: you need the Maintainer role. Run `glab config set git_credential token --host %s` to use your own token.", project, host)
+		}
+		return "", time.Time{}, fmt.Errorf("failed to create a project access token for %s: %w", project, err)
 	}
 
-	fmt.Fprintf(opts.IO.StdOut, "protocol=%s\n", expectedParams["protocol"])
-	fmt.Fprintf(opts.IO.StdOut, "host=%s\n", expectedParams["host"])
-	fmt.Fprintf(opts.IO.StdOut, "username=%s\n", tokenUser)
-	fmt.Fprintf(opts.IO.StdOut, "password=%s\n", gotToken)
+	if err := cache.set(cfg, key, cachedCredential{ID: token.ID, ExpiresAt: expiresAt}, token.Token); err != nil {
+		return "", time.Time{}, err
+	}
+	if err := cache.save(); err != nil {
+		return "", time.Time{}, err
+	}
+	return token.Token, expiresAt, nil
+}
 
-	return nil
+// eraseCredential revokes a project access token rejected by Git, and removes it from the cache.
+// The token of the account is never removed: run `glab auth logout` instead.
+func eraseCredential(opts *CredentialOptions, cfg config.Config, host, project, password string) error {
+	cache, err := loadCredentialCache()
+	if err != nil {
+		return err
+	}
+	key := cacheKey(host, cfg.Account(host), project)
+	cached, ok := cache[key]
+	if !ok {
+		return nil
+	}
+	if token, _ := cache.token(cfg, key); password != "" && token != password {
+		return nil
+	}
+
+	var revokeErr error
+	if cached.ID != 0 {
+		client, err := opts.HttpClient(host, cfg)
+		if err == nil {
+			err = api.RevokeProjectAccessToken(client, project, cached.ID)
+		}
+		if err != nil && !errors.Is(err, gitlab.ErrNotFound) {
+			revokeErr = fmt.Errorf("failed to revoke the project access token of %s: %w", project, err)
+		}
+	}
+
+	cache.remove(cfg, key)
+	if err := cache.save(); err != nil {
+		return err
+	}
+	return revokeErr
 }
@@ -0,0 +1,115 @@
+package login
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gitlab.com/gitlab-org/cli/internal/config"
+
+	"gopkg.in/yaml.v3"
+)
+
+// credentialCacheLeeway is how long before their expiry cached tokens are replaced.
+const credentialCacheLeeway = time.Hour
+
+type cachedCredential struct {
+	// ID is the ID of the project access token, to rotate and revoke it.
+	ID int `yaml:"id"`
+	// Token is only set for the hosts that keep their tokens in the configuration file.
+	// Otherwise, the token is in the credential store of the host.
+	Token     string    `yaml:"token,omitempty"`
+	ExpiresAt time.Time `yaml:"expires_at"`
+}
+
+// credentialCache holds the project access tokens created for Git, by host, account, and project.
+type credentialCache map[string]cachedCredential
+
+func credentialCacheFile() string {
+	return filepath.Join(config.ConfigDir(), "git-credentials.yml")
+}
+
+func cacheKey(host, account, project string) string {
+	if account != "" {
+		return host + "/" + project + "@" + account
+	}
+	return host + "/" + project
+}
+
+// storeName returns the host of a cache key, and the name of its token in the credential
+// store of the host.
+func storeName(key string) (string, string) {
+	host, name, _ := strings.Cut(key, "/")
+	return host, "project/" + name
+}
+
+func loadCredentialCache() (credentialCache, error) {
+	cache := credentialCache{}
+	data, err := os.ReadFile(credentialCacheFile())
+	if os.IsNotExist(err) {
+		return cache, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(data, &cache); err != nil {
+		return nil, err
+	}
+	return cache, nil
+}
+
+func (c credentialCache) save() error {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+	return config.WriteConfigFile(credentialCacheFile(), data)
+}
+
+// token returns the token of a cache key, from the credential store of its host.
+func (c credentialCache) token(cfg config.Config, key string) (string, error) {
+	host, name := storeName(key)
+	store, err := cfg.CredentialStore(host)
+	if err != nil || store == nil {
+		return c[key].Token, err
+	}
+	return store.Get(host, name)
+}
+
+// set caches a token, and saves it to the credential store of its host. The tokens of
+// read-only stores aren't kept, and are rotated the next time they are needed.
+func (c credentialCache) set(cfg config.Config, key string, cached cachedCredential, token string) error {
+	host, name := storeName(key)
+	store, err := cfg.CredentialStore(host)
+	if err != nil {
+		return err
+	}
+
+	if store == nil {
+		cached.Token = token
+	} else if err := store.Set(host, name, token); err != nil && !errors.As(err, new(*config.ReadOnlyStoreError)) {
+		return err
+	}
+	c[key] = cached
+	return nil
+}
+
+// remove removes a token from the cache, and from the credential store of its host.
+func (c credentialCache) remove(cfg config.Config, key string) {
+	host, name := storeName(key)
+	if store, err := cfg.CredentialStore(host); err == nil && store != nil {
+		_ = store.Delete(host, name)
+	}
+	delete(c, key)
+}
+
+// removeExpired removes the expired tokens.
+func (c credentialCache) removeExpired(cfg config.Config) {
+	for key, cached := range c {
+		if time.Now().After(cached.ExpiresAt) {
+			c.remove(cfg, key)
+		}
+	}
+}
@@ -2,23 +2,34 @@ package login
 
 import (
 	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/internal/config"
 	"gitlab.com/gitlab-org/cli/pkg/iostreams"
 
 	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
 )
 
-type tinyConfig map[string]string
-
-func (c tinyConfig) Get(host, key string) (string, error) {
-	return c[fmt.Sprintf("%s:%s", host, key)], nil
+func stubCredentialConfig(content string) func() (config.Config, error) {
+	return func() (config.Config, error) {
+		return config.NewFromString(content), nil
+	}
 }
 
 func Test_helperRun(t *testing.T) {
 	tests := []struct {
 		name       string
 		opts       CredentialOptions
+		env        map[string]string
 		input      string
 		wantStdout string
 		wantStderr string
@@ -28,13 +39,12 @@ func Test_helperRun(t *testing.T) {
 			name: "host only, credentials found",
 			opts: CredentialOptions{
 				Operation: "get",
-				Config: func() (configExt, error) {
-					return tinyConfig{
-						"_source":           "/Users/monalisa/.config/glab/config.yml",
-						"example.com:user":  "monalisa",
-						"example.com:token": "OTOKEN",
-					}, nil
-				},
+				Config: stubCredentialConfig(heredoc.Doc(`
+					hosts:
+					  example.com:
+					    user: monalisa
+					    token: OTOKEN
+				`)),
 			},
 			input: heredoc.Doc(`
 				protocol=https
@@ -53,13 +63,12 @@ func Test_helperRun(t *testing.T) {
 			name: "host plus user",
 			opts: CredentialOptions{
 				Operation: "get",
-				Config: func() (configExt, error) {
-					return tinyConfig{
-						"_source":           "/Users/monalisa/.config/glab/config.yml",
-						"example.com:user":  "monalisa",
-						"example.com:token": "OTOKEN",
-					}, nil
-				},
+				Config: stubCredentialConfig(heredoc.Doc(`
+					hosts:
+					  example.com:
+					    user: monalisa
+					    token: OTOKEN
+				`)),
 			},
 			input: heredoc.Doc(`
 				protocol=https
@@ -79,13 +88,12 @@ func Test_helperRun(t *testing.T) {
 			name: "url input",
 			opts: CredentialOptions{
 				Operation: "get",
-				Config: func() (configExt, error) {
-					return tinyConfig{
-						"_source":           "/Users/monalisa/.config/glab/config.yml",
-						"example.com:user":  "monalisa",
-						"example.com:token": "OTOKEN",
-					}, nil
-				},
+				Config: stubCredentialConfig(heredoc.Doc(`
+					hosts:
+					  example.com:
+					    user: monalisa
+					    token: OTOKEN
+				`)),
 			},
 			input: heredoc.Doc(`
 				url=https://monalisa@example.com
@@ -103,12 +111,11 @@ func Test_helperRun(t *testing.T) {
 			name: "host only, no credentials found",
 			opts: CredentialOptions{
 				Operation: "get",
-				Config: func() (configExt, error) {
-					return tinyConfig{
-						"_source":          "/Users/monalisa/.config/glab/config.yml",
-						"example.com:user": "monalisa",
-					}, nil
-				},
+				Config: stubCredentialConfig(heredoc.Doc(`
+					hosts:
+					  example.com:
+					    user: monalisa
+				`)),
 			},
 			input: heredoc.Doc(`
 				protocol=https
@@ -122,13 +129,13 @@ func Test_helperRun(t *testing.T) {
 			name: "token from env",
 			opts: CredentialOptions{
 				Operation: "get",
-				Config: func() (configExt, error) {
-					return tinyConfig{
-						"_source":           "GITLAB_TOKEN",
-						"example.com:token": "OTOKEN",
-					}, nil
-				},
+				Config: stubCredentialConfig(heredoc.Doc(`
+					hosts:
+					  example.com:
+					    user: monalisa
+				`)),
 			},
+			env: map[string]string{"GITLAB_TOKEN": "OTOKEN"},
 			input: heredoc.Doc(`
 				protocol=https
 				host=example.com
@@ -146,6 +153,9 @@ func Test_helperRun(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
 			io, stdin, stdout, stderr := iostreams.Test()
 			fmt.Fprint(stdin, tt.input)
 			opts := &tt.opts
@@ -162,3 +172,214 @@ func Test_helperRun(t *testing.T) {
 		})
 	}
 }
+
+func Test_helperRun_store(t *testing.T) {
+	io, stdin, stdout, _ := iostreams.Test()
+	fmt.Fprint(stdin, "protocol=https\nhost=example.com\nusername=oauth2\npassword=OTOKEN\n")
+
+	err := helperRun(&CredentialOptions{
+		IO:        io,
+		Operation: "store",
+		Config:    stubCredentialConfig("hosts:\n  example.com:\n    token: OTOKEN\n"),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, stdout.String())
+}
+
+func Test_helperRun_invalidOperation(t *testing.T) {
+	io, _, _, _ := iostreams.Test()
+
+	err := helperRun(&CredentialOptions{IO: io, Operation: "approve"})
+	assert.EqualError(t, err, `glab auth git-credential: "approve" is an invalid operation.`)
+}
+
+func Test_helperRun_accountByPath(t *testing.T) {
+	cfg := stubCredentialConfig(heredoc.Doc(`
+		hosts:
+		  example.com:
+		    account: alice
+		    accounts:
+		      alice:
+		        token: ALICE
+		        git_paths: alice
+		      work:
+		        token: WORK
+		        git_paths: acme, acme/secret/
+		      secret:
+		        token: SECRET
+		        git_paths: acme/secret
+	`))
+
+	tests := []struct {
+		path      string
+		wantToken string
+	}{
+		{path: "acme/cli.git", wantToken: "WORK"},
+		{path: "acme/secret/cli.git", wantToken: "WORK"},
+		{path: "acme-corp/cli.git", wantToken: "ALICE"},
+		{path: "", wantToken: "ALICE"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			io, stdin, stdout, _ := iostreams.Test()
+			fmt.Fprintf(stdin, "protocol=https\nhost=example.com\npath=%s\n", tt.path)
+
+			err := helperRun(&CredentialOptions{IO: io, Operation: "get", Config: cfg})
+			require.NoError(t, err)
+			assert.Contains(t, stdout.String(), "password="+tt.wantToken+"\n")
+		})
+	}
+}
+
+func Test_helperRun_projectToken(t *testing.T) {
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+
+	created := 0
+	originalCreate := api.CreateProjectAccessToken
+	t.Cleanup(func() { api.CreateProjectAccessToken = originalCreate })
+	api.CreateProjectAccessToken = func(_ *gitlab.Client, pid interface{}, opts *gitlab.CreateProjectAccessTokenOptions) (*gitlab.ProjectAccessToken, error) {
+		created++
+		assert.Equal(t, "acme/cli", pid)
+		assert.Equal(t, projectTokenName, *opts.Name)
+		assert.Equal(t, []string{"read_repository", "write_repository"}, *opts.Scopes)
+		assert.Equal(t, gitlab.DeveloperPermissions, *opts.AccessLevel)
+		return &gitlab.ProjectAccessToken{ID: 100 + created, Token: fmt.Sprintf("glpat-project-%d", created)}, nil
+	}
+
+	var rotated []int
+	originalRotate := api.RotateProjectAccessToken
+	t.Cleanup(func() { api.RotateProjectAccessToken = originalRotate })
+	api.RotateProjectAccessToken = func(_ *gitlab.Client, pid interface{}, id int, opts *gitlab.RotateProjectAccessTokenOptions) (*gitlab.ProjectAccessToken, error) {
+		rotated = append(rotated, id)
+		return &gitlab.ProjectAccessToken{ID: 200, Token: "glpat-rotated"}, nil
+	}
+
+	var revoked []int
+	originalRevoke := api.RevokeProjectAccessToken
+	t.Cleanup(func() { api.RevokeProjectAccessToken = originalRevoke })
+	api.RevokeProjectAccessToken = func(_ *gitlab.Client, pid interface{}, id int) error {
+		revoked = append(revoked, id)
+		return nil
+	}
+
+	opts := func(operation, input string) (*CredentialOptions, *iostreams.IOStreams) {
+		io, stdin, _, _ := iostreams.Test()
+		fmt.Fprint(stdin, input)
+		return &CredentialOptions{
+			IO:        io,
+			Operation: operation,
+			Config: stubCredentialConfig(heredoc.Doc(`
+				hosts:
+				  example.com:
+				    token: OTOKEN
+				    git_credential: project_token
+			`)),
+			HttpClient: func(string, config.Config) (*gitlab.Client, error) {
+				return nil, nil
+			},
+		}, io
+	}
+	get := func() string {
+		o, io := opts("get", "url=https://example.com/acme/cli.git\n")
+		require.NoError(t, helperRun(o))
+		return io.StdOut.(interface{ String() string }).String()
+	}
+
+	expiry := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 2)
+	assert.Equal(t, heredoc.Docf(`
+		protocol=https
+		host=example.com
+		username=oauth2
+		password=glpat-project-1
+		password_expiry_utc=%d
+	`, expiry.Unix()), get())
+
+	t.Run("cached", func(t *testing.T) {
+		assert.Contains(t, get(), "password=glpat-project-1\n")
+		assert.Equal(t, 1, created)
+
+		info, err := os.Stat(filepath.Join(config.ConfigDir(), "git-credentials.yml"))
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	})
+
+	t.Run("erase of another token", func(t *testing.T) {
+		o, _ := opts("erase", "url=https://example.com/acme/cli.git\npassword=OTOKEN\n")
+		require.NoError(t, helperRun(o))
+		assert.Contains(t, get(), "password=glpat-project-1\n")
+		assert.Empty(t, revoked)
+	})
+
+	t.Run("expiring token is rotated", func(t *testing.T) {
+		cache, err := loadCredentialCache()
+		require.NoError(t, err)
+		key := cacheKey("example.com", "", "acme/cli")
+		cached := cache[key]
+		cached.ExpiresAt = time.Now().Add(time.Minute)
+		cache[key] = cached
+		require.NoError(t, cache.save())
+
+		assert.Contains(t, get(), "password=glpat-rotated\n")
+		assert.Equal(t, []int{101}, rotated)
+		assert.Equal(t, 1, created)
+	})
+
+	t.Run("erase", func(t *testing.T) {
+		o, _ := opts("erase", "url=https://example.com/acme/cli.git\npassword=glpat-rotated\n")
+		require.NoError(t, helperRun(o))
+		assert.Equal(t, []int{200}, revoked)
+		assert.Contains(t, get(), "password=glpat-project-2\n")
+	})
+
+	t.Run("no path", func(t *testing.T) {
+		o, _ := opts("get", "protocol=https\nhost=example.com\n")
+		err := helperRun(o)
+		assert.ErrorContains(t, err, "credential.https://example.com.useHttpPath true")
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		api.CreateProjectAccessToken = func(*gitlab.Client, interface{}, *gitlab.CreateProjectAccessTokenOptions) (*gitlab.ProjectAccessToken, error) {
+			return nil, &gitlab.ErrorResponse{Response: &http.Response{StatusCode: http.StatusForbidden}}
+		}
+		o, _ := opts("get", "url=https://example.com/acme/other.git\n")
+		err := helperRun(o)
+		assert.ErrorContains(t, err, "you need the Maintainer role")
+	})
+}
+
+func Test_helperRun_projectTokenInCredentialStore(t *testing.T) {
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+	keyring.MockInit()
+
+	originalCreate := api.CreateProjectAccessToken
+	t.Cleanup(func() { api.CreateProjectAccessToken = originalCreate })
+	api.CreateProjectAccessToken = func(*gitlab.Client, interface{}, *gitlab.CreateProjectAccessTokenOptions) (*gitlab.ProjectAccessToken, error) {
+		return &gitlab.ProjectAccessToken{ID: 101, Token: "glpat-project"}, nil
+	}
+
+	io, stdin, stdout, _ := iostreams.Test()
+	fmt.Fprint(stdin, "url=https://example.com/acme/cli.git\n")
+	err := helperRun(&CredentialOptions{
+		IO:        io,
+		Operation: "get",
+		Config: stubCredentialConfig(heredoc.Doc(`
+			hosts:
+			  example.com:
+			    credential_store: keyring
+			    git_credential: project_token
+		`)),
+		HttpClient: func(string, config.Config) (*gitlab.Client, error) {
+			return nil, nil
+		},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "password=glpat-project\n")
+
+	token, err := keyring.Get("glab:example.com", "project/acme/cli")
+	require.NoError(t, err)
+	assert.Equal(t, "glpat-project", token)
+
+	data, err := os.ReadFile(filepath.Join(config.ConfigDir(), "git-credentials.yml"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "glpat-project")
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 
@@ -38,7 +39,13 @@ type LoginOptions struct {
 	ApiProtocol string
 	GitProtocol string
 
+	CACert     string
+	ClientCert string
+	ClientKey  string
+	Proxy      string
+
 	UseKeyring bool
+	Device     bool
 }
 
 var opts *LoginOptions
@@ -78,10 +85,23 @@ func NewCmdLogin(f *cmdutils.Factory) *cobra.Command {
 			$ glab auth login --hostname gitlab.example.org --api-host gitlab.example.org:3443 --api-protocol https --git-protocol ssh  --stdin < myaccesstoken.txt
 			# non-interactive job token setup
 			$ glab auth login --hostname gitlab.example.org --job-token $CI_JOB_TOKEN
+
+			# Sign in from a host without a browser, like over SSH or in a container
+			$ glab auth login --device
+
+			# Add a second account to a host, and make it the active account
+			$ glab auth login --hostname gitlab.com --account bot --stdin < bottoken.txt
+
+			# Authenticate with a host that requires a client certificate, through a proxy
+			$ glab auth login --hostname gitlab.example.org --ca-cert ca.pem --client-cert client.pem --client-key client.key --proxy http://proxy.example.org:3128 --stdin < myaccesstoken.txt
 		`, "`"),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if !opts.IO.PromptEnabled() && !tokenStdin && opts.Token == "" && opts.JobToken == "" {
-				return &cmdutils.FlagError{Err: errors.New("'--stdin', '--token', or '--job-token' required when not running interactively.")}
+			if !opts.IO.PromptEnabled() && !tokenStdin && opts.Token == "" && opts.JobToken == "" && !opts.Device {
+				return &cmdutils.FlagError{Err: errors.New("'--stdin', '--token', '--job-token', or '--device' required when not running interactively.")}
+			}
+
+			if opts.Device && (opts.Token != "" || opts.JobToken != "" || tokenStdin) {
+				return &cmdutils.FlagError{Err: errors.New("'--device' cannot be used with '--token', '--job-token', or '--stdin'.")}
 			}
 
 			if opts.JobToken != "" && (opts.Token != "" || tokenStdin) {
@@ -92,6 +112,16 @@ func NewCmdLogin(f *cmdutils.Factory) *cobra.Command {
 				return &cmdutils.FlagError{Err: errors.New("specify one of '--token' or '--stdin'. You cannot use both flags at the same time.")}
 			}
 
+			if (opts.ClientCert == "") != (opts.ClientKey == "") {
+				return &cmdutils.FlagError{Err: errors.New("'--client-cert' and '--client-key' must be used together.")}
+			}
+
+			if opts.Proxy != "" {
+				if err := config.ValidateKey("proxy", opts.Proxy); err != nil {
+					return &cmdutils.FlagError{Err: err}
+				}
+			}
+
 			if tokenStdin {
 				defer opts.IO.In.Close()
 				token, err := io.ReadAll(opts.IO.In)
@@ -111,6 +141,10 @@ func NewCmdLogin(f *cmdutils.Factory) *cobra.Command {
 				}
 			}
 
+			if opts.Device && !opts.Interactive && (opts.ApiHost != "" || opts.GitProtocol != "") {
+				return &cmdutils.FlagError{Err: errors.New("api-host and git-protocol cannot be used with '--device'.")}
+			}
+
 			if !opts.Interactive && opts.Hostname == "" {
 				opts.Hostname = glinstance.Default()
 			}
@@ -132,9 +166,14 @@ func NewCmdLogin(f *cmdutils.Factory) *cobra.Command {
 	cmd.Flags().StringVarP(&opts.JobToken, "job-token", "j", "", "CI job token.")
 	cmd.Flags().BoolVar(&tokenStdin, "stdin", false, "Read token from standard input.")
 	cmd.Flags().BoolVar(&opts.UseKeyring, "use-keyring", false, "Store token in your operating system's keyring.")
+	cmd.Flags().BoolVar(&opts.Device, "device", false, "Sign in with a code entered in a browser on any device, for hosts without a browser.")
 	cmd.Flags().StringVarP(&opts.ApiHost, "api-host", "a", "", "API host url.")
 	cmd.Flags().StringVarP(&opts.ApiProtocol, "api-protocol", "p", "", "API protocol: https, http")
 	cmd.Flags().StringVarP(&opts.GitProtocol, "git-protocol", "g", "", "Git protocol: ssh, https, http")
+	cmd.Flags().StringVar(&opts.CACert, "ca-cert", "", "Verify the host with this CA certificate, in addition to the system certificates.")
+	cmd.Flags().StringVar(&opts.ClientCert, "client-cert", "", "Authenticate the TLS connections with this client certificate. Requires '--client-key'.")
+	cmd.Flags().StringVar(&opts.ClientKey, "client-key", "", "The key of the client certificate.")
+	cmd.Flags().StringVar(&opts.Proxy, "proxy", "", "Connect to the host through this proxy, like http://proxy.example.com:3128.")
 
 	return cmd
 }
@@ -151,8 +190,18 @@ func loginRun(opts *LoginOptions) error {
 			return errors.New("empty hostname would leak `oauth_token`")
 		}
 
+		if err := addAccount(cfg, opts.Hostname); err != nil {
+			return err
+		}
+		if err := saveConnectionSettings(cfg, opts, opts.Hostname); err != nil {
+			return err
+		}
+
 		if opts.UseKeyring {
-			return keyring.Set("glab:"+opts.Hostname, "", opts.Token)
+			if err := keyring.Set("glab:"+opts.Hostname, cfg.Account(opts.Hostname), opts.Token); err != nil {
+				return err
+			}
+			return cfg.Write()
 		} else {
 			err := cfg.Set(opts.Hostname, "token", opts.Token)
 			if err != nil {
@@ -193,8 +242,18 @@ func loginRun(opts *LoginOptions) error {
 			return errors.New("empty hostname would leak `oauth_token`")
 		}
 
+		if err := addAccount(cfg, opts.Hostname); err != nil {
+			return err
+		}
+		if err := saveConnectionSettings(cfg, opts, opts.Hostname); err != nil {
+			return err
+		}
+
 		if opts.UseKeyring {
-			return keyring.Set("glab:"+opts.Hostname, "", opts.JobToken)
+			if err := keyring.Set("glab:"+opts.Hostname, cfg.Account(opts.Hostname), opts.JobToken); err != nil {
+				return err
+			}
+			return cfg.Write()
 		} else {
 			err := cfg.Set(opts.Hostname, "job_token", opts.JobToken)
 			if err != nil {
@@ -275,6 +334,13 @@ func loginRun(opts *LoginOptions) error {
 
 	fmt.Fprintf(opts.IO.StdErr, "- Logging into %s\n", hostname)
 
+	if err := addAccount(cfg, hostname); err != nil {
+		return err
+	}
+	if err := saveConnectionSettings(cfg, opts, hostname); err != nil {
+		return err
+	}
+
 	if token := config.GetFromEnv("token"); token != "" {
 		fmt.Fprintf(opts.IO.StdErr, "%s One of %s environment variables is set. If you don't want to use it for glab, unset it.\n", c.Yellow("WARNING:"), strings.Join(config.EnvKeyEquivalence("token"), ", "))
 	}
@@ -309,7 +375,9 @@ func loginRun(opts *LoginOptions) error {
 
 	var loginType string
 
-	if opts.Interactive {
+	if opts.Device {
+		loginType = "device"
+	} else if opts.Interactive {
 		err := survey.AskOne(&survey.Select{
 			Message: "How would you like to sign in?",
 			Options: []string{
@@ -328,6 +396,21 @@ func loginRun(opts *LoginOptions) error {
 		if err != nil {
 			return err
 		}
+	} else if loginType == "device" {
+		protocol := opts.ApiProtocol
+		if protocol == "" {
+			protocol = "https"
+		}
+		token, err = oauth2.StartDeviceFlow(cfg, opts.IO, hostname, protocol)
+		if err != nil {
+			return err
+		}
+		if opts.ApiProtocol != "" {
+			err = cfg.Set(hostname, "api_protocol", opts.ApiProtocol)
+			if err != nil {
+				return err
+			}
+		}
 	} else {
 		token, err = oauth2.StartFlow(cfg, opts.IO, hostname)
 		if err != nil {
@@ -336,7 +419,7 @@ func loginRun(opts *LoginOptions) error {
 	}
 
 	if opts.UseKeyring {
-		err = keyring.Set("glab:"+hostname, "", token)
+		err = keyring.Set("glab:"+hostname, cfg.Account(hostname), token)
 		if err != nil {
 			return err
 		}
@@ -464,6 +547,58 @@ func hostnameValidator(v interface{}) error {
 	return nil
 }
 
+// addAccount adds the account selected with --account to the host, so the credentials
+// are saved to this account.
+func addAccount(cfg config.Config, hostname string) error {
+	account := cfg.AccountOverride()
+	if account == "" {
+		return nil
+	}
+	return cfg.AddAccount(hostname, account)
+}
+
+// saveConnectionSettings checks the certificates set with the flags, and saves them and the
+// proxy to the host, before the first request to the host.
+func saveConnectionSettings(cfg config.Config, opts *LoginOptions, hostname string) error {
+	if opts.CACert != "" {
+		caCert, err := filepath.Abs(opts.CACert)
+		if err != nil {
+			return err
+		}
+		if _, err := api.LoadCACertificate(caCert); err != nil {
+			return err
+		}
+		if err := cfg.Set(hostname, "ca_cert", caCert); err != nil {
+			return err
+		}
+	}
+
+	if opts.ClientCert != "" {
+		clientCert, err := filepath.Abs(opts.ClientCert)
+		if err != nil {
+			return err
+		}
+		clientKey, err := filepath.Abs(opts.ClientKey)
+		if err != nil {
+			return err
+		}
+		if _, err := api.LoadClientCertificate(clientCert, clientKey); err != nil {
+			return err
+		}
+		if err := cfg.Set(hostname, "client_cert", clientCert); err != nil {
+			return err
+		}
+		if err := cfg.Set(hostname, "client_key", clientKey); err != nil {
+			return err
+		}
+	}
+
+	if opts.Proxy != "" {
+		return cfg.Set(hostname, "proxy", opts.Proxy)
+	}
+	return nil
+}
+
 func getAccessTokenTip(hostname string) string {
 	glHostname := hostname
 	if glHostname == "" {
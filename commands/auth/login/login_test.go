@@ -2,14 +2,22 @@ package login
 
 import (
 	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"gitlab.com/gitlab-org/cli/pkg/iostreams"
 
 	"github.com/google/shlex"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/zalando/go-keyring"
 	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/config"
 )
 
 func TestMain(m *testing.M) {
@@ -59,6 +67,18 @@ func Test_NewCmdLogin(t *testing.T) {
 			err:      "could not prompt",
 			stdinTTY: false,
 		},
+		{
+			name:     "client certificate without key",
+			cli:      "--token glpat-123 --client-cert client.pem",
+			wantsErr: true,
+			err:      "'--client-cert' and '--client-key' must be used together.",
+		},
+		{
+			name:     "invalid proxy",
+			cli:      "--token glpat-123 --proxy proxy.example.com",
+			wantsErr: true,
+			err:      `invalid value "proxy.example.com" for proxy: use an http, https, or socks5 URL`,
+		},
 		{
 			name:  "nontty, stdin, hostname",
 			cli:   "--hostname db.org --stdin",
@@ -354,3 +374,38 @@ func Test_keyringLogin(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "glpat-1234", token)
 }
+
+func Test_saveConnectionSettings(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "client.pem")
+	keyFile := filepath.Join(dir, "client.key")
+	key, err := x509.MarshalPKCS8PrivateKey(server.TLS.Certificates[0].PrivateKey)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: key}), 0o600))
+
+	cfg := config.NewBlankConfig()
+	err = saveConnectionSettings(cfg, &LoginOptions{
+		CACert:     certFile,
+		ClientCert: certFile,
+		ClientKey:  keyFile,
+		Proxy:      "http://proxy.example.com:3128",
+	}, "gitlab.example.org")
+	require.NoError(t, err)
+
+	for key, want := range map[string]string{
+		"ca_cert":     certFile,
+		"client_cert": certFile,
+		"client_key":  keyFile,
+		"proxy":       "http://proxy.example.com:3128",
+	} {
+		value, _ := cfg.Get("gitlab.example.org", key)
+		assert.Equal(t, want, value, key)
+	}
+
+	err = saveConnectionSettings(cfg, &LoginOptions{ClientCert: keyFile, ClientKey: certFile}, "gitlab.example.org")
+	assert.ErrorContains(t, err, "failed to load the client certificate "+keyFile+" with the key "+certFile)
+}
@@ -0,0 +1,91 @@
+package logout
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/pkg/glinstance"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+type LogoutOpts struct {
+	Hostname string
+
+	IO     *iostreams.IOStreams
+	Config func() (config.Config, error)
+}
+
+func NewCmdLogout(f *cmdutils.Factory) *cobra.Command {
+	opts := &LogoutOpts{
+		IO:     f.IO,
+		Config: f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "logout",
+		Args:  cobra.ExactArgs(0),
+		Short: "Log out of a GitLab instance.",
+		Long: heredoc.Doc(`
+			Log out of a GitLab instance, and remove the token from the configuration file
+			and from the keyring of your operating system.
+
+			For a host with several accounts, the active account is logged out, unless you
+			select another account with --account. Another account becomes the active account.
+		`),
+		Example: heredoc.Doc(`
+			glab auth logout
+			glab auth logout --hostname gitlab.example.org
+			glab auth logout --account bot
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Hostname == "" {
+				opts.Hostname = glinstance.OverridableDefault()
+			}
+
+			return logoutRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Hostname, "hostname", "h", "", "The hostname of the GitLab instance to log out of.")
+
+	return cmd
+}
+
+func logoutRun(opts *LogoutOpts) error {
+	c := opts.IO.Color()
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	account := cfg.Account(opts.Hostname)
+	if account == "" {
+		token, _, _ := cfg.GetWithSource(opts.Hostname, "token", false)
+		jobToken, _, _ := cfg.GetWithSource(opts.Hostname, "job_token", false)
+		if token == "" && jobToken == "" {
+			return fmt.Errorf("not logged in to %s.", opts.Hostname)
+		}
+	}
+
+	if err := cfg.RemoveAccount(opts.Hostname, account); err != nil {
+		return err
+	}
+	if err := cfg.Write(); err != nil {
+		return err
+	}
+
+	if account == "" {
+		fmt.Fprintf(opts.IO.StdErr, "%s Logged out of %s.\n", c.GreenCheck(), opts.Hostname)
+		return nil
+	}
+
+	fmt.Fprintf(opts.IO.StdErr, "%s Logged out of %s account %s.\n", c.GreenCheck(), opts.Hostname, c.Bold(account))
+	if active := cfg.Account(opts.Hostname); active != "" && active != account {
+		fmt.Fprintf(opts.IO.StdErr, "%s Active account for %s is now %s.\n", c.GreenCheck(), opts.Hostname, c.Bold(active))
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package logout
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+func Test_logoutRun(t *testing.T) {
+	defer config.StubConfig(`---
+hosts:
+  gitlab.com:
+    account: alice
+    accounts:
+      alice:
+        token: glpat-alice
+      bot:
+        token: glpat-bot
+  gitlab.example.org:
+    token: glpat-example
+  gitlab.empty.org:
+    api_protocol: https
+`, "")()
+
+	tests := []struct {
+		name     string
+		hostname string
+		account  string
+		stderr   string
+		wantErr  string
+		accounts []string
+	}{
+		{
+			name:     "active account",
+			hostname: "gitlab.com",
+			stderr:   "✓ Logged out of gitlab.com account alice.\n✓ Active account for gitlab.com is now bot.\n",
+			accounts: []string{"bot"},
+		},
+		{
+			name:     "selected account",
+			hostname: "gitlab.com",
+			account:  "bot",
+			stderr:   "✓ Logged out of gitlab.com account bot.\n",
+			accounts: []string{"alice"},
+		},
+		{
+			name:     "unknown account",
+			hostname: "gitlab.com",
+			account:  "admin",
+			wantErr:  `account "admin" not found for gitlab.com.`,
+		},
+		{
+			name:     "host without accounts",
+			hostname: "gitlab.example.org",
+			stderr:   "✓ Logged out of gitlab.example.org.\n",
+		},
+		{
+			name:     "not logged in",
+			hostname: "gitlab.empty.org",
+			wantErr:  "not logged in to gitlab.empty.org.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mainBuf := bytes.Buffer{}
+			defer config.StubWriteConfig(&mainBuf, &bytes.Buffer{})()
+			keyring.MockInit()
+
+			cfg, err := config.ParseConfig("config.yml")
+			require.NoError(t, err)
+
+			cfg.UseAccount(tc.account)
+
+			io, _, _, stderr := iostreams.Test()
+			err = logoutRun(&LogoutOpts{
+				Hostname: tc.hostname,
+				IO:       io,
+				Config: func() (config.Config, error) {
+					return cfg, nil
+				},
+			})
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.stderr, stderr.String())
+
+			accounts, _ := cfg.Accounts(tc.hostname)
+			assert.Equal(t, tc.accounts, accounts)
+			if tc.accounts == nil {
+				token, _, _ := cfg.GetWithSource(tc.hostname, "token", false)
+				assert.Empty(t, token)
+			}
+			assert.NotEmpty(t, mainBuf.String())
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package migrate
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+type MigrateOpts struct {
+	Hostname string
+	Store    string
+
+	IO     *iostreams.IOStreams
+	Config func() (config.Config, error)
+}
+
+func NewCmdMigrate(f *cmdutils.Factory) *cobra.Command {
+	opts := &MigrateOpts{
+		IO:     f.IO,
+		Config: f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Args:  cobra.ExactArgs(0),
+		Short: "Move the tokens from the configuration file to a credential store.",
+		Long: heredoc.Docf(`
+			Move the tokens saved in plain text in the configuration file to a credential store,
+			and save the new tokens of the hosts to this store.
+
+			Credential stores:
+
+			- %[1]skeyring%[1]s: the keyring of your operating system.
+			- %[1]spass%[1]s: pass, the standard Unix password manager, as %[1]sglab/<host>%[1]s.
+			- %[1]s1password%[1]s: 1Password, with its CLI %[1]sop%[1]s, as %[1]sglab/<host>%[1]s items.
+
+			Two more stores read the tokens, but can't save them. Select them with
+			%[1]sglab config set --host <host> credential_store <store>%[1]s:
+
+			- %[1]senv%[1]s: the %[1]sGLAB_TOKEN_<HOST>%[1]s environment variable, like %[1]sGLAB_TOKEN_GITLAB_COM%[1]s.
+			- %[1]scommand%[1]s: the output of the %[1]stoken_command%[1]s of the host.
+
+			To never write tokens to the configuration file, set the store for all the hosts
+			with %[1]sglab config set credential_store <store>%[1]s.
+		`, "`"),
+		Example: heredoc.Doc(`
+			glab auth migrate
+			glab auth migrate --to pass --hostname gitlab.example.org
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return migrateRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Hostname, "hostname", "h", "", "Migrate the tokens of this host only.")
+	cmd.Flags().StringVar(&opts.Store, "to", "keyring", "The credential store: keyring, pass, 1password.")
+
+	return cmd
+}
+
+func migrateRun(opts *MigrateOpts) error {
+	c := opts.IO.Color()
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	hosts := []string{opts.Hostname}
+	if opts.Hostname == "" {
+		hosts, err = cfg.Hosts()
+		if err != nil {
+			return err
+		}
+	}
+	if len(hosts) == 0 {
+		return fmt.Errorf("no GitLab instances have been authenticated with glab.")
+	}
+
+	for _, host := range hosts {
+		migrated, err := cfg.MigrateCredentials(host, opts.Store)
+		if migrated > 0 {
+			// save the tokens already moved to the store
+			if err := cfg.Write(); err != nil {
+				return err
+			}
+		}
+		if err != nil {
+			return err
+		}
+
+		if migrated == 0 {
+			fmt.Fprintf(opts.IO.StdErr, "%s No tokens in the configuration file for %s.\n", c.GreenCheck(), host)
+			continue
+		}
+		fmt.Fprintf(opts.IO.StdErr, "%s Moved %s of %s to %s.\n", c.GreenCheck(), utils.Pluralize(migrated, "token"), host, opts.Store)
+	}
+
+	return cfg.Write()
+}
@@ -0,0 +1,47 @@
+package migrate
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+func Test_migrateRun(t *testing.T) {
+	defer config.StubConfig(`---
+hosts:
+  gitlab.com:
+    token: glpat-gitlab
+  gitlab.example.org:
+    credential_store: keyring
+`, "")()
+
+	mainBuf := bytes.Buffer{}
+	defer config.StubWriteConfig(&mainBuf, &bytes.Buffer{})()
+	keyring.MockInit()
+
+	cfg, err := config.ParseConfig("config.yml")
+	require.NoError(t, err)
+
+	io, _, _, stderr := iostreams.Test()
+	err = migrateRun(&MigrateOpts{
+		Store: "keyring",
+		IO:    io,
+		Config: func() (config.Config, error) {
+			return cfg, nil
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Moved 1 token of gitlab.com to keyring.\n✓ No tokens in the configuration file for gitlab.example.org.\n", stderr.String())
+	assert.NotContains(t, mainBuf.String(), "glpat-gitlab")
+
+	token, err := keyring.Get("glab:gitlab.com", "")
+	require.NoError(t, err)
+	assert.Equal(t, "glpat-gitlab", token)
+}
@@ -1,8 +1,11 @@
 package status
 
 import (
+	"crypto/x509"
 	"fmt"
+	"net/url"
 	"strings"
+	"time"
 
 	"golang.org/x/exp/slices"
 
@@ -14,6 +17,8 @@ import (
 	"gitlab.com/gitlab-org/cli/commands/cmdutils"
 	"gitlab.com/gitlab-org/cli/internal/config"
 	"gitlab.com/gitlab-org/cli/pkg/glinstance"
+	"gitlab.com/gitlab-org/cli/pkg/oauth2"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
 )
 
 type StatusOpts struct {
@@ -38,6 +43,9 @@ func NewCmdStatus(f *cmdutils.Factory, runE func(*StatusOpts) error) *cobra.Comm
 		Long: heredoc.Doc(`Verifies and displays information about your authentication state.
 
 		This command tests the authentication states of all known GitLab instances in the configuration file and reports issues, if any.
+		It also reports the scopes of the tokens, and how long until they expire.
+		For HTTPS hosts, it verifies the TLS certificate of the host, with the CA certificate set
+		for the host, and reports when the certificate of the host and the client certificate expire.
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if runE != nil {
@@ -96,11 +104,26 @@ func statusRun(opts *StatusOpts) error {
 				addMsg("%s %s: API call failed: %s", c.FailedIcon(), instance, err)
 			} else {
 				addMsg("%s Logged in to %s as %s (%s)", c.GreenCheck(), instance, c.Bold(user.Username), tokenSource)
+				for _, msg := range tokenInfo(opts.IO, cfg, instance, apiClient) {
+					addMsg("%s", msg)
+				}
+				for _, msg := range tlsInfo(opts.IO, cfg, instance, apiClient) {
+					addMsg("%s", msg)
+				}
 			}
 		} else {
 			failedAuth = true
 			addMsg("%s %s: failed to initialize api client: %s", c.FailedIcon(), instance, err)
 		}
+		if accounts, _ := cfg.Accounts(instance); len(accounts) > 0 {
+			active := cfg.Account(instance)
+			for i, account := range accounts {
+				if account == active {
+					accounts[i] = c.Bold(account) + " (active)"
+				}
+			}
+			addMsg("%s Accounts: %s", c.GreenCheck(), strings.Join(accounts, ", "))
+		}
 		proto, _ := cfg.Get(instance, "git_protocol")
 		if proto != "" {
 			addMsg("%s Git operations for %s configured to use %s protocol.",
@@ -157,3 +180,110 @@ func statusRun(opts *StatusOpts) error {
 		return nil
 	}
 }
+
+// tokenInfo returns the scopes and the expiry of the token of a host. Errors are ignored,
+// because older instances and job tokens can't return them.
+func tokenInfo(io *iostreams.IOStreams, cfg config.Config, instance string, apiClient *api.Client) []string {
+	c := io.Color()
+	var msgs []string
+
+	if isOAuth2, _ := cfg.Get(instance, "is_oauth2"); isOAuth2 == "true" {
+		if info, err := api.GetOAuthTokenInfo(apiClient.Lab()); err == nil && len(info.Scope) > 0 {
+			msgs = append(msgs, fmt.Sprintf("%s Token scopes: %s", c.GreenCheck(), strings.Join(info.Scope, ", ")))
+		}
+		if expiry, err := oauth2.ExpiryDate(instance, cfg); err == nil {
+			msgs = append(msgs, fmt.Sprintf("%s OAuth token expires in %s, and is refreshed automatically.", c.GreenCheck(), formatExpiry(time.Until(expiry))))
+		}
+		return msgs
+	}
+
+	if jobToken, _ := cfg.Get(instance, "job_token"); jobToken != "" {
+		return nil
+	}
+
+	token, err := api.GetCurrentPersonalAccessToken(apiClient.Lab())
+	if err != nil {
+		return nil
+	}
+	if len(token.Scopes) > 0 {
+		msgs = append(msgs, fmt.Sprintf("%s Token scopes: %s", c.GreenCheck(), strings.Join(token.Scopes, ", ")))
+	}
+
+	if token.ExpiresAt == nil {
+		return append(msgs, fmt.Sprintf("%s Token does not expire.", c.GreenCheck()))
+	}
+	until := time.Until(time.Time(*token.ExpiresAt))
+	switch {
+	case until < 0:
+		msgs = append(msgs, fmt.Sprintf("%s Token expired on %s.", c.FailedIcon(), token.ExpiresAt))
+	case until < tokenExpiryWarning:
+		msgs = append(msgs, fmt.Sprintf("%s Token expires in %s, on %s.", c.WarnIcon(), formatExpiry(until), token.ExpiresAt))
+	default:
+		msgs = append(msgs, fmt.Sprintf("%s Token expires in %s, on %s.", c.GreenCheck(), formatExpiry(until), token.ExpiresAt))
+	}
+	return msgs
+}
+
+// tlsInfo returns the verification and the expiry of the TLS certificate of a host, and the
+// expiry of its client certificate. Connection errors are reported by the API call.
+func tlsInfo(io *iostreams.IOStreams, cfg config.Config, instance string, apiClient *api.Client) []string {
+	c := io.Color()
+	var msgs []string
+
+	if state, err := apiClient.TLSState(); err == nil && state != nil && len(state.PeerCertificates) > 0 {
+		cert := state.PeerCertificates[0]
+		if len(state.VerifiedChains) == 0 {
+			msgs = append(msgs, fmt.Sprintf("%s TLS certificate of %s is not verified, because skip_tls_verify is set.", c.WarnIcon(), instance))
+		} else {
+			chain := state.VerifiedChains[0]
+			msgs = append(msgs, fmt.Sprintf("%s TLS certificate of %s verified by %s.", c.GreenCheck(), instance, chain[len(chain)-1].Subject.CommonName))
+		}
+		msgs = append(msgs, certificateExpiry(c, "TLS certificate of "+instance, cert))
+	}
+
+	if certFile, _ := cfg.Get(instance, "client_cert"); certFile != "" {
+		cert, err := api.ParseCertificateFile(certFile)
+		if err != nil {
+			msgs = append(msgs, fmt.Sprintf("%s Client certificate %s can't be read: %s", c.FailedIcon(), certFile, err))
+		} else {
+			msgs = append(msgs, certificateExpiry(c, "Client certificate "+certFile, cert))
+		}
+	}
+
+	if proxy, _ := cfg.Get(instance, "proxy"); proxy != "" {
+		// the password of the proxy must not be shown
+		if u, err := url.Parse(proxy); err == nil {
+			proxy = u.Redacted()
+		}
+		msgs = append(msgs, fmt.Sprintf("%s Proxy: %s", c.GreenCheck(), proxy))
+	}
+	return msgs
+}
+
+func certificateExpiry(c *iostreams.ColorPalette, name string, cert *x509.Certificate) string {
+	date := cert.NotAfter.Format(time.DateOnly)
+	until := time.Until(cert.NotAfter)
+	switch {
+	case until < 0:
+		return fmt.Sprintf("%s %s expired on %s.", c.FailedIcon(), name, date)
+	case until < certExpiryWarning:
+		return fmt.Sprintf("%s %s expires in %s, on %s.", c.WarnIcon(), name, formatExpiry(until), date)
+	}
+	return fmt.Sprintf("%s %s expires in %s, on %s.", c.GreenCheck(), name, formatExpiry(until), date)
+}
+
+// certExpiryWarning is how long before their expiry certificates are reported with a warning.
+const certExpiryWarning = 30 * 24 * time.Hour
+
+// tokenExpiryWarning is how long before their expiry tokens are reported with a warning.
+const tokenExpiryWarning = 7 * 24 * time.Hour
+
+func formatExpiry(d time.Duration) string {
+	if d >= 48*time.Hour {
+		return utils.Pluralize(int(d.Hours()/24), "day")
+	}
+	if d < time.Minute {
+		return "less than a minute"
+	}
+	return utils.FormatSeconds(int(d.Seconds()))
+}
@@ -0,0 +1,107 @@
+package authswitch
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/pkg/glinstance"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/pkg/prompt"
+)
+
+type SwitchOpts struct {
+	Hostname string
+	User     string
+
+	IO     *iostreams.IOStreams
+	Config func() (config.Config, error)
+}
+
+func NewCmdSwitch(f *cmdutils.Factory) *cobra.Command {
+	opts := &SwitchOpts{
+		IO:     f.IO,
+		Config: f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "switch",
+		Args:  cobra.ExactArgs(0),
+		Short: "Switch the active account of a GitLab instance.",
+		Long: heredoc.Doc(`
+			Switch the active account of a GitLab instance. Add accounts with
+			'glab auth login --account <name>'.
+
+			With two accounts, the command switches to the other account. With more
+			accounts, it prompts for the account, unless you select it with --user.
+
+			To use an account for a single command, set GLAB_ACCOUNT. To use an account
+			in a repository, run 'glab config set account <name>'.
+		`),
+		Example: heredoc.Doc(`
+			glab auth switch
+			glab auth switch --user work
+			glab auth switch --hostname gitlab.example.org --user admin
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Hostname == "" {
+				opts.Hostname = glinstance.OverridableDefault()
+			}
+
+			return switchRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Hostname, "hostname", "h", "", "The hostname of the GitLab instance.")
+	cmd.Flags().StringVarP(&opts.User, "user", "u", "", "The account to switch to.")
+
+	return cmd
+}
+
+func switchRun(opts *SwitchOpts) error {
+	c := opts.IO.Color()
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	accounts, err := cfg.Accounts(opts.Hostname)
+	if err != nil {
+		return err
+	}
+	if len(accounts) == 0 {
+		return fmt.Errorf("no accounts found for %s. Run `glab auth login --hostname %s --account <name>` to add one.", opts.Hostname, opts.Hostname)
+	}
+
+	account := opts.User
+	active := cfg.Account(opts.Hostname)
+	switch {
+	case account != "":
+	case len(accounts) == 2:
+		account = accounts[0]
+		if account == active {
+			account = accounts[1]
+		}
+	case opts.IO.PromptEnabled():
+		err := prompt.Select(&account, "account", fmt.Sprintf("Switch to which account of %s?", opts.Hostname), accounts)
+		if err != nil {
+			return fmt.Errorf("could not prompt: %w", err)
+		}
+	default:
+		return &cmdutils.FlagError{Err: errors.New("--user required when not running interactively.")}
+	}
+
+	if err := cfg.SwitchAccount(opts.Hostname, account); err != nil {
+		return err
+	}
+	if err := cfg.Write(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(opts.IO.StdErr, "%s Switched active account for %s to %s.\n", c.GreenCheck(), opts.Hostname, c.Bold(account))
+	return nil
+}
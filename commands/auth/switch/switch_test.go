@@ -0,0 +1,100 @@
+package authswitch
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+func Test_switchRun(t *testing.T) {
+	defer config.StubConfig(`---
+hosts:
+  gitlab.com:
+    account: alice
+    accounts:
+      alice:
+        token: glpat-alice
+      bot:
+        token: glpat-bot
+  gitlab.example.org:
+    account: alice
+    accounts:
+      alice:
+        token: glpat-alice
+      bot:
+        token: glpat-bot
+      admin:
+        token: glpat-admin
+  gitlab.single.org:
+    token: glpat-single
+`, "")()
+
+	tests := []struct {
+		name       string
+		hostname   string
+		user       string
+		wantErr    string
+		wantActive string
+	}{
+		{
+			name:       "toggle between two accounts",
+			hostname:   "gitlab.com",
+			wantActive: "bot",
+		},
+		{
+			name:       "select the account",
+			hostname:   "gitlab.example.org",
+			user:       "admin",
+			wantActive: "admin",
+		},
+		{
+			name:     "several accounts without --user",
+			hostname: "gitlab.example.org",
+			wantErr:  "--user required when not running interactively.",
+		},
+		{
+			name:     "unknown account",
+			hostname: "gitlab.com",
+			user:     "admin",
+			wantErr:  `account "admin" not found for gitlab.com.`,
+		},
+		{
+			name:     "host without accounts",
+			hostname: "gitlab.single.org",
+			wantErr:  "no accounts found for gitlab.single.org.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			defer config.StubWriteConfig(&bytes.Buffer{}, &bytes.Buffer{})()
+
+			cfg, err := config.ParseConfig("config.yml")
+			require.NoError(t, err)
+
+			io, _, _, stderr := iostreams.Test()
+			io.SetPrompt("true")
+			err = switchRun(&SwitchOpts{
+				Hostname: tc.hostname,
+				User:     tc.user,
+				IO:       io,
+				Config: func() (config.Config, error) {
+					return cfg, nil
+				},
+			})
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantActive, cfg.Account(tc.hostname))
+			assert.Equal(t, "✓ Switched active account for "+tc.hostname+" to "+tc.wantActive+".\n", stderr.String())
+		})
+	}
+}
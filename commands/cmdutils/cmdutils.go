@@ -34,8 +34,7 @@ const (
 // LoadGitLabTemplate finds and loads the GitLab template from the working git directory
 // Follows the format officially supported by GitLab
 // https://docs.gitlab.com/ee/user/project/description_templates.html#setting-a-default-template-for-issues-and-merge-requests.
-//
-// TODO: load from remote repository if repo is overridden by -R flag
+// Use LoadTemplate to also load the templates of the remote project.
 func LoadGitLabTemplate(tmplType, tmplName string) (string, error) {
 	wdir, err := git.ToplevelDir()
 	if err != nil {
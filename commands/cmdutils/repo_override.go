@@ -23,3 +23,12 @@ func EnableRepoOverride(cmd *cobra.Command, f *Factory) {
 		return nil
 	}
 }
+
+// IsRepoOverridden returns true when the repository is set with the --repo flag or the
+// GITLAB_REPO environment variable, instead of the git repository of the working directory.
+func IsRepoOverridden(cmd *cobra.Command) bool {
+	if cmd.Flags().Lookup("repo") != nil && cmd.Flags().Changed("repo") {
+		return true
+	}
+	return os.Getenv("GITLAB_REPO") != ""
+}
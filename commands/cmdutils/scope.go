@@ -0,0 +1,52 @@
+package cmdutils
+
+import (
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/flag"
+)
+
+// Scope is the project or the group that the resources of a command, like
+// milestones, issue boards, or iterations, belong to.
+type Scope struct {
+	Group   string
+	Project string
+}
+
+// IsGroup returns true for the resources of a group.
+func (s Scope) IsGroup() bool {
+	return s.Group != ""
+}
+
+// String returns the full path of the project or group.
+func (s Scope) String() string {
+	if s.IsGroup() {
+		return s.Group
+	}
+	return s.Project
+}
+
+// EnableGroupFlag adds the --group flag to select the resources of a group
+// instead of the project. resources names them in the flag description, like "milestones".
+func EnableGroupFlag(cmd *cobra.Command, resources string) {
+	cmd.Flags().StringP("group", "g", "", "Select the "+resources+" of a group or subgroup. Ignored if a repository argument is set.")
+}
+
+// ScopeFromCmd returns the scope of the resources for the command: the group
+// of the --group flag or the GITLAB_GROUP environment variable, or the project.
+func ScopeFromCmd(cmd *cobra.Command, f *Factory) (Scope, error) {
+	group, err := flag.GroupOverride(cmd)
+	if err != nil {
+		return Scope{}, err
+	}
+	if group != "" {
+		return Scope{Group: group}, nil
+	}
+
+	repo, err := f.BaseRepo()
+	if err != nil {
+		return Scope{}, err
+	}
+
+	return Scope{Project: repo.FullName()}, nil
+}
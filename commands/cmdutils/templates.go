@@ -0,0 +1,208 @@
+package cmdutils
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+// templateAPIType returns the type of the project templates API for a type of template.
+func templateAPIType(tmplType string) string {
+	if tmplType == MergeRequestTemplate {
+		return api.MergeRequestTemplates
+	}
+	return api.IssueTemplates
+}
+
+// ListTemplates returns the names of the description templates of a type: the templates
+// of the local checkout, and the templates of the project, its group, and the instance.
+// When remoteOnly is set, like when the repository is set with --repo, the local
+// checkout is ignored.
+func ListTemplates(client *gitlab.Client, repo glrepo.Interface, tmplType string, remoteOnly bool) ([]string, error) {
+	var names []string
+	if !remoteOnly {
+		names, _ = ListGitLabTemplates(tmplType)
+	}
+
+	templates, err := api.ListProjectTemplates(client, repo.FullName(), templateAPIType(tmplType))
+	if err != nil {
+		// like for the local templates, the templates are optional
+		if !remoteOnly {
+			return names, nil
+		}
+		return nil, err
+	}
+
+	for _, t := range templates {
+		name := t.Name
+		if name == "" {
+			name = t.Key
+		}
+		if !utils.PresentInStringSlice(names, name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// LoadTemplate returns the content of the description template with the given name,
+// from the local checkout or, when it is not there, from the project templates API.
+// When remoteOnly is set, the local checkout is ignored.
+func LoadTemplate(client *gitlab.Client, repo glrepo.Interface, tmplType, tmplName string, remoteOnly bool) (string, error) {
+	if !remoteOnly {
+		content, err := LoadGitLabTemplate(tmplType, tmplName)
+		if err == nil && content != "" {
+			return content, nil
+		}
+	}
+
+	template, err := api.GetProjectTemplate(client, repo.FullName(), templateAPIType(tmplType), strings.TrimSuffix(tmplName, ".md"))
+	if err != nil {
+		if api.Is404(err) {
+			return "", fmt.Errorf("template %q not found for %s.", tmplName, repo.FullName())
+		}
+		return "", fmt.Errorf("failed to get template %q: %w", tmplName, err)
+	}
+	return strings.TrimSpace(template.Content), nil
+}
+
+// QuickActions is the metadata set by the quick actions of a description, like `/label ~bug`.
+type QuickActions struct {
+	Labels       []string
+	Assignees    []string
+	Reviewers    []string
+	Milestone    string
+	Weight       int
+	DueDate      string
+	Confidential bool
+	Draft        bool
+}
+
+var (
+	quickActionRE = regexp.MustCompile(`^/([a-z_]+)(?:\s+(.*))?$`)
+	// quickActionArgRE matches the arguments of quick actions: ~label, ~"label with spaces",
+	// @user, %milestone, or %"milestone with spaces".
+	quickActionArgRE = regexp.MustCompile(`[~@%]?"[^"]*"|[^\s,]+`)
+)
+
+// ParseQuickActions removes from a description the quick actions that set metadata
+// for the type of template, and returns them. The other quick actions, and the quick
+// actions glab can't apply, like `/assign me`, are kept for GitLab to apply.
+func ParseQuickActions(description, tmplType string) (string, QuickActions) {
+	var qa QuickActions
+	var kept []string
+	inCodeBlock := false
+
+	for _, line := range strings.Split(description, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inCodeBlock = !inCodeBlock
+		}
+
+		m := quickActionRE.FindStringSubmatch(trimmed)
+		if inCodeBlock || m == nil || !qa.apply(m[1], quickActionArgs(m[2]), tmplType) {
+			kept = append(kept, line)
+		}
+	}
+
+	return strings.TrimSpace(strings.Join(kept, "\n")), qa
+}
+
+// apply sets the metadata of a quick action, and returns false if glab can't apply it.
+func (qa *QuickActions) apply(action string, args []string, tmplType string) bool {
+	isMR := tmplType == MergeRequestTemplate
+
+	switch action {
+	case "label", "labels":
+		if len(args) == 0 {
+			return false
+		}
+		for _, a := range args {
+			qa.Labels = appendUnique(qa.Labels, strings.TrimPrefix(a, "~"))
+		}
+	case "assign":
+		return qa.addUsers(&qa.Assignees, args)
+	case "assign_reviewer", "reviewer", "request_review":
+		return isMR && qa.addUsers(&qa.Reviewers, args)
+	case "milestone":
+		if len(args) != 1 {
+			return false
+		}
+		qa.Milestone = strings.TrimPrefix(args[0], "%")
+	case "weight":
+		if isMR || len(args) != 1 {
+			return false
+		}
+		weight, err := strconv.Atoi(args[0])
+		if err != nil || weight < 0 {
+			return false
+		}
+		qa.Weight = weight
+	case "due":
+		if isMR || len(args) != 1 {
+			return false
+		}
+		// only dates are applied: relative dates, like "in 2 days", are left to GitLab
+		if _, err := gitlab.ParseISOTime(args[0]); err != nil {
+			return false
+		}
+		qa.DueDate = args[0]
+	case "confidential":
+		if isMR || len(args) != 0 {
+			return false
+		}
+		qa.Confidential = true
+	case "draft":
+		if !isMR || len(args) != 0 {
+			return false
+		}
+		qa.Draft = true
+	default:
+		return false
+	}
+	return true
+}
+
+func (qa *QuickActions) addUsers(users *[]string, args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+	for _, a := range args {
+		// the current user is only known to GitLab
+		if a == "me" || a == "@me" {
+			return false
+		}
+	}
+	for _, a := range args {
+		*users = appendUnique(*users, strings.TrimPrefix(a, "@"))
+	}
+	return true
+}
+
+// quickActionArgs splits the arguments of a quick action, separated by spaces or commas,
+// and removes the quotes around names with spaces.
+func quickActionArgs(s string) []string {
+	var args []string
+	for _, arg := range quickActionArgRE.FindAllString(s, -1) {
+		if arg = strings.ReplaceAll(arg, `"`, ""); arg != "" {
+			args = append(args, arg)
+		}
+	}
+	return args
+}
+
+func appendUnique(s []string, v string) []string {
+	if utils.PresentInStringSlice(s, v) {
+		return s
+	}
+	return append(s, v)
+}
@@ -0,0 +1,144 @@
+package cmdutils
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/pkg/git"
+)
+
+func TestParseQuickActions(t *testing.T) {
+	tests := []struct {
+		name            string
+		tmplType        string
+		description     string
+		wantDescription string
+		want            QuickActions
+	}{
+		{
+			name:     "issue template",
+			tmplType: IssueTemplate,
+			description: heredoc.Doc(`
+				## Summary
+
+				/label ~bug ~"needs triage"
+				/assign @alice, @bob
+				/milestone %"Release 1.0"
+				/weight 3
+				/due 2025-07-01
+				/confidential
+				/cc @carol
+			`),
+			wantDescription: "## Summary\n\n/cc @carol",
+			want: QuickActions{
+				Labels:       []string{"bug", "needs triage"},
+				Assignees:    []string{"alice", "bob"},
+				Milestone:    "Release 1.0",
+				Weight:       3,
+				DueDate:      "2025-07-01",
+				Confidential: true,
+			},
+		},
+		{
+			name:     "merge request template",
+			tmplType: MergeRequestTemplate,
+			description: heredoc.Doc(`
+				/labels ~feature
+				/assign_reviewer @alice
+				/draft
+				/weight 3
+			`),
+			wantDescription: "/weight 3",
+			want: QuickActions{
+				Labels:    []string{"feature"},
+				Reviewers: []string{"alice"},
+				Draft:     true,
+			},
+		},
+		{
+			name:     "quick actions left to GitLab",
+			tmplType: IssueTemplate,
+			description: heredoc.Doc(`
+				/assign me
+				/due in 2 days
+				/label
+				` + "```" + `
+				/label ~example
+				` + "```" + `
+			`),
+			wantDescription: "/assign me\n/due in 2 days\n/label\n```\n/label ~example\n```",
+			want:            QuickActions{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			description, qa := ParseQuickActions(tc.description, tc.tmplType)
+			assert.Equal(t, tc.wantDescription, description)
+			assert.Equal(t, tc.want, qa)
+		})
+	}
+}
+
+func TestListTemplates(t *testing.T) {
+	git.ToplevelDir = func() (string, error) { return "../../test/testdata", nil }
+	repo := glrepo.New("OWNER", "REPO")
+
+	var listErr error
+	api.ListProjectTemplates = func(client *gitlab.Client, projectID interface{}, templateType string) ([]*gitlab.ProjectTemplate, error) {
+		assert.Equal(t, api.IssueTemplates, templateType)
+		return []*gitlab.ProjectTemplate{{Key: "Bug", Name: "Bug"}, {Key: "Incident", Name: "Incident"}}, listErr
+	}
+
+	names, err := ListTemplates(nil, repo, IssueTemplate, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Bug", "Feature Request", "Incident"}, names)
+
+	names, err = ListTemplates(nil, repo, IssueTemplate, true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Bug", "Incident"}, names)
+
+	listErr = errors.New("403 Forbidden")
+	names, err = ListTemplates(nil, repo, IssueTemplate, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Bug", "Feature Request"}, names)
+
+	_, err = ListTemplates(nil, repo, IssueTemplate, true)
+	require.Error(t, err)
+}
+
+func TestLoadTemplate(t *testing.T) {
+	git.ToplevelDir = func() (string, error) { return "../../test/testdata", nil }
+	repo := glrepo.New("OWNER", "REPO")
+
+	api.GetProjectTemplate = func(client *gitlab.Client, projectID interface{}, templateType, name string) (*gitlab.ProjectTemplate, error) {
+		assert.Equal(t, api.MergeRequestTemplates, templateType)
+		if name == "Missing" {
+			return nil, &gitlab.ErrorResponse{Response: &http.Response{StatusCode: http.StatusNotFound}}
+		}
+		return &gitlab.ProjectTemplate{Name: name, Content: "remote " + name + "\n"}, nil
+	}
+
+	content, err := LoadTemplate(nil, repo, MergeRequestTemplate, "Default", false)
+	require.NoError(t, err)
+	assert.NotContains(t, content, "remote")
+
+	content, err = LoadTemplate(nil, repo, MergeRequestTemplate, "Default", true)
+	require.NoError(t, err)
+	assert.Equal(t, "remote Default", content)
+
+	content, err = LoadTemplate(nil, repo, MergeRequestTemplate, "Group template.md", false)
+	require.NoError(t, err)
+	assert.Equal(t, "remote Group template", content)
+
+	_, err = LoadTemplate(nil, repo, MergeRequestTemplate, "Missing", false)
+	require.EqualError(t, err, `template "Missing" not found for OWNER/REPO.`)
+}
@@ -1,12 +1,21 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/spf13/cobra"
 
 	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/pkg/surveyext"
+	"gitlab.com/gitlab-org/cli/pkg/tableprinter"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
 )
 
 var isGlobal bool
@@ -28,6 +37,26 @@ Current respected settings:
 - glab_pager: Your desired pager command to use, such as 'less -R'.
 - check_update: If true, notifies of new versions of glab. Defaults to true.
 - display_hyperlinks: If true, and using a TTY, outputs hyperlinks for issues and merge request lists. Defaults to false.
+- group: The default group of the projects created with %[1]sglab repo create%[1]s.
+- mr_labels: The comma-separated labels of the merge requests created with %[1]sglab mr create%[1]s.
+- mr_reviewers: The comma-separated usernames of the reviewers of the merge requests created with %[1]sglab mr create%[1]s.
+
+Settings are read, from the highest precedence to the lowest, from:
+
+1. Environment variables.
+2. The settings of the host in the global configuration file, with %[1]s--host%[1]s.
+3. The repository's %[1]s.git/glab-cli/config.yml%[1]s file.
+4. %[1]s.glab.yml%[1]s files in the working directory and its parent directories, the nearest first.
+   In each file, the settings of the host, in its %[1]shosts%[1]s section, take precedence.
+   Only %[1]sgit_protocol%[1]s, %[1]sremote_alias%[1]s, %[1]sbranch_prefix%[1]s, %[1]sgroup%[1]s, %[1]smr_labels%[1]s,
+   %[1]smr_reviewers%[1]s, and %[1]sdisplay_hyperlinks%[1]s are read from %[1]s.glab.yml%[1]s files.
+5. The global configuration file, %[1]s~/.config/glab-cli/config.yml%[1]s.
+6. The default values.
+
+Run %[1]sglab config get <key> --show-origin%[1]s to show where a value comes from.
+
+Keys and values are validated when they are set. Run %[1]sglab config list%[1]s to show
+all the settings, with their values and where they are set.
 `, "`"),
 		Aliases: []string{"conf"},
 	}
@@ -36,12 +65,16 @@ Current respected settings:
 
 	configCmd.AddCommand(NewCmdConfigGet(f))
 	configCmd.AddCommand(NewCmdConfigSet(f))
+	configCmd.AddCommand(NewCmdConfigUnset(f))
+	configCmd.AddCommand(NewCmdConfigList(f))
+	configCmd.AddCommand(NewCmdConfigEdit(f))
 
 	return configCmd
 }
 
 func NewCmdConfigGet(f *cmdutils.Factory) *cobra.Command {
 	var hostname string
+	var showOrigin bool
 
 	cmd := &cobra.Command{
 		Use:   "get <key>",
@@ -52,14 +85,29 @@ func NewCmdConfigGet(f *cmdutils.Factory) *cobra.Command {
   vim
   $ glab config get glamour_style
   notty
+  $ glab config get git_protocol --show-origin
+  file:/home/user/work/.glab.yml	https
 `,
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeKeys,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cfg, err := f.Config()
 			if err != nil {
 				return err
 			}
 
+			if showOrigin {
+				val, source, err := cfg.GetWithSource(hostname, args[0], true)
+				if err != nil {
+					return err
+				}
+				if config.ConfigKeyEquivalence(args[0]) == "proxy" {
+					val = redactProxy(val)
+				}
+				fmt.Fprintf(f.IO.StdOut, "%s\t%s\n", origin(args[0], source), val)
+				return nil
+			}
+
 			val, err := cfg.Get(hostname, args[0])
 			if err != nil {
 				return err
@@ -73,6 +121,7 @@ func NewCmdConfigGet(f *cmdutils.Factory) *cobra.Command {
 	}
 
 	cmd.Flags().StringVarP(&hostname, "host", "h", "", "Get per-host setting.")
+	cmd.Flags().BoolVar(&showOrigin, "show-origin", false, "Print where the value comes from: a file, an environment variable, or the default value.")
 	cmd.Flags().BoolP("global", "g", false, "Read from global config file (~/.config/glab-cli/config.yml). Default: checks 'Environment variables → Local → Global'.")
 
 	return cmd
@@ -93,7 +142,8 @@ Specifying the '--hostname' flag also saves in the global configuration file.
   glab config set token xxxxx -h gitlab.com
   glab config set check_update false --global
 `,
-		Args: cobra.ExactArgs(2),
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completeKeys,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cfg, err := f.Config()
 			if err != nil {
@@ -103,6 +153,10 @@ Specifying the '--hostname' flag also saves in the global configuration file.
 			localCfg, _ := cfg.Local()
 
 			key, value := args[0], args[1]
+			if err := config.ValidateKey(key, value); err != nil {
+				return err
+			}
+
 			if isGlobal || hostname != "" {
 				err = cfg.Set(hostname, key, value)
 			} else {
@@ -130,3 +184,275 @@ Specifying the '--hostname' flag also saves in the global configuration file.
 	cmd.Flags().BoolVarP(&isGlobal, "global", "g", false, "Write to global '~/.config/glab-cli/config.yml' file rather than the repository's '.git/glab-cli/config.yml' file.")
 	return cmd
 }
+
+func NewCmdConfigUnset(f *cmdutils.Factory) *cobra.Command {
+	var hostname string
+	var global bool
+
+	cmd := &cobra.Command{
+		Use:   "unset <key>",
+		Short: "Removes a key from the configuration.",
+		Long: heredoc.Doc(`
+			Remove a key from the configuration, so its default value is used.
+			Use 'glab config unset --global' to remove a key from the global config.
+			Specifying the '--host' flag removes the key of the host from the global configuration file.
+		`),
+		Example: heredoc.Doc(`
+			glab config unset editor
+			glab config unset git_protocol -h gitlab.example.org
+			glab config unset check_update --global
+		`),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeKeys,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := f.Config()
+			if err != nil {
+				return err
+			}
+
+			key := args[0]
+			if !global && hostname == "" {
+				localCfg, err := cfg.Local()
+				if err != nil {
+					return err
+				}
+				if err := localCfg.Delete(config.ConfigKeyEquivalence(key)); err != nil {
+					return fmt.Errorf("failed to write configuration to disk: %w", err)
+				}
+				return nil
+			}
+
+			if err := cfg.Unset(hostname, key); err != nil {
+				return fmt.Errorf("failed to unset %q: %w", key, err)
+			}
+			if err := cfg.Write(); err != nil {
+				return fmt.Errorf("failed to write configuration to disk: %w", err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&hostname, "host", "h", "", "Remove per-host setting.")
+	cmd.Flags().BoolVarP(&global, "global", "g", false, "Remove from global '~/.config/glab-cli/config.yml' file rather than the repository's '.git/glab-cli/config.yml' file.")
+	return cmd
+}
+
+func NewCmdConfigList(f *cmdutils.Factory) *cobra.Command {
+	var hostname string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Lists the effective configuration, and where each value is set.",
+		Long: heredoc.Doc(`
+			List the value of each configuration key, and its source:
+
+			- env: an environment variable, named in brackets.
+			- local: the repository's '.git/glab-cli/config.yml' file.
+			- profile: a '.glab.yml' file of the working directory or of its parents, named in brackets.
+			- global: the global '~/.config/glab-cli/config.yml' file.
+			- host: the configuration of the host in the global file, with '--host'.
+			- default: the key isn't set.
+
+			Tokens kept in a credential store show the name of the store as their source.
+		`),
+		Example: heredoc.Doc(`
+			glab config list
+			glab config list -h gitlab.example.org
+		`),
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := f.Config()
+			if err != nil {
+				return err
+			}
+
+			table := tableprinter.NewTablePrinter()
+			table.AddRow("KEY", "VALUE", "SOURCE")
+			for _, key := range config.KnownKeys {
+				if key.Internal || config.ConfigKeyEquivalence(key.Name) != key.Name {
+					continue
+				}
+				if hostname == "" && key.Host && key.Name != "token" {
+					// keys of hosts only have a value with --host
+					if _, source, _ := cfg.GetWithSource("", key.Name, true); source == config.DefaultSource {
+						continue
+					}
+				}
+
+				value, source, _ := cfg.GetWithSource(hostname, key.Name, true)
+				if key.Name == "token" && value != "" {
+					value = maskToken(value)
+				}
+				if key.Name == "proxy" {
+					value = redactProxy(value)
+				}
+				table.AddRow(key.Name, value, describeSource(cfg, hostname, key.Name, value, source))
+			}
+			fmt.Fprint(f.IO.StdOut, table.Render())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&hostname, "host", "h", "", "List the configuration of a host.")
+	return cmd
+}
+
+// describeSource returns the kind of the source of a value returned by GetWithSource.
+func describeSource(cfg config.Config, hostname, key, value, source string) string {
+	switch source {
+	case config.DefaultSource, "":
+		return "default"
+	case config.LocalConfigFile():
+		return "local"
+	case config.ConfigFile():
+		if hostname == "" {
+			return "global"
+		}
+		// the values of hosts and the global values are in the same file
+		globalValue, globalSource, _ := cfg.GetWithSource("", key, false)
+		if globalSource == config.ConfigFile() && globalValue == value {
+			return "global"
+		}
+		return "host"
+	}
+	if isProfile(source) {
+		return fmt.Sprintf("profile (%s)", source)
+	}
+	if isEnv(key, source) {
+		return fmt.Sprintf("env (%s)", source)
+	}
+	return source
+}
+
+// origin returns where a value returned by GetWithSource comes from, like 'git config --show-origin'.
+func origin(key, source string) string {
+	switch {
+	case source == config.DefaultSource || source == "":
+		return "default"
+	case source == config.ConfigFile() || source == config.LocalConfigFile() || isProfile(source):
+		return "file:" + source
+	case isEnv(key, source):
+		return "env:" + source
+	}
+	// the keyring, and the credential stores
+	return source
+}
+
+func isProfile(source string) bool {
+	return filepath.Base(source) == config.ProfileFileName
+}
+
+func isEnv(key, source string) bool {
+	for _, name := range config.EnvKeyEquivalence(key) {
+		if name == source {
+			return true
+		}
+	}
+	return false
+}
+
+// maskToken hides all but the last characters of a token.
+func maskToken(token string) string {
+	if len(token) <= 8 {
+		return strings.Repeat("*", len(token))
+	}
+	return strings.Repeat("*", len(token)-4) + token[len(token)-4:]
+}
+
+// redactProxy hides the password of the URL of a proxy.
+func redactProxy(proxy string) string {
+	if u, err := url.Parse(proxy); err == nil {
+		return u.Redacted()
+	}
+	return proxy
+}
+
+func NewCmdConfigEdit(f *cmdutils.Factory) *cobra.Command {
+	var local bool
+
+	cmd := &cobra.Command{
+		Use:   "edit",
+		Short: "Opens the configuration file in an editor.",
+		Long: heredoc.Doc(`
+			Open the global configuration file in your editor. Use '--local' to edit the
+			repository's '.git/glab-cli/config.yml' file instead.
+
+			The file is validated after you save it. A file that isn't valid YAML isn't saved.
+			Unknown keys and invalid values are reported, so you can fix them.
+		`),
+		Example: heredoc.Doc(`
+			glab config edit
+			glab config edit --local
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			filename := config.ConfigFile()
+			if local {
+				filename = config.LocalConfigFile()
+			}
+
+			data, err := os.ReadFile(filename)
+			if err != nil && !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+
+			editor, err := cmdutils.GetEditor(f.Config)
+			if err != nil {
+				return err
+			}
+			edited, err := surveyext.Edit(editor, "config*.yml", string(data), f.IO.In, f.IO.StdOut, f.IO.StdErr, nil)
+			if err != nil {
+				return err
+			}
+			if edited == string(data) {
+				return nil
+			}
+
+			errs, err := config.ValidateData([]byte(edited))
+			if err != nil {
+				return fmt.Errorf("the configuration wasn't saved because it isn't valid: %w", err)
+			}
+			if err := config.WriteConfigFile(filename, []byte(edited)); err != nil {
+				return fmt.Errorf("failed to write configuration to disk: %w", err)
+			}
+
+			if len(errs) == 0 {
+				return nil
+			}
+			c := f.IO.Color()
+			for _, err := range errs {
+				fmt.Fprintf(f.IO.StdErr, "%s %s\n", c.FailedIcon(), err)
+			}
+			return fmt.Errorf("%s saved with %s. Run 'glab config edit' to fix them.", filename, utils.Pluralize(len(errs), "invalid key"))
+		},
+	}
+
+	cmd.Flags().BoolVarP(&local, "local", "l", false, "Edit the repository's '.git/glab-cli/config.yml' file.")
+	return cmd
+}
+
+// completeKeys completes the known keys, and the values of the key.
+func completeKeys(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) == 0 {
+		var keys []string
+		for _, key := range config.KnownKeys {
+			if !key.Internal {
+				keys = append(keys, key.Name+"\t"+key.Description)
+			}
+		}
+		return keys, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	if len(args) == 1 && cmd.Name() == "set" {
+		if key, ok := config.LookupKey(args[0]); ok && len(key.Values) > 0 {
+			directive := cobra.ShellCompDirectiveNoFileComp
+			if key.Validate != nil {
+				directive = cobra.ShellCompDirectiveDefault
+			}
+			return key.Values, directive
+		}
+		return nil, cobra.ShellCompDirectiveDefault
+	}
+	return nil, cobra.ShellCompDirectiveNoFileComp
+}
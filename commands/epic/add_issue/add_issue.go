@@ -0,0 +1,63 @@
+package add_issue
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/epic/epicutils"
+	"gitlab.com/gitlab-org/cli/commands/issue/issueutils"
+)
+
+func NewCmdAddIssue(f *cmdutils.Factory) *cobra.Command {
+	epicAddIssueCmd := &cobra.Command{
+		Use:   "add-issue <epic> <issue>... [flags]",
+		Short: `Add issues to an epic.`,
+		Long: heredoc.Doc(`
+			Add issues to an epic. Issues are set by IID in the current project, or by URL.
+			An issue that belongs to another epic is moved to this epic.
+		`),
+		Example: heredoc.Doc(`
+			glab epic add-issue 12 34 35
+			glab epic add-issue &12 https://gitlab.com/my-group/my-project/-/issues/34
+		`),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			iid, err := epicutils.ParseIID(args[0])
+			if err != nil {
+				return err
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			group, err := epicutils.GroupFromCmd(cmd, f)
+			if err != nil {
+				return err
+			}
+
+			issues, _, err := issueutils.IssuesFromArgs(apiClient, f.BaseRepo, args[1:])
+			if err != nil {
+				return err
+			}
+
+			c := f.IO.Color()
+			for _, issue := range issues {
+				if _, err := api.AssignEpicIssue(apiClient, group, iid, issue.ID); err != nil {
+					return fmt.Errorf("adding issue #%d to epic &%d: %w", issue.IID, iid, err)
+				}
+				fmt.Fprintf(f.IO.StdOut, "%s Added issue #%d to epic &%d.\n", c.GreenCheck(), issue.IID, iid)
+			}
+			return nil
+		},
+	}
+
+	cmdutils.EnableGroupFlag(epicAddIssueCmd, "epics")
+
+	return epicAddIssueCmd
+}
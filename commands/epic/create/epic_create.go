@@ -0,0 +1,110 @@
+package create
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/epic/epicutils"
+)
+
+type options struct {
+	title        string
+	description  string
+	labels       []string
+	parent       string
+	confidential bool
+	startDate    string
+	dueDate      string
+}
+
+func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
+	opts := &options{}
+
+	epicCreateCmd := &cobra.Command{
+		Use:     "create [flags]",
+		Short:   `Create an epic in a group.`,
+		Aliases: []string{"new"},
+		Example: heredoc.Doc(`
+			glab epic create --title "Q3 roadmap" --label planning
+			glab epic create --title "Search" --parent 12 --start-date 2025-07-01 --due-date 2025-09-30
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			createOpts := &gitlab.CreateEpicOptions{Title: gitlab.Ptr(opts.title)}
+			if opts.description != "" {
+				createOpts.Description = gitlab.Ptr(opts.description)
+			}
+			if len(opts.labels) > 0 {
+				labels := gitlab.LabelOptions(opts.labels)
+				createOpts.Labels = &labels
+			}
+			if opts.confidential {
+				createOpts.Confidential = gitlab.Ptr(true)
+			}
+			if opts.startDate != "" {
+				startDate, err := gitlab.ParseISOTime(opts.startDate)
+				if err != nil {
+					return &cmdutils.FlagError{Err: fmt.Errorf("invalid --start-date %q: use the YYYY-MM-DD format.", opts.startDate)}
+				}
+				createOpts.StartDateIsFixed = gitlab.Ptr(true)
+				createOpts.StartDateFixed = &startDate
+			}
+			if opts.dueDate != "" {
+				dueDate, err := gitlab.ParseISOTime(opts.dueDate)
+				if err != nil {
+					return &cmdutils.FlagError{Err: fmt.Errorf("invalid --due-date %q: use the YYYY-MM-DD format.", opts.dueDate)}
+				}
+				createOpts.DueDateIsFixed = gitlab.Ptr(true)
+				createOpts.DueDateFixed = &dueDate
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			group, err := epicutils.GroupFromCmd(cmd, f)
+			if err != nil {
+				return err
+			}
+
+			if opts.parent != "" {
+				parentIID, err := epicutils.ParseIID(opts.parent)
+				if err != nil {
+					return err
+				}
+				parent, err := api.GetEpic(apiClient, group, parentIID)
+				if err != nil {
+					return fmt.Errorf("finding parent epic %s: %w", opts.parent, err)
+				}
+				createOpts.ParentID = gitlab.Ptr(parent.ID)
+			}
+
+			epic, err := api.CreateEpic(apiClient, group, createOpts)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(f.IO.StdOut, "%s Created epic %s %q in %s.\n", f.IO.Color().GreenCheck(), epicutils.Reference(epic), epic.Title, group)
+			fmt.Fprintln(f.IO.StdOut, epic.WebURL)
+			return nil
+		},
+	}
+
+	cmdutils.EnableGroupFlag(epicCreateCmd, "epics")
+	epicCreateCmd.Flags().StringVarP(&opts.title, "title", "t", "", "Title of the epic.")
+	epicCreateCmd.Flags().StringVarP(&opts.description, "description", "d", "", "Description of the epic.")
+	epicCreateCmd.Flags().StringSliceVarP(&opts.labels, "label", "l", []string{}, "Labels of the epic, comma separated.")
+	epicCreateCmd.Flags().StringVar(&opts.parent, "parent", "", "IID of the parent epic, to create a child epic.")
+	epicCreateCmd.Flags().BoolVarP(&opts.confidential, "confidential", "c", false, "Make the epic confidential.")
+	epicCreateCmd.Flags().StringVar(&opts.startDate, "start-date", "", "Fixed start date of the epic, in the YYYY-MM-DD format.")
+	epicCreateCmd.Flags().StringVar(&opts.dueDate, "due-date", "", "Fixed due date of the epic, in the YYYY-MM-DD format.")
+	_ = epicCreateCmd.MarkFlagRequired("title")
+
+	return epicCreateCmd
+}
@@ -0,0 +1,35 @@
+package epic
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	epicAddIssueCmd "gitlab.com/gitlab-org/cli/commands/epic/add_issue"
+	epicCreateCmd "gitlab.com/gitlab-org/cli/commands/epic/create"
+	epicListCmd "gitlab.com/gitlab-org/cli/commands/epic/list"
+	epicRemoveIssueCmd "gitlab.com/gitlab-org/cli/commands/epic/remove_issue"
+	epicTreeCmd "gitlab.com/gitlab-org/cli/commands/epic/tree"
+	epicViewCmd "gitlab.com/gitlab-org/cli/commands/epic/view"
+)
+
+func NewCmdEpic(f *cmdutils.Factory) *cobra.Command {
+	epicCmd := &cobra.Command{
+		Use:   "epic <command> [flags]",
+		Short: `Work with group epics.`,
+		Long: heredoc.Doc(`
+			Work with the epics of a group. Epics are in the group of the current project,
+			unless you select another group with --group, or another project with --repo.
+		`),
+	}
+
+	cmdutils.EnableRepoOverride(epicCmd, f)
+
+	epicCmd.AddCommand(epicListCmd.NewCmdList(f))
+	epicCmd.AddCommand(epicViewCmd.NewCmdView(f))
+	epicCmd.AddCommand(epicCreateCmd.NewCmdCreate(f))
+	epicCmd.AddCommand(epicAddIssueCmd.NewCmdAddIssue(f))
+	epicCmd.AddCommand(epicRemoveIssueCmd.NewCmdRemoveIssue(f))
+	epicCmd.AddCommand(epicTreeCmd.NewCmdTree(f))
+	return epicCmd
+}
@@ -0,0 +1,55 @@
+package epicutils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+// GroupFromCmd returns the group of the epics for the command: the group of the
+// --group flag or the GITLAB_GROUP environment variable, or the group of the project,
+// because epics only belong to groups.
+func GroupFromCmd(cmd *cobra.Command, f *cmdutils.Factory) (string, error) {
+	scope, err := cmdutils.ScopeFromCmd(cmd, f)
+	if err != nil {
+		return "", err
+	}
+	if scope.IsGroup() {
+		return scope.Group, nil
+	}
+
+	repo, err := f.BaseRepo()
+	if err != nil {
+		return "", err
+	}
+
+	return repo.RepoOwner(), nil
+}
+
+// ParseIID parses the IID of an epic, with or without its & prefix.
+func ParseIID(value string) (int, error) {
+	iid, err := strconv.Atoi(strings.TrimPrefix(value, "&"))
+	if err != nil || iid <= 0 {
+		return 0, fmt.Errorf("invalid epic: %q. Use the IID of the epic, like 12 or &12.", value)
+	}
+	return iid, nil
+}
+
+// Reference returns the short reference of an epic, like &12.
+func Reference(epic *gitlab.Epic) string {
+	return fmt.Sprintf("&%d", epic.IID)
+}
+
+// State returns the state of an epic, colored for its state.
+func State(c *iostreams.ColorPalette, state string) string {
+	if state == "closed" {
+		return c.Red(state)
+	}
+	return c.Green(state)
+}
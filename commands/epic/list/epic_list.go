@@ -0,0 +1,136 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/epic/epicutils"
+	"gitlab.com/gitlab-org/cli/pkg/tableprinter"
+)
+
+type options struct {
+	state              string
+	labels             []string
+	author             string
+	search             string
+	includeDescendants bool
+	page               int
+	perPage            int
+	outputFormat       string
+}
+
+func NewCmdList(f *cmdutils.Factory) *cobra.Command {
+	opts := &options{}
+
+	epicListCmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List the epics of a group.`,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			glab epic list
+			glab epic list --group my-group --state all --label planning
+			glab epic list --author alice --search "Q3" --output json
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.state != "opened" && opts.state != "closed" && opts.state != "all" {
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid --state %q: use opened, closed, or all.", opts.state)}
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			group, err := epicutils.GroupFromCmd(cmd, f)
+			if err != nil {
+				return err
+			}
+
+			listOpts := &gitlab.ListGroupEpicsOptions{
+				ListOptions: gitlab.ListOptions{Page: opts.page, PerPage: opts.perPage},
+				State:       gitlab.Ptr(opts.state),
+			}
+			if len(opts.labels) > 0 {
+				labels := gitlab.LabelOptions(opts.labels)
+				listOpts.Labels = &labels
+			}
+			if opts.search != "" {
+				listOpts.Search = gitlab.Ptr(opts.search)
+			}
+			if opts.includeDescendants {
+				listOpts.IncludeDescendantGroups = gitlab.Ptr(true)
+			}
+			if opts.author != "" {
+				author, err := api.UserByName(apiClient, opts.author)
+				if err != nil {
+					return err
+				}
+				listOpts.AuthorID = gitlab.Ptr(author.ID)
+			}
+
+			epics, err := api.ListGroupEpics(apiClient, group, listOpts)
+			if err != nil {
+				return err
+			}
+
+			if opts.outputFormat == "json" {
+				epicsJSON, _ := json.Marshal(epics)
+				fmt.Fprintln(f.IO.StdOut, string(epicsJSON))
+				return nil
+			}
+
+			if len(epics) == 0 {
+				fmt.Fprintf(f.IO.StdOut, "No epics found for %s.\n", group)
+				return nil
+			}
+
+			fmt.Fprintf(f.IO.StdOut, "Showing %d epics for %s.\n\n", len(epics), group)
+			fmt.Fprint(f.IO.StdOut, formatEpics(f, epics))
+			return nil
+		},
+	}
+
+	cmdutils.EnableGroupFlag(epicListCmd, "epics")
+	epicListCmd.Flags().StringVarP(&opts.state, "state", "s", "opened", "Filter epics by state: opened, closed, or all.")
+	epicListCmd.Flags().StringSliceVarP(&opts.labels, "label", "l", []string{}, "Filter epics by labels, comma separated.")
+	epicListCmd.Flags().StringVarP(&opts.author, "author", "a", "", "Filter epics by the username of their author.")
+	epicListCmd.Flags().StringVar(&opts.search, "search", "", "Filter epics by title or description.")
+	epicListCmd.Flags().BoolVar(&opts.includeDescendants, "include-subgroups", false, "Include the epics of the subgroups.")
+	epicListCmd.Flags().IntVarP(&opts.page, "page", "p", 1, "Page number.")
+	epicListCmd.Flags().IntVarP(&opts.perPage, "per-page", "P", 30, "Number of items to list per page.")
+	epicListCmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return epicListCmd
+}
+
+func formatEpics(f *cmdutils.Factory, epics []*gitlab.Epic) string {
+	c := f.IO.Color()
+	table := tableprinter.NewTablePrinter()
+	table.AddRow("EPIC", "TITLE", "STATE", "LABELS", "START", "DUE")
+	for _, epic := range epics {
+		table.AddRow(
+			epicutils.Reference(epic),
+			epic.Title,
+			epicutils.State(c, epic.State),
+			strings.Join(epic.Labels, ", "),
+			formatDate(epic.StartDate),
+			formatDate(epic.DueDate),
+		)
+	}
+	return table.String()
+}
+
+func formatDate(date *gitlab.ISOTime) string {
+	if date == nil {
+		return ""
+	}
+	return date.String()
+}
@@ -0,0 +1,87 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/acarl005/stripansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+func TestNewCmdList(t *testing.T) {
+	var gotGroup interface{}
+	var gotOpts *gitlab.ListGroupEpicsOptions
+	api.ListGroupEpics = func(client *gitlab.Client, groupID interface{}, opts *gitlab.ListGroupEpicsOptions) ([]*gitlab.Epic, error) {
+		gotGroup = groupID
+		gotOpts = opts
+		return []*gitlab.Epic{
+			{IID: 12, Title: "Q3 roadmap", State: "opened", Labels: []string{"planning", "q3"}},
+		}, nil
+	}
+
+	tests := []struct {
+		name       string
+		arg        string
+		wantGroup  string
+		wantState  string
+		wantLabels *gitlab.LabelOptions
+		want       []string
+		wantErr    string
+	}{
+		{
+			name:      "epics of the project group",
+			arg:       "",
+			wantGroup: "cli-automated-testing",
+			wantState: "opened",
+			want:      []string{"Showing 1 epics for cli-automated-testing.", "&12", "Q3 roadmap", "planning, q3"},
+		},
+		{
+			name:       "filtered epics of a group",
+			arg:        "--group my-group --state all --label planning",
+			wantGroup:  "my-group",
+			wantState:  "all",
+			wantLabels: &gitlab.LabelOptions{"planning"},
+			want:       []string{"Showing 1 epics for my-group."},
+		},
+		{
+			name:    "invalid state",
+			arg:     "--state active",
+			wantErr: `invalid --state "active": use opened, closed, or all.`,
+		},
+	}
+
+	io, _, stdout, _ := iostreams.Test()
+	f := cmdtest.StubFactory("https://gitlab.com/cli-automated-testing/test")
+	f.IO = io
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stdout.Reset()
+
+			cmd := NewCmdList(f)
+			cmdutils.EnableRepoOverride(cmd, f)
+
+			_, err := cmdtest.RunCommand(cmd, tc.arg)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.wantGroup, gotGroup)
+			assert.Equal(t, tc.wantState, *gotOpts.State)
+			assert.Equal(t, tc.wantLabels, gotOpts.Labels)
+
+			out := stripansi.Strip(stdout.String())
+			for _, want := range tc.want {
+				assert.Contains(t, out, want)
+			}
+		})
+	}
+}
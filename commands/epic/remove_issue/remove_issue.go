@@ -0,0 +1,77 @@
+package remove_issue
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/epic/epicutils"
+	"gitlab.com/gitlab-org/cli/commands/issue/issueutils"
+)
+
+func NewCmdRemoveIssue(f *cmdutils.Factory) *cobra.Command {
+	epicRemoveIssueCmd := &cobra.Command{
+		Use:   "remove-issue <epic> <issue>... [flags]",
+		Short: `Remove issues from an epic.`,
+		Long: heredoc.Doc(`
+			Remove issues from an epic. Issues are set by IID in the current project, or by URL.
+		`),
+		Aliases: []string{"rm-issue"},
+		Example: heredoc.Doc(`
+			glab epic remove-issue 12 34
+		`),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			iid, err := epicutils.ParseIID(args[0])
+			if err != nil {
+				return err
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			group, err := epicutils.GroupFromCmd(cmd, f)
+			if err != nil {
+				return err
+			}
+
+			issues, _, err := issueutils.IssuesFromArgs(apiClient, f.BaseRepo, args[1:])
+			if err != nil {
+				return err
+			}
+
+			// Removing an issue from an epic needs the ID of its link with the epic,
+			// which is only returned by the list of the issues of the epic.
+			epicIssues, err := api.ListEpicIssues(apiClient, group, iid)
+			if err != nil {
+				return err
+			}
+			epicIssueIDs := make(map[int]int, len(epicIssues))
+			for _, epicIssue := range epicIssues {
+				epicIssueIDs[epicIssue.ID] = epicIssue.EpicIssueID
+			}
+
+			c := f.IO.Color()
+			for _, issue := range issues {
+				epicIssueID, ok := epicIssueIDs[issue.ID]
+				if !ok {
+					return fmt.Errorf("issue #%d is not in epic &%d.", issue.IID, iid)
+				}
+				if _, err := api.RemoveEpicIssue(apiClient, group, iid, epicIssueID); err != nil {
+					return fmt.Errorf("removing issue #%d from epic &%d: %w", issue.IID, iid, err)
+				}
+				fmt.Fprintf(f.IO.StdOut, "%s Removed issue #%d from epic &%d.\n", c.RedCheck(), issue.IID, iid)
+			}
+			return nil
+		},
+	}
+
+	cmdutils.EnableGroupFlag(epicRemoveIssueCmd, "epics")
+
+	return epicRemoveIssueCmd
+}
@@ -0,0 +1,44 @@
+package remove_issue
+
+import (
+	"testing"
+
+	"github.com/acarl005/stripansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+func TestNewCmdRemoveIssue(t *testing.T) {
+	api.GetIssue = func(client *gitlab.Client, projectID interface{}, issueID int) (*gitlab.Issue, error) {
+		return &gitlab.Issue{ID: issueID * 100, IID: issueID}, nil
+	}
+	api.ListEpicIssues = func(client *gitlab.Client, groupID interface{}, epicIID int) ([]*gitlab.Issue, error) {
+		return []*gitlab.Issue{{ID: 3400, IID: 34, EpicIssueID: 7}}, nil
+	}
+	var removed []int
+	api.RemoveEpicIssue = func(client *gitlab.Client, groupID interface{}, epicIID, epicIssueID int) (*gitlab.EpicIssueAssignment, error) {
+		removed = append(removed, epicIssueID)
+		return &gitlab.EpicIssueAssignment{ID: epicIssueID}, nil
+	}
+
+	io, _, stdout, _ := iostreams.Test()
+	f := cmdtest.StubFactory("https://gitlab.com/cli-automated-testing/test")
+	f.IO = io
+
+	cmd := NewCmdRemoveIssue(f)
+	cmdutils.EnableRepoOverride(cmd, f)
+
+	_, err := cmdtest.RunCommand(cmd, "&12 34")
+	require.NoError(t, err)
+	assert.Equal(t, []int{7}, removed)
+	assert.Contains(t, stripansi.Strip(stdout.String()), "Removed issue #34 from epic &12.")
+
+	_, err = cmdtest.RunCommand(cmd, "12 35")
+	require.EqualError(t, err, "issue #35 is not in epic &12.")
+}
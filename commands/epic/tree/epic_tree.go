@@ -0,0 +1,195 @@
+package tree
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/epic/epicutils"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+type options struct {
+	depth        int
+	outputFormat string
+}
+
+// node is an epic or an issue of the tree.
+type node struct {
+	Type      string `json:"type"`
+	Reference string `json:"reference"`
+	Title     string `json:"title"`
+	State     string `json:"state"`
+	WebURL    string `json:"web_url"`
+	// Progress counts the issues of an epic and of all its child epics.
+	Progress *progress `json:"progress,omitempty"`
+	Children []*node   `json:"children,omitempty"`
+}
+
+type progress struct {
+	Closed int `json:"closed"`
+	Total  int `json:"total"`
+}
+
+func NewCmdTree(f *cmdutils.Factory) *cobra.Command {
+	opts := &options{}
+
+	epicTreeCmd := &cobra.Command{
+		Use:   "tree <epic> [flags]",
+		Short: `Show the hierarchy of an epic, with its child epics and issues.`,
+		Long: heredoc.Doc(`
+			Show the hierarchy of an epic: its child epics, their own child epics, and the
+			issues of each epic, with their state. The progress of an epic counts the closed
+			issues of the epic and of all its child epics.
+		`),
+		Example: heredoc.Doc(`
+			glab epic tree 12
+			glab epic tree &12 --depth 1
+			glab epic tree 12 --group my-group --output json
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			iid, err := epicutils.ParseIID(args[0])
+			if err != nil {
+				return err
+			}
+			if opts.depth < 0 {
+				return &cmdutils.FlagError{Err: errors.New("--depth must be 0 or more.")}
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			group, err := epicutils.GroupFromCmd(cmd, f)
+			if err != nil {
+				return err
+			}
+
+			epic, err := api.GetEpic(apiClient, group, iid)
+			if err != nil {
+				return err
+			}
+
+			root, err := buildTree(apiClient, group, epic, 1, opts.depth)
+			if err != nil {
+				return err
+			}
+
+			if opts.outputFormat == "json" {
+				treeJSON, _ := json.Marshal(root)
+				fmt.Fprintln(f.IO.StdOut, string(treeJSON))
+				return nil
+			}
+
+			fmt.Fprint(f.IO.StdOut, formatTree(f.IO.Color(), root))
+			return nil
+		},
+	}
+
+	cmdutils.EnableGroupFlag(epicTreeCmd, "epics")
+	epicTreeCmd.Flags().IntVarP(&opts.depth, "depth", "d", 0, "Maximum depth of child epics to show. 0 shows all of them.")
+	epicTreeCmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return epicTreeCmd
+}
+
+// buildTree returns the tree of an epic. Child epics can belong to subgroups, so
+// they are fetched from their own group. Past maxDepth, child epics are still
+// counted in the progress, but not shown.
+func buildTree(client *gitlab.Client, groupID interface{}, epic *gitlab.Epic, depth, maxDepth int) (*node, error) {
+	n := &node{
+		Type:      "epic",
+		Reference: epicutils.Reference(epic),
+		Title:     epic.Title,
+		State:     epic.State,
+		WebURL:    epic.WebURL,
+		Progress:  &progress{},
+	}
+
+	children, err := api.ListEpicChildren(client, groupID, epic.IID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the child epics of epic %s: %w", n.Reference, err)
+	}
+	for _, child := range children {
+		var childGroupID interface{} = groupID
+		if child.GroupID != 0 {
+			childGroupID = child.GroupID
+		}
+		childNode, err := buildTree(client, childGroupID, child, depth+1, maxDepth)
+		if err != nil {
+			return nil, err
+		}
+		n.Progress.Closed += childNode.Progress.Closed
+		n.Progress.Total += childNode.Progress.Total
+		if maxDepth == 0 || depth <= maxDepth {
+			n.Children = append(n.Children, childNode)
+		}
+	}
+
+	issues, err := api.ListEpicIssues(client, groupID, epic.IID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the issues of epic %s: %w", n.Reference, err)
+	}
+	for _, issue := range issues {
+		n.Progress.Total++
+		if issue.State == "closed" {
+			n.Progress.Closed++
+		}
+		n.Children = append(n.Children, &node{
+			Type:      "issue",
+			Reference: issueReference(issue),
+			Title:     issue.Title,
+			State:     issue.State,
+			WebURL:    issue.WebURL,
+		})
+	}
+
+	return n, nil
+}
+
+// issueReference returns the full reference of an issue, as the issues of
+// an epic can belong to any project of the group.
+func issueReference(issue *gitlab.Issue) string {
+	if issue.References != nil && issue.References.Full != "" {
+		return issue.References.Full
+	}
+	return fmt.Sprintf("#%d", issue.IID)
+}
+
+func formatTree(c *iostreams.ColorPalette, root *node) string {
+	var b strings.Builder
+	fmt.Fprintln(&b, formatNode(c, root))
+	writeChildren(&b, c, root.Children, "")
+	return b.String()
+}
+
+func writeChildren(b *strings.Builder, c *iostreams.ColorPalette, children []*node, prefix string) {
+	for i, child := range children {
+		branch, indent := "├── ", "│   "
+		if i == len(children)-1 {
+			branch, indent = "└── ", "    "
+		}
+		fmt.Fprintf(b, "%s%s%s\n", prefix, branch, formatNode(c, child))
+		writeChildren(b, c, child.Children, prefix+indent)
+	}
+}
+
+func formatNode(c *iostreams.ColorPalette, n *node) string {
+	line := fmt.Sprintf("%s %s (%s)", n.Reference, n.Title, epicutils.State(c, n.State))
+	if n.Type == "epic" {
+		line = c.Bold(n.Reference) + line[len(n.Reference):]
+		if n.Progress.Total > 0 {
+			line += c.Gray(fmt.Sprintf(" %d/%d issues closed, %d%%", n.Progress.Closed, n.Progress.Total, n.Progress.Closed*100/n.Progress.Total))
+		}
+	}
+	return line
+}
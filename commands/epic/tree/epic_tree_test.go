@@ -0,0 +1,127 @@
+package tree
+
+import (
+	"testing"
+
+	"github.com/acarl005/stripansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+func stubEpics(t *testing.T) {
+	t.Helper()
+
+	api.GetEpic = func(client *gitlab.Client, groupID interface{}, epicIID int) (*gitlab.Epic, error) {
+		return &gitlab.Epic{IID: epicIID, GroupID: 1, Title: "Roadmap", State: "opened"}, nil
+	}
+	api.ListEpicChildren = func(client *gitlab.Client, groupID interface{}, epicIID int) ([]*gitlab.Epic, error) {
+		switch epicIID {
+		case 1:
+			return []*gitlab.Epic{
+				{IID: 2, GroupID: 2, Title: "Search", State: "opened"},
+				{IID: 3, GroupID: 1, Title: "Billing", State: "closed"},
+			}, nil
+		case 2:
+			return []*gitlab.Epic{{IID: 4, GroupID: 2, Title: "Indexing", State: "opened"}}, nil
+		}
+		return nil, nil
+	}
+	api.ListEpicIssues = func(client *gitlab.Client, groupID interface{}, epicIID int) ([]*gitlab.Issue, error) {
+		// child epics are fetched from their own group
+		if epicIID == 2 || epicIID == 4 {
+			assert.Equal(t, 2, groupID)
+		}
+		switch epicIID {
+		case 1:
+			return []*gitlab.Issue{{IID: 10, Title: "Kickoff", State: "closed", References: &gitlab.IssueReferences{Full: "g/p#10"}}}, nil
+		case 3:
+			return []*gitlab.Issue{{IID: 11, Title: "Invoices", State: "closed", References: &gitlab.IssueReferences{Full: "g/p#11"}}}, nil
+		case 4:
+			return []*gitlab.Issue{
+				{IID: 12, Title: "Crawler", State: "opened", References: &gitlab.IssueReferences{Full: "g/s/p#12"}},
+				{IID: 13, Title: "Ranking", State: "closed", References: &gitlab.IssueReferences{Full: "g/s/p#13"}},
+			}, nil
+		}
+		return nil, nil
+	}
+}
+
+func TestNewCmdTree(t *testing.T) {
+	stubEpics(t)
+
+	io, _, stdout, _ := iostreams.Test()
+	f := cmdtest.StubFactory("https://gitlab.com/cli-automated-testing/test")
+	f.IO = io
+
+	tests := []struct {
+		name string
+		arg  string
+		want string
+	}{
+		{
+			name: "full tree",
+			arg:  "&1",
+			want: `&1 Roadmap (opened) 3/4 issues closed, 75%
+├── &2 Search (opened) 1/2 issues closed, 50%
+│   └── &4 Indexing (opened) 1/2 issues closed, 50%
+│       ├── g/s/p#12 Crawler (opened)
+│       └── g/s/p#13 Ranking (closed)
+├── &3 Billing (closed) 1/1 issues closed, 100%
+│   └── g/p#11 Invoices (closed)
+└── g/p#10 Kickoff (closed)
+`,
+		},
+		{
+			name: "limited depth counts hidden epics",
+			arg:  "1 --depth 1",
+			want: `&1 Roadmap (opened) 3/4 issues closed, 75%
+├── &2 Search (opened) 1/2 issues closed, 50%
+├── &3 Billing (closed) 1/1 issues closed, 100%
+│   └── g/p#11 Invoices (closed)
+└── g/p#10 Kickoff (closed)
+`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stdout.Reset()
+
+			cmd := NewCmdTree(f)
+			cmdutils.EnableRepoOverride(cmd, f)
+
+			_, err := cmdtest.RunCommand(cmd, tc.arg)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.want, stripansi.Strip(stdout.String()))
+		})
+	}
+}
+
+func TestNewCmdTree_json(t *testing.T) {
+	stubEpics(t)
+
+	io, _, stdout, _ := iostreams.Test()
+	f := cmdtest.StubFactory("https://gitlab.com/cli-automated-testing/test")
+	f.IO = io
+
+	cmd := NewCmdTree(f)
+	cmdutils.EnableRepoOverride(cmd, f)
+
+	_, err := cmdtest.RunCommand(cmd, "3 --output json")
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"type": "epic", "reference": "&3", "title": "Roadmap", "state": "opened", "web_url": "",
+		"progress": {"closed": 1, "total": 1},
+		"children": [
+			{"type": "issue", "reference": "g/p#11", "title": "Invoices", "state": "closed", "web_url": ""}
+		]
+	}`, stdout.String())
+}
@@ -0,0 +1,133 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/epic/epicutils"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+type options struct {
+	web          bool
+	outputFormat string
+}
+
+func NewCmdView(f *cmdutils.Factory) *cobra.Command {
+	opts := &options{}
+
+	epicViewCmd := &cobra.Command{
+		Use:     "view <epic> [flags]",
+		Short:   `Display the details of an epic.`,
+		Aliases: []string{"show"},
+		Example: heredoc.Doc(`
+			glab epic view 12
+			glab epic view &12 --group my-group --web
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			iid, err := epicutils.ParseIID(args[0])
+			if err != nil {
+				return err
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			group, err := epicutils.GroupFromCmd(cmd, f)
+			if err != nil {
+				return err
+			}
+
+			epic, err := api.GetEpic(apiClient, group, iid)
+			if err != nil {
+				return err
+			}
+
+			cfg, _ := f.Config()
+
+			if opts.web {
+				if f.IO.IsOutputTTY() {
+					fmt.Fprintf(f.IO.StdErr, "Opening %s in your browser.\n", epic.WebURL)
+				}
+				browser, _ := cfg.Get(apiClient.BaseURL().Host, "browser")
+				return utils.OpenInBrowser(epic.WebURL, browser)
+			}
+
+			if opts.outputFormat == "json" {
+				epicJSON, _ := json.Marshal(epic)
+				fmt.Fprintln(f.IO.StdOut, string(epicJSON))
+				return nil
+			}
+
+			children, err := api.ListEpicChildren(apiClient, group, iid)
+			if err != nil {
+				return err
+			}
+			issues, err := api.ListEpicIssues(apiClient, group, iid)
+			if err != nil {
+				return err
+			}
+
+			glamourStyle, _ := cfg.Get(apiClient.BaseURL().Host, "glamour_style")
+			fmt.Fprint(f.IO.StdOut, displayEpic(f.IO, epic, children, issues, glamourStyle))
+			return nil
+		},
+	}
+
+	cmdutils.EnableGroupFlag(epicViewCmd, "epics")
+	epicViewCmd.Flags().BoolVarP(&opts.web, "web", "w", false, "Open the epic in the browser.")
+	epicViewCmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return epicViewCmd
+}
+
+func displayEpic(io *iostreams.IOStreams, epic *gitlab.Epic, children []*gitlab.Epic, issues []*gitlab.Issue, glamourStyle string) string {
+	c := io.Color()
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s %s\n", c.Bold(epicutils.Reference(epic)), c.Bold(epic.Title))
+	fmt.Fprintf(&b, "State:\t\t%s\n", epicutils.State(c, epic.State))
+	if epic.Author != nil {
+		fmt.Fprintf(&b, "Author:\t\t%s\n", epic.Author.Username)
+	}
+	if len(epic.Labels) > 0 {
+		fmt.Fprintf(&b, "Labels:\t\t%s\n", strings.Join(epic.Labels, ", "))
+	}
+	if epic.StartDate != nil {
+		fmt.Fprintf(&b, "Start date:\t%s\n", epic.StartDate)
+	}
+	if epic.DueDate != nil {
+		fmt.Fprintf(&b, "Due date:\t%s\n", epic.DueDate)
+	}
+
+	closed := 0
+	for _, issue := range issues {
+		if issue.State == "closed" {
+			closed++
+		}
+	}
+	fmt.Fprintf(&b, "Child epics:\t%d\n", len(children))
+	fmt.Fprintf(&b, "Issues:\t\t%d of %d closed\n", closed, len(issues))
+
+	if epic.Description != "" {
+		description, err := utils.RenderMarkdown(epic.Description, glamourStyle)
+		if err != nil {
+			description = epic.Description
+		}
+		fmt.Fprintf(&b, "\n%s\n", strings.TrimRight(description, "\n"))
+	}
+
+	fmt.Fprintf(&b, "\n%s\n", c.Gray(epic.WebURL))
+	return b.String()
+}
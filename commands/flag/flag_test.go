@@ -1,4 +1,4 @@
-package flag
+package flag_test
 
 import (
 	"testing"
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"gitlab.com/gitlab-org/cli/commands/cmdtest"
 	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/flag"
 	"gitlab.com/gitlab-org/cli/internal/config"
 	"gitlab.com/gitlab-org/cli/internal/glrepo"
 )
@@ -28,7 +29,7 @@ func NewDummyCmd(f *cmdutils.Factory, runE func(opts *ListOptions) error) *cobra
 			// support repo override
 			opts.BaseRepo = f.BaseRepo
 
-			group, err := GroupOverride(cmd)
+			group, err := flag.GroupOverride(cmd)
 			if err != nil {
 				return err
 			}
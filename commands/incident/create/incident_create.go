@@ -0,0 +1,113 @@
+package create
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/incident/incidentutils"
+	"gitlab.com/gitlab-org/cli/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/pkg/prompt"
+)
+
+type options struct {
+	title        string
+	description  string
+	severity     string
+	labels       []string
+	assignees    []string
+	confidential bool
+}
+
+func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
+	opts := &options{}
+
+	incidentCreateCmd := &cobra.Command{
+		Use:     "create [flags]",
+		Short:   `Create an incident.`,
+		Long:    ``,
+		Aliases: []string{"new"},
+		Example: heredoc.Doc(`
+			glab incident create --title "API returns 500 errors" --severity critical
+			glab incident create -t "Slow checkout" -s medium --assignee alice --label "service::payments"
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.severity != "" {
+				severity, err := incidentutils.ValidateSeverity(opts.severity)
+				if err != nil {
+					return err
+				}
+				opts.severity = severity
+			}
+
+			if opts.title == "" {
+				if !f.IO.PromptEnabled() {
+					return &cmdutils.FlagError{Err: errors.New("--title is required when not running interactively.")}
+				}
+				if err := prompt.AskQuestionWithInput(&opts.title, "title", "Title", "", true); err != nil {
+					return err
+				}
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+			repo, err := f.BaseRepo()
+			if err != nil {
+				return err
+			}
+
+			createOpts := &gitlab.CreateIssueOptions{
+				Title:     gitlab.Ptr(opts.title),
+				IssueType: gitlab.Ptr("incident"),
+			}
+			if opts.description != "" {
+				createOpts.Description = gitlab.Ptr(opts.description)
+			}
+			if len(opts.labels) > 0 {
+				createOpts.Labels = (*gitlab.LabelOptions)(&opts.labels)
+			}
+			if opts.confidential {
+				createOpts.Confidential = gitlab.Ptr(true)
+			}
+			if len(opts.assignees) > 0 {
+				users, err := api.UsersByNames(apiClient, opts.assignees)
+				if err != nil {
+					return err
+				}
+				createOpts.AssigneeIDs = cmdutils.IDsFromUsers(users)
+			}
+
+			incident, err := api.CreateIssue(apiClient, repo.FullName(), createOpts)
+			if err != nil {
+				return fmt.Errorf("failed to create incident: %w", err)
+			}
+
+			if opts.severity != "" {
+				err := api.SetIncidentSeverity(apiClient, repo.FullName(), incident.IID, opts.severity)
+				if err != nil {
+					return fmt.Errorf("incident #%d was created, but its severity could not be set: %w", incident.IID, err)
+				}
+			}
+
+			fmt.Fprintln(f.IO.StdOut, issueutils.DisplayIssue(f.IO.Color(), incident, f.IO.IsaTTY))
+			return nil
+		},
+	}
+
+	incidentCreateCmd.Flags().StringVarP(&opts.title, "title", "t", "", "Title of the incident.")
+	incidentCreateCmd.Flags().StringVarP(&opts.description, "description", "d", "", "Description of the incident.")
+	incidentCreateCmd.Flags().StringVarP(&opts.severity, "severity", "s", "", "Severity of the incident: critical, high, medium, low, or unknown.")
+	incidentCreateCmd.Flags().StringSliceVarP(&opts.labels, "label", "l", []string{}, "Add labels. Multiple labels should be comma-separated.")
+	incidentCreateCmd.Flags().StringSliceVarP(&opts.assignees, "assignee", "a", []string{}, "Assign the incident to users, by username. Multiple usernames should be comma-separated.")
+	incidentCreateCmd.Flags().BoolVarP(&opts.confidential, "confidential", "c", false, "Set the incident as confidential.")
+
+	return incidentCreateCmd
+}
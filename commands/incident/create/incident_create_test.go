@@ -0,0 +1,86 @@
+package create
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+func TestMain(m *testing.M) {
+	cmdtest.InitTest(m, "incident_create_test")
+}
+
+func runCommand(t *testing.T, args string, severityErr error) (string, *gitlab.CreateIssueOptions, string, error) {
+	t.Helper()
+
+	var createOpts *gitlab.CreateIssueOptions
+	api.CreateIssue = func(client *gitlab.Client, projectID interface{}, opts *gitlab.CreateIssueOptions) (*gitlab.Issue, error) {
+		createOpts = opts
+		return &gitlab.Issue{
+			IID:       12,
+			Title:     *opts.Title,
+			State:     "opened",
+			WebURL:    "https://gitlab.com/OWNER/REPO/-/issues/incident/12",
+			CreatedAt: gitlab.Ptr(time.Now()),
+		}, nil
+	}
+	var severity string
+	api.SetIncidentSeverity = func(client *gitlab.Client, projectPath string, iid int, s string) error {
+		assert.Equal(t, "OWNER/REPO", projectPath)
+		assert.Equal(t, 12, iid)
+		severity = s
+		return severityErr
+	}
+
+	io, _, stdout, _ := iostreams.Test()
+	f := cmdtest.StubFactory("https://gitlab.com/OWNER/REPO")
+	f.IO = io
+
+	_, err := cmdtest.RunCommand(NewCmdCreate(f), args)
+	return stdout.String(), createOpts, severity, err
+}
+
+func TestIncidentCreate(t *testing.T) {
+	output, createOpts, severity, err := runCommand(t, `--title "API returns 500 errors" --severity Critical --label ops`, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "incident", *createOpts.IssueType)
+	assert.Equal(t, "API returns 500 errors", *createOpts.Title)
+	assert.Equal(t, gitlab.LabelOptions{"ops"}, *createOpts.Labels)
+	assert.Equal(t, "critical", severity)
+	assert.Equal(t, "https://gitlab.com/OWNER/REPO/-/issues/incident/12\n", output)
+}
+
+func TestIncidentCreate_NoSeverity(t *testing.T) {
+	_, _, severity, err := runCommand(t, `--title "Slow checkout"`, nil)
+	require.NoError(t, err)
+
+	assert.Empty(t, severity)
+}
+
+func TestIncidentCreate_SeverityError(t *testing.T) {
+	_, _, _, err := runCommand(t, `--title "Slow checkout" --severity low`, errors.New("forbidden"))
+
+	assert.EqualError(t, err, "incident #12 was created, but its severity could not be set: forbidden")
+}
+
+func TestIncidentCreate_InvalidSeverity(t *testing.T) {
+	_, createOpts, _, err := runCommand(t, `--title "Slow checkout" --severity urgent`, nil)
+
+	assert.EqualError(t, err, `invalid severity "urgent": use critical, high, medium, low, or unknown.`)
+	assert.Nil(t, createOpts)
+}
+
+func TestIncidentCreate_NoTitle(t *testing.T) {
+	_, _, _, err := runCommand(t, "", nil)
+
+	assert.EqualError(t, err, "--title is required when not running interactively.")
+}
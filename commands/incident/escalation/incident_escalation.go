@@ -0,0 +1,69 @@
+package escalation
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/incident/incidentutils"
+)
+
+func NewCmdEscalation(f *cmdutils.Factory) *cobra.Command {
+	incidentEscalationCmd := &cobra.Command{
+		Use:   "escalation <command> [flags]",
+		Short: `Manage the escalation status of incidents.`,
+		Long:  ``,
+	}
+
+	incidentEscalationCmd.AddCommand(NewCmdSet(f))
+
+	return incidentEscalationCmd
+}
+
+func NewCmdSet(f *cmdutils.Factory) *cobra.Command {
+	incidentEscalationSetCmd := &cobra.Command{
+		Use:   "set <id> <status>",
+		Short: `Set the escalation status of an incident.`,
+		Long: heredoc.Doc(`
+			Set the escalation status of an incident: triggered, acknowledged, resolved,
+			or ignored.
+
+			Acknowledge an incident to stop paging the next on-call responders of its
+			escalation policy. Resolving an incident closes it.
+		`),
+		Example: heredoc.Doc(`
+			glab incident escalation set 123 acknowledged
+			glab incident escalation set 123 resolved
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			status, err := incidentutils.ValidateEscalationStatus(args[1])
+			if err != nil {
+				return err
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			incident, repo, err := incidentutils.IncidentFromArg(apiClient, f, args[0], "view")
+			if err != nil {
+				return err
+			}
+
+			err = api.SetIncidentEscalationStatus(apiClient, repo.FullName(), incident.IID, status)
+			if err != nil {
+				return fmt.Errorf("failed to set the escalation status of incident #%d: %w", incident.IID, err)
+			}
+
+			fmt.Fprintf(f.IO.StdOut, "%s Set the escalation status of incident #%d to %s.\n", f.IO.Color().GreenCheck(), incident.IID, status)
+			return nil
+		},
+	}
+
+	return incidentEscalationSetCmd
+}
@@ -5,10 +5,15 @@ import (
 	"gitlab.com/gitlab-org/cli/commands/cmdutils"
 
 	incidentCloseCmd "gitlab.com/gitlab-org/cli/commands/incident/close"
+	incidentCreateCmd "gitlab.com/gitlab-org/cli/commands/incident/create"
+	incidentEscalationCmd "gitlab.com/gitlab-org/cli/commands/incident/escalation"
 	incidentListCmd "gitlab.com/gitlab-org/cli/commands/incident/list"
 	incidentNoteCmd "gitlab.com/gitlab-org/cli/commands/incident/note"
 	incidentReopenCmd "gitlab.com/gitlab-org/cli/commands/incident/reopen"
+	incidentSeverityCmd "gitlab.com/gitlab-org/cli/commands/incident/severity"
+	incidentStatusCmd "gitlab.com/gitlab-org/cli/commands/incident/status"
 	incidentSubscribeCmd "gitlab.com/gitlab-org/cli/commands/incident/subscribe"
+	incidentTimelineCmd "gitlab.com/gitlab-org/cli/commands/incident/timeline"
 	incidentUnsubscribeCmd "gitlab.com/gitlab-org/cli/commands/incident/unsubscribe"
 	incidentViewCmd "gitlab.com/gitlab-org/cli/commands/incident/view"
 
@@ -22,6 +27,9 @@ func NewCmdIncident(f *cmdutils.Factory) *cobra.Command {
 		Long:  ``,
 		Example: heredoc.Doc(`
 			glab incident list
+			glab incident create --title "API returns 500 errors" --severity critical
+			glab incident timeline add 123 --note "Rolled back the deployment"
+			glab incident status
 		`),
 		Annotations: map[string]string{
 			"help:arguments": heredoc.Doc(`
@@ -42,5 +50,10 @@ func NewCmdIncident(f *cmdutils.Factory) *cobra.Command {
 	incidentCmd.AddCommand(incidentReopenCmd.NewCmdReopen(f))
 	incidentCmd.AddCommand(incidentSubscribeCmd.NewCmdSubscribe(f))
 	incidentCmd.AddCommand(incidentUnsubscribeCmd.NewCmdUnsubscribe(f))
+	incidentCmd.AddCommand(incidentCreateCmd.NewCmdCreate(f))
+	incidentCmd.AddCommand(incidentSeverityCmd.NewCmdSeverity(f))
+	incidentCmd.AddCommand(incidentEscalationCmd.NewCmdEscalation(f))
+	incidentCmd.AddCommand(incidentTimelineCmd.NewCmdTimeline(f))
+	incidentCmd.AddCommand(incidentStatusCmd.NewCmdStatus(f))
 	return incidentCmd
 }
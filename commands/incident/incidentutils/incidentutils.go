@@ -0,0 +1,71 @@
+package incidentutils
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/issuable"
+	"gitlab.com/gitlab-org/cli/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+// Severities are the severities of incidents, from the most severe.
+var Severities = []string{"critical", "high", "medium", "low", "unknown"}
+
+// EscalationStatuses are the escalation statuses of incidents.
+var EscalationStatuses = []string{"triggered", "acknowledged", "resolved", "ignored"}
+
+// ValidateSeverity returns the severity in lower case, or an error for an unknown severity.
+func ValidateSeverity(severity string) (string, error) {
+	return validate("severity", severity, Severities)
+}
+
+// ValidateEscalationStatus returns the escalation status in lower case, or an error for an unknown status.
+func ValidateEscalationStatus(status string) (string, error) {
+	return validate("escalation status", status, EscalationStatuses)
+}
+
+func validate(name, value string, values []string) (string, error) {
+	value = strings.ToLower(value)
+	for _, v := range values {
+		if v == value {
+			return value, nil
+		}
+	}
+	last := len(values) - 1
+	return "", &cmdutils.FlagError{Err: fmt.Errorf("invalid %s %q: use %s, or %s.", name, value, strings.Join(values[:last], ", "), values[last])}
+}
+
+// IncidentFromArg returns the incident of an argument, or an error when the issue is not an incident.
+func IncidentFromArg(client *gitlab.Client, f *cmdutils.Factory, arg, subcmd string) (*gitlab.Issue, glrepo.Interface, error) {
+	issue, repo, err := issueutils.IssueFromArg(client, f.BaseRepo, arg)
+	if err != nil {
+		return nil, nil, err
+	}
+	if issue.IssueType == nil {
+		return nil, nil, errors.New("incident not found.")
+	}
+	if valid, msg := issuable.ValidateIncidentCmd(issuable.TypeIncident, subcmd, issue); !valid {
+		return nil, nil, errors.New(msg)
+	}
+	return issue, repo, nil
+}
+
+// SeverityColor returns a severity, in the color of the severity.
+func SeverityColor(c *iostreams.ColorPalette, severity string) string {
+	severity = strings.ToLower(severity)
+	switch severity {
+	case "critical", "high":
+		return c.Red(severity)
+	case "medium":
+		return c.Yellow(severity)
+	case "low":
+		return c.Blue(severity)
+	}
+	return c.Gray(severity)
+}
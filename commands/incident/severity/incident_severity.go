@@ -0,0 +1,66 @@
+package severity
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/incident/incidentutils"
+)
+
+func NewCmdSeverity(f *cmdutils.Factory) *cobra.Command {
+	incidentSeverityCmd := &cobra.Command{
+		Use:   "severity <command> [flags]",
+		Short: `Manage the severity of incidents.`,
+		Long:  ``,
+	}
+
+	incidentSeverityCmd.AddCommand(NewCmdSet(f))
+
+	return incidentSeverityCmd
+}
+
+func NewCmdSet(f *cmdutils.Factory) *cobra.Command {
+	incidentSeveritySetCmd := &cobra.Command{
+		Use:   "set <id> <severity>",
+		Short: `Set the severity of an incident.`,
+		Long: heredoc.Doc(`
+			Set the severity of an incident: critical, high, medium, low, or unknown.
+		`),
+		Example: heredoc.Doc(`
+			glab incident severity set 123 critical
+			glab incident severity set https://gitlab.com/OWNER/REPO/-/issues/incident/123 low
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			severity, err := incidentutils.ValidateSeverity(args[1])
+			if err != nil {
+				return err
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			incident, repo, err := incidentutils.IncidentFromArg(apiClient, f, args[0], "view")
+			if err != nil {
+				return err
+			}
+
+			err = api.SetIncidentSeverity(apiClient, repo.FullName(), incident.IID, severity)
+			if err != nil {
+				return fmt.Errorf("failed to set the severity of incident #%d: %w", incident.IID, err)
+			}
+
+			c := f.IO.Color()
+			fmt.Fprintf(f.IO.StdOut, "%s Set the severity of incident #%d to %s.\n", c.GreenCheck(), incident.IID, incidentutils.SeverityColor(c, severity))
+			return nil
+		},
+	}
+
+	return incidentSeveritySetCmd
+}
@@ -0,0 +1,131 @@
+package status
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/incident/incidentutils"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/pkg/tableprinter"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+// now is replaced in tests.
+var now = time.Now
+
+func NewCmdStatus(f *cmdutils.Factory) *cobra.Command {
+	var outputFormat string
+
+	incidentStatusCmd := &cobra.Command{
+		Use:   "status [flags]",
+		Short: `Show the open incidents by severity.`,
+		Long: heredoc.Doc(`
+			Show the open incidents of the project, from the most severe, with their
+			escalation status and the time since they were created.
+		`),
+		Example: heredoc.Doc(`
+			glab incident status
+			glab incident status --output json
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+			repo, err := f.BaseRepo()
+			if err != nil {
+				return err
+			}
+
+			incidents, err := api.ListOpenIncidents(apiClient, repo.FullName())
+			if err != nil {
+				return fmt.Errorf("failed to get the open incidents of %s: %w", repo.FullName(), err)
+			}
+			sortBySeverity(incidents)
+
+			if outputFormat == "json" {
+				incidentsJSON, _ := json.Marshal(incidents)
+				fmt.Fprintln(f.IO.StdOut, string(incidentsJSON))
+				return nil
+			}
+
+			fmt.Fprint(f.IO.StdOut, formatStatus(f.IO, repo.FullName(), incidents, now()))
+			return nil
+		},
+	}
+
+	incidentStatusCmd.Flags().StringVarP(&outputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return incidentStatusCmd
+}
+
+func severityRank(severity string) int {
+	for i, s := range incidentutils.Severities {
+		if strings.EqualFold(s, severity) {
+			return i
+		}
+	}
+	return len(incidentutils.Severities)
+}
+
+// sortBySeverity sorts incidents from the most severe, then from the oldest.
+func sortBySeverity(incidents []*api.Incident) {
+	sort.SliceStable(incidents, func(i, j int) bool {
+		a, b := incidents[i], incidents[j]
+		if ra, rb := severityRank(a.Severity), severityRank(b.Severity); ra != rb {
+			return ra < rb
+		}
+		return a.CreatedAt.Before(b.CreatedAt)
+	})
+}
+
+func formatStatus(io *iostreams.IOStreams, project string, incidents []*api.Incident, today time.Time) string {
+	c := io.Color()
+
+	if len(incidents) == 0 {
+		return fmt.Sprintf("%s No open incidents in %s.\n", c.GreenCheck(), project)
+	}
+
+	counts := map[string]int{}
+	for _, i := range incidents {
+		counts[strings.ToLower(i.Severity)]++
+	}
+	var summary []string
+	for _, s := range incidentutils.Severities {
+		if counts[s] > 0 {
+			summary = append(summary, fmt.Sprintf("%d %s", counts[s], incidentutils.SeverityColor(c, s)))
+		}
+	}
+
+	table := tableprinter.NewTablePrinter()
+	table.AddRow("ID", "SEVERITY", "STATUS", "OPENED", "ASSIGNEES", "TITLE")
+	for _, i := range incidents {
+		var assignees []string
+		for _, a := range i.Assignees.Nodes {
+			assignees = append(assignees, "@"+a.Username)
+		}
+		status := strings.ToLower(i.EscalationStatus)
+		if status == "triggered" {
+			status = c.Red(status)
+		}
+		table.AddRow(
+			"#"+i.IID,
+			incidentutils.SeverityColor(c, i.Severity),
+			status,
+			utils.PrettyTimeAgo(today.Sub(i.CreatedAt)),
+			strings.Join(assignees, ", "),
+			i.Title,
+		)
+	}
+
+	return fmt.Sprintf("%s in %s: %s\n\n%s", utils.Pluralize(len(incidents), "open incident"), project, strings.Join(summary, ", "), table.String())
+}
@@ -0,0 +1,68 @@
+package status
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+func TestMain(m *testing.M) {
+	cmdtest.InitTest(m, "incident_status_test")
+}
+
+func incident(iid, severity, status string, age time.Duration) *api.Incident {
+	return &api.Incident{
+		IID:              iid,
+		Title:            "Incident " + iid,
+		Severity:         severity,
+		EscalationStatus: status,
+		CreatedAt:        time.Date(2025, 6, 20, 12, 0, 0, 0, time.UTC).Add(-age),
+	}
+}
+
+func TestIncidentStatus(t *testing.T) {
+	now = func() time.Time { return time.Date(2025, 6, 20, 12, 0, 0, 0, time.UTC) }
+	defer func() { now = time.Now }()
+
+	api.ListOpenIncidents = func(client *gitlab.Client, projectPath string) ([]*api.Incident, error) {
+		assert.Equal(t, "OWNER/REPO", projectPath)
+		return []*api.Incident{
+			incident("3", "LOW", "ACKNOWLEDGED", 48*time.Hour),
+			incident("7", "CRITICAL", "TRIGGERED", 2*time.Hour),
+			incident("5", "CRITICAL", "ACKNOWLEDGED", 5*time.Hour),
+		}, nil
+	}
+
+	io, _, stdout, _ := iostreams.Test()
+	f := cmdtest.StubFactory("https://gitlab.com/OWNER/REPO")
+	f.IO = io
+
+	_, err := cmdtest.RunCommand(NewCmdStatus(f), "")
+	require.NoError(t, err)
+
+	output := stdout.String()
+	assert.Contains(t, output, "3 open incidents in OWNER/REPO: 2 critical, 1 low\n")
+	assert.Regexp(t, `#5\s+critical\s+acknowledged\s+about 5 hours ago\s+Incident 5\n#7\s+critical\s+triggered\s+about 2 hours ago\s+Incident 7\n#3\s+low\s+acknowledged\s+about 2 days ago\s+Incident 3\n`, output)
+}
+
+func TestIncidentStatus_NoIncidents(t *testing.T) {
+	api.ListOpenIncidents = func(client *gitlab.Client, projectPath string) ([]*api.Incident, error) {
+		return nil, nil
+	}
+
+	io, _, stdout, _ := iostreams.Test()
+	f := cmdtest.StubFactory("https://gitlab.com/OWNER/REPO")
+	f.IO = io
+
+	_, err := cmdtest.RunCommand(NewCmdStatus(f), "")
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ No open incidents in OWNER/REPO.\n", stdout.String())
+}
@@ -0,0 +1,185 @@
+package timeline
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/incident/incidentutils"
+	"gitlab.com/gitlab-org/cli/pkg/tableprinter"
+)
+
+// now is replaced in tests.
+var now = time.Now
+
+// Tags are the tags of timeline events.
+var Tags = []string{"Start time", "End time", "Impact detected", "Response initiated", "Impact mitigated", "Cause identified"}
+
+func NewCmdTimeline(f *cmdutils.Factory) *cobra.Command {
+	incidentTimelineCmd := &cobra.Command{
+		Use:   "timeline <command> [flags]",
+		Short: `Manage the timeline events of incidents.`,
+		Long:  ``,
+	}
+
+	incidentTimelineCmd.AddCommand(NewCmdAdd(f))
+	incidentTimelineCmd.AddCommand(NewCmdList(f))
+
+	return incidentTimelineCmd
+}
+
+func NewCmdAdd(f *cmdutils.Factory) *cobra.Command {
+	var note, occurredAt string
+	var tags []string
+
+	incidentTimelineAddCmd := &cobra.Command{
+		Use:   "add <id> [flags]",
+		Short: `Add an event to the timeline of an incident.`,
+		Long: heredoc.Docf(`
+			Add an event to the timeline of an incident. The event occurs now, unless
+			set with --occurred-at, in the RFC 3339 format or as a time of today, like 14:05.
+
+			Tag events with --tag: %s.
+		`, strings.Join(Tags, ", ")),
+		Example: heredoc.Doc(`
+			glab incident timeline add 123 --note "Rolled back the deployment"
+			glab incident timeline add 123 -m "Alert fired" --occurred-at 09:42 --tag "Impact detected"
+			glab incident timeline add 123 -m "Root cause found" --occurred-at 2025-06-20T10:30:00Z --tag "Cause identified"
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if strings.TrimSpace(note) == "" {
+				return &cmdutils.FlagError{Err: errors.New("--note is required.")}
+			}
+			occurred, err := parseOccurredAt(occurredAt)
+			if err != nil {
+				return err
+			}
+			for i, tag := range tags {
+				if tags[i], err = validateTag(tag); err != nil {
+					return err
+				}
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			incident, _, err := incidentutils.IncidentFromArg(apiClient, f, args[0], "view")
+			if err != nil {
+				return err
+			}
+
+			event, err := api.CreateIncidentTimelineEvent(apiClient, incident.ID, &api.CreateIncidentTimelineEventOptions{
+				Note:       note,
+				OccurredAt: occurred,
+				Tags:       tags,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to add the event to the timeline of incident #%d: %w", incident.IID, err)
+			}
+
+			fmt.Fprintf(f.IO.StdOut, "%s Added an event at %s to the timeline of incident #%d.\n", f.IO.Color().GreenCheck(), event.OccurredAt.Format(time.RFC3339), incident.IID)
+			return nil
+		},
+	}
+
+	incidentTimelineAddCmd.Flags().StringVarP(&note, "note", "m", "", "Note of the event.")
+	incidentTimelineAddCmd.Flags().StringVar(&occurredAt, "occurred-at", "", "Time of the event, in the RFC 3339 format or like 14:05 for today. Defaults to now.")
+	incidentTimelineAddCmd.Flags().StringSliceVarP(&tags, "tag", "t", []string{}, "Tags of the event. Multiple tags should be comma-separated.")
+
+	return incidentTimelineAddCmd
+}
+
+// parseOccurredAt returns the time of an event, from an RFC 3339 time or a time of today.
+func parseOccurredAt(value string) (time.Time, error) {
+	today := now()
+	if value == "" {
+		return today, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("15:04", value, today.Location()); err == nil {
+		return time.Date(today.Year(), today.Month(), today.Day(), t.Hour(), t.Minute(), 0, 0, today.Location()), nil
+	}
+	return time.Time{}, &cmdutils.FlagError{Err: fmt.Errorf("invalid --occurred-at %q: use the RFC 3339 format, like 2025-06-20T14:05:00Z, or a time like 14:05.", value)}
+}
+
+// validateTag returns a tag with the case of the tags of GitLab.
+func validateTag(tag string) (string, error) {
+	for _, t := range Tags {
+		if strings.EqualFold(t, strings.TrimSpace(tag)) {
+			return t, nil
+		}
+	}
+	return "", &cmdutils.FlagError{Err: fmt.Errorf("invalid --tag %q: use %s.", tag, strings.Join(Tags, ", "))}
+}
+
+func NewCmdList(f *cmdutils.Factory) *cobra.Command {
+	var outputFormat string
+
+	incidentTimelineListCmd := &cobra.Command{
+		Use:     "list <id> [flags]",
+		Short:   `List the timeline events of an incident.`,
+		Long:    ``,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			glab incident timeline list 123
+			glab incident timeline list 123 --output json
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			incident, repo, err := incidentutils.IncidentFromArg(apiClient, f, args[0], "view")
+			if err != nil {
+				return err
+			}
+
+			events, err := api.ListIncidentTimelineEvents(apiClient, repo.FullName(), incident.ID)
+			if err != nil {
+				return fmt.Errorf("failed to get the timeline of incident #%d: %w", incident.IID, err)
+			}
+			sort.SliceStable(events, func(i, j int) bool {
+				return events[i].OccurredAt.Before(events[j].OccurredAt)
+			})
+
+			if outputFormat == "json" {
+				eventsJSON, _ := json.Marshal(events)
+				fmt.Fprintln(f.IO.StdOut, string(eventsJSON))
+				return nil
+			}
+
+			if len(events) == 0 {
+				fmt.Fprintf(f.IO.StdOut, "No timeline events for incident #%d.\n", incident.IID)
+				return nil
+			}
+
+			c := f.IO.Color()
+			table := tableprinter.NewTablePrinter()
+			table.AddRow("TIME", "AUTHOR", "TAGS", "NOTE")
+			for _, e := range events {
+				table.AddRow(e.OccurredAt.Format("2006-01-02 15:04 MST"), e.Author.Username, c.Cyan(strings.Join(e.Tags(), ", ")), e.Note)
+			}
+			fmt.Fprintf(f.IO.StdOut, "Timeline of incident #%d: %s\n\n%s", incident.IID, incident.Title, table.String())
+			return nil
+		},
+	}
+
+	incidentTimelineListCmd.Flags().StringVarP(&outputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return incidentTimelineListCmd
+}
@@ -0,0 +1,118 @@
+package timeline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+func TestMain(m *testing.M) {
+	cmdtest.InitTest(m, "incident_timeline_test")
+}
+
+func runCommand(t *testing.T, issueType string, args string) (string, error) {
+	t.Helper()
+
+	now = func() time.Time { return time.Date(2025, 6, 20, 12, 0, 0, 0, time.UTC) }
+	t.Cleanup(func() { now = time.Now })
+
+	api.GetIssue = func(client *gitlab.Client, projectID interface{}, issueID int) (*gitlab.Issue, error) {
+		return &gitlab.Issue{ID: 4567, IID: issueID, Title: "API returns 500 errors", IssueType: gitlab.Ptr(issueType)}, nil
+	}
+
+	io, _, stdout, _ := iostreams.Test()
+	f := cmdtest.StubFactory("https://gitlab.com/OWNER/REPO")
+	f.IO = io
+
+	_, err := cmdtest.RunCommand(NewCmdTimeline(f), args)
+	return stdout.String(), err
+}
+
+func TestTimelineAdd(t *testing.T) {
+	var gotOpts *api.CreateIncidentTimelineEventOptions
+	api.CreateIncidentTimelineEvent = func(client *gitlab.Client, incidentID int, opts *api.CreateIncidentTimelineEventOptions) (*api.IncidentTimelineEvent, error) {
+		assert.Equal(t, 4567, incidentID)
+		gotOpts = opts
+		return &api.IncidentTimelineEvent{OccurredAt: opts.OccurredAt}, nil
+	}
+
+	output, err := runCommand(t, "incident", `add 12 -m "Alert fired" --occurred-at 09:42 --tag "impact detected"`)
+	require.NoError(t, err)
+
+	assert.Equal(t, &api.CreateIncidentTimelineEventOptions{
+		Note:       "Alert fired",
+		OccurredAt: time.Date(2025, 6, 20, 9, 42, 0, 0, time.UTC),
+		Tags:       []string{"Impact detected"},
+	}, gotOpts)
+	assert.Equal(t, "✓ Added an event at 2025-06-20T09:42:00Z to the timeline of incident #12.\n", output)
+}
+
+func TestTimelineAdd_Errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		issueType string
+		args      string
+		wantErr   string
+	}{
+		{
+			name:      "no note",
+			issueType: "incident",
+			args:      "add 12",
+			wantErr:   "--note is required.",
+		},
+		{
+			name:      "invalid time",
+			issueType: "incident",
+			args:      `add 12 -m note --occurred-at yesterday`,
+			wantErr:   `invalid --occurred-at "yesterday": use the RFC 3339 format, like 2025-06-20T14:05:00Z, or a time like 14:05.`,
+		},
+		{
+			name:      "invalid tag",
+			issueType: "incident",
+			args:      `add 12 -m note --tag outage`,
+			wantErr:   `invalid --tag "outage": use Start time, End time, Impact detected, Response initiated, Impact mitigated, Cause identified.`,
+		},
+		{
+			name:      "not an incident",
+			issueType: "issue",
+			args:      `add 12 -m note`,
+			wantErr:   "Incident not found, but an issue with the provided ID exists. Run `glab issue view <id>` to view.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := runCommand(t, tc.issueType, tc.args)
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestTimelineList(t *testing.T) {
+	api.ListIncidentTimelineEvents = func(client *gitlab.Client, projectPath string, incidentID int) ([]*api.IncidentTimelineEvent, error) {
+		assert.Equal(t, "OWNER/REPO", projectPath)
+		assert.Equal(t, 4567, incidentID)
+
+		rollback := &api.IncidentTimelineEvent{Note: "Rolled back", OccurredAt: time.Date(2025, 6, 20, 10, 15, 0, 0, time.UTC)}
+		rollback.Author.Username = "bob"
+		alert := &api.IncidentTimelineEvent{Note: "Alert fired", OccurredAt: time.Date(2025, 6, 20, 9, 42, 0, 0, time.UTC)}
+		alert.Author.Username = "alice"
+		alert.TimelineEventTags.Nodes = append(alert.TimelineEventTags.Nodes, struct {
+			Name string `json:"name"`
+		}{Name: "Impact detected"})
+		return []*api.IncidentTimelineEvent{rollback, alert}, nil
+	}
+
+	output, err := runCommand(t, "incident", "list 12")
+	require.NoError(t, err)
+
+	assert.Contains(t, output, "Timeline of incident #12: API returns 500 errors\n")
+	assert.Regexp(t, `2025-06-20 09:42 UTC\s+alice\s+Impact detected\s+Alert fired\n2025-06-20 10:15 UTC\s+bob\s+Rolled back\n`, output)
+}
@@ -15,6 +15,7 @@ import (
 	"gitlab.com/gitlab-org/cli/commands/flag"
 	"gitlab.com/gitlab-org/cli/commands/issuable"
 	"gitlab.com/gitlab-org/cli/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/commands/iteration/iterationutils"
 	"gitlab.com/gitlab-org/cli/pkg/utils"
 
 	"github.com/spf13/cobra"
@@ -34,7 +35,7 @@ type ListOptions struct {
 	Search      string
 	Group       string
 	IssueType   string
-	Iteration   int
+	Iteration   string
 
 	// issue states
 	State        string
@@ -149,7 +150,7 @@ func NewCmdList(f *cmdutils.Factory, runE func(opts *ListOptions) error, issueTy
 
 	if issueType == issuable.TypeIssue {
 		issueListCmd.Flags().StringVarP(&opts.IssueType, "issue-type", "t", "", "Filter issue by its type. Options: issue, incident, test_case.")
-		issueListCmd.Flags().IntVarP(&opts.Iteration, "iteration", "i", 0, "Filter issue by iteration <id>.")
+		issueListCmd.Flags().StringVarP(&opts.Iteration, "iteration", "i", "", "Filter issue by iteration <id>, @current, or @next.")
 	}
 
 	issueListCmd.Flags().BoolP("opened", "o", false, fmt.Sprintf("Get only open %ss.", issueType))
@@ -247,8 +248,13 @@ func listRun(opts *ListOptions) error {
 		opts.ListType = "search"
 		issueType = opts.IssueType
 	}
-	if issueType == "issue" && opts.Iteration != 0 {
-		listOpts.IterationID = gitlab.Ptr(opts.Iteration)
+	if issueType == "issue" && opts.Iteration != "" {
+		scope := cmdutils.Scope{Group: opts.Group, Project: repo.FullName()}
+		iterationID, err := iterationutils.ResolveID(apiClient, scope, opts.Iteration)
+		if err != nil {
+			return err
+		}
+		listOpts.IterationID = gitlab.Ptr(iterationID)
 	}
 
 	var issues []*gitlab.Issue
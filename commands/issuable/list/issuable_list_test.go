@@ -207,6 +207,29 @@ func TestIssueList_filterByIteration(t *testing.T) {
 	cmdtest.Eq(t, output.String(), `No open issues match your search in OWNER/REPO.
 
 
+`)
+}
+
+func TestIssueList_filterByCurrentIteration(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{
+		MatchURL: httpmock.PathAndQuerystring,
+	}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/iterations?include_ancestors=true&page=1&per_page=100&state=current",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 11, "title": "Sprint 11", "state": 2}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues?in=title%2Cdescription&iteration_id=11&page=1&per_page=30&state=opened",
+		httpmock.NewStringResponse(http.StatusOK, `[]`))
+
+	output, err := runCommand("issue", fakeHTTP, true, "--iteration @current", nil, "")
+	if err != nil {
+		t.Errorf("error running command `issue list`: %v", err)
+	}
+
+	cmdtest.Eq(t, output.Stderr(), "")
+	cmdtest.Eq(t, output.String(), `No open issues match your search in OWNER/REPO.
+
+
 `)
 }
 
@@ -0,0 +1,70 @@
+package timetracking
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+)
+
+// idArgs returns the usage of the argument selecting the item.
+func (k Kind) idArgs() string {
+	if k == MergeRequest {
+		return "[<id> | <branch>]"
+	}
+	return "<id>"
+}
+
+// itemArgs splits the arguments selecting the item from the duration, which is last.
+func (k Kind) itemArgs() cobra.PositionalArgs {
+	if k == MergeRequest {
+		return cobra.RangeArgs(1, 2)
+	}
+	return cobra.ExactArgs(2)
+}
+
+func newCmdAdd(f *cmdutils.Factory, kind Kind) *cobra.Command {
+	var summary string
+
+	timeAddCmd := &cobra.Command{
+		Use:   fmt.Sprintf("add %s <duration> [flags]", kind.idArgs()),
+		Short: fmt.Sprintf(`Add time spent on %s.`, kind.withArticle()),
+		Long: heredoc.Doc(`
+			Add time spent, like 1h30m, 3d, or 1w 2d. Durations use the time tracking
+			units of GitLab: mo, w, d, h, m, and s.
+
+			To subtract time, use a negative duration after --, like -- -30m.
+		`),
+		Example: heredoc.Doc(fmt.Sprintf(`
+			glab %[1]s time add 123 1h30m
+			glab %[1]s time add 123 2h --summary "Code review"
+			glab %[1]s time add 123 -- -30m
+		`, kind)),
+		Args: kind.itemArgs(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			duration := args[len(args)-1]
+			if err := validateDuration(duration); err != nil {
+				return err
+			}
+
+			apiClient, it, err := find(f, kind, args[:len(args)-1])
+			if err != nil {
+				return err
+			}
+
+			stats, err := it.addSpent(apiClient, duration, summary)
+			if err != nil {
+				return fmt.Errorf("failed to add time spent on %s: %w", it.reference, err)
+			}
+
+			printStats(f.IO, fmt.Sprintf("Added %s to %s.", duration, it.reference), stats)
+			return nil
+		},
+	}
+
+	timeAddCmd.Flags().StringVarP(&summary, "summary", "m", "", "Summary of the work done.")
+
+	return timeAddCmd
+}
@@ -0,0 +1,47 @@
+package timetracking
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+)
+
+func newCmdEstimate(f *cmdutils.Factory, kind Kind) *cobra.Command {
+	timeEstimateCmd := &cobra.Command{
+		Use:   fmt.Sprintf("estimate %s <duration>", kind.idArgs()),
+		Short: fmt.Sprintf(`Set the time estimate of %s.`, kind.withArticle()),
+		Long: heredoc.Doc(`
+			Set the time estimate, like 1h30m, 3d, or 1w 2d. The estimate replaces the
+			previous estimate. To remove it, use the reset command.
+		`),
+		Example: heredoc.Doc(fmt.Sprintf(`
+			glab %[1]s time estimate 123 3d
+			glab %[1]s time estimate 123 "1w 2d"
+		`, kind)),
+		Args: kind.itemArgs(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			duration := args[len(args)-1]
+			if err := validateDuration(duration); err != nil {
+				return err
+			}
+
+			apiClient, it, err := find(f, kind, args[:len(args)-1])
+			if err != nil {
+				return err
+			}
+
+			stats, err := it.setEstimate(apiClient, duration)
+			if err != nil {
+				return fmt.Errorf("failed to set the time estimate of %s: %w", it.reference, err)
+			}
+
+			printStats(f.IO, fmt.Sprintf("Set the time estimate of %s to %s.", it.reference, duration), stats)
+			return nil
+		},
+	}
+
+	return timeEstimateCmd
+}
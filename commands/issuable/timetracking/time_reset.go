@@ -0,0 +1,65 @@
+package timetracking
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+)
+
+func newCmdReset(f *cmdutils.Factory, kind Kind) *cobra.Command {
+	var spent, estimate bool
+
+	args := cobra.ExactArgs(1)
+	if kind == MergeRequest {
+		args = cobra.MaximumNArgs(1)
+	}
+
+	timeResetCmd := &cobra.Command{
+		Use:   fmt.Sprintf("reset %s [flags]", kind.idArgs()),
+		Short: fmt.Sprintf(`Reset the time spent on %s, or its time estimate.`, kind.withArticle()),
+		Long:  ``,
+		Example: heredoc.Doc(fmt.Sprintf(`
+			glab %[1]s time reset 123 --spent
+			glab %[1]s time reset 123 --estimate
+			glab %[1]s time reset 123 --spent --estimate
+		`, kind)),
+		Args: args,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !spent && !estimate {
+				return &cmdutils.FlagError{Err: errors.New("specify --spent, --estimate, or both.")}
+			}
+
+			apiClient, it, err := find(f, kind, args)
+			if err != nil {
+				return err
+			}
+
+			var stats *gitlab.TimeStats
+			if spent {
+				stats, err = it.resetSpent(apiClient)
+				if err != nil {
+					return fmt.Errorf("failed to reset the time spent on %s: %w", it.reference, err)
+				}
+			}
+			if estimate {
+				stats, err = it.resetEstimate(apiClient)
+				if err != nil {
+					return fmt.Errorf("failed to reset the time estimate of %s: %w", it.reference, err)
+				}
+			}
+
+			printStats(f.IO, fmt.Sprintf("Reset the time tracking of %s.", it.reference), stats)
+			return nil
+		},
+	}
+
+	timeResetCmd.Flags().BoolVar(&spent, "spent", false, "Reset the time spent.")
+	timeResetCmd.Flags().BoolVar(&estimate, "estimate", false, "Reset the time estimate.")
+
+	return timeResetCmd
+}
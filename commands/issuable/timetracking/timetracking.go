@@ -0,0 +1,150 @@
+package timetracking
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/commands/mr/mrutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+// Kind is the kind of item the time is tracked for.
+type Kind string
+
+const (
+	Issue        Kind = "issue"
+	MergeRequest Kind = "mr"
+)
+
+func (k Kind) name() string {
+	if k == MergeRequest {
+		return "merge request"
+	}
+	return "issue"
+}
+
+// withArticle returns the name of the kind with its indefinite article.
+func (k Kind) withArticle() string {
+	if k == MergeRequest {
+		return "a merge request"
+	}
+	return "an issue"
+}
+
+// durationRE matches the durations of GitLab, like 1h30m, 3d, or 1w 2d 4h.
+var durationRE = regexp.MustCompile(`^-?(\d+(\.\d+)?(mo|w|d|h|m|s)\s*)+$`)
+
+func validateDuration(duration string) error {
+	if !durationRE.MatchString(strings.TrimSpace(duration)) {
+		return &cmdutils.FlagError{Err: fmt.Errorf("invalid duration %q: use a duration like 1h30m, 3d, or 1w 2d.", duration)}
+	}
+	return nil
+}
+
+// item is the issue or the merge request the time is tracked for.
+type item struct {
+	kind      Kind
+	repo      glrepo.Interface
+	iid       int
+	reference string
+}
+
+// find returns the item of the arguments. For merge requests, the item of no
+// argument is the merge request of the current branch.
+func find(f *cmdutils.Factory, kind Kind, args []string) (*gitlab.Client, *item, error) {
+	apiClient, err := f.HttpClient()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if kind == MergeRequest {
+		mr, repo, err := mrutils.MRFromArgs(f, args, "any")
+		if err != nil {
+			return nil, nil, err
+		}
+		reference := fmt.Sprintf("!%d", mr.IID)
+		if mr.References != nil && mr.References.Full != "" {
+			reference = mr.References.Full
+		}
+		return apiClient, &item{kind: kind, repo: repo, iid: mr.IID, reference: reference}, nil
+	}
+
+	if len(args) == 0 {
+		return nil, nil, &cmdutils.FlagError{Err: errors.New("specify the ID of the issue.")}
+	}
+	issue, repo, err := issueutils.IssueFromArg(apiClient, f.BaseRepo, args[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	return apiClient, &item{kind: kind, repo: repo, iid: issue.IID, reference: issueutils.Reference(issue)}, nil
+}
+
+func (i *item) setEstimate(client *gitlab.Client, duration string) (*gitlab.TimeStats, error) {
+	opts := &gitlab.SetTimeEstimateOptions{Duration: gitlab.Ptr(duration)}
+	if i.kind == MergeRequest {
+		return api.SetMRTimeEstimate(client, i.repo.FullName(), i.iid, opts)
+	}
+	return api.SetIssueTimeEstimate(client, i.repo.FullName(), i.iid, opts)
+}
+
+func (i *item) resetEstimate(client *gitlab.Client) (*gitlab.TimeStats, error) {
+	if i.kind == MergeRequest {
+		return api.ResetMRTimeEstimate(client, i.repo.FullName(), i.iid)
+	}
+	return api.ResetIssueTimeEstimate(client, i.repo.FullName(), i.iid)
+}
+
+func (i *item) addSpent(client *gitlab.Client, duration, summary string) (*gitlab.TimeStats, error) {
+	opts := &gitlab.AddSpentTimeOptions{Duration: gitlab.Ptr(duration)}
+	if summary != "" {
+		opts.Summary = gitlab.Ptr(summary)
+	}
+	if i.kind == MergeRequest {
+		return api.AddMRTimeSpent(client, i.repo.FullName(), i.iid, opts)
+	}
+	return api.AddIssueTimeSpent(client, i.repo.FullName(), i.iid, opts)
+}
+
+func (i *item) resetSpent(client *gitlab.Client) (*gitlab.TimeStats, error) {
+	if i.kind == MergeRequest {
+		return api.ResetMRTimeSpent(client, i.repo.FullName(), i.iid)
+	}
+	return api.ResetIssueTimeSpent(client, i.repo.FullName(), i.iid)
+}
+
+// printStats prints the time tracking totals of an item after a change.
+func printStats(io *iostreams.IOStreams, message string, stats *gitlab.TimeStats) {
+	c := io.Color()
+	spent, estimate := "0h", "no estimate"
+	if stats.HumanTotalTimeSpent != "" {
+		spent = stats.HumanTotalTimeSpent
+	}
+	if stats.HumanTimeEstimate != "" {
+		estimate = stats.HumanTimeEstimate + " estimated"
+	}
+	fmt.Fprintf(io.StdOut, "%s %s\n  Spent: %s (%s)\n", c.GreenCheck(), message, spent, estimate)
+}
+
+// NewCmdTime returns the `time` command of issues or of merge requests.
+func NewCmdTime(f *cmdutils.Factory, kind Kind) *cobra.Command {
+	timeCmd := &cobra.Command{
+		Use:   "time <command> [flags]",
+		Short: fmt.Sprintf(`Track the time spent on %ss.`, kind.name()),
+		Long:  ``,
+	}
+
+	timeCmd.AddCommand(newCmdAdd(f, kind))
+	timeCmd.AddCommand(newCmdEstimate(f, kind))
+	timeCmd.AddCommand(newCmdReset(f, kind))
+
+	return timeCmd
+}
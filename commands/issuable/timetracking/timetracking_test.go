@@ -0,0 +1,94 @@
+package timetracking
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+func TestMain(m *testing.M) {
+	cmdtest.InitTest(m, "timetracking_test")
+}
+
+func runCommand(t *testing.T, kind Kind, args string) (string, error) {
+	t.Helper()
+
+	api.GetIssue = func(client *gitlab.Client, projectID interface{}, issueID int) (*gitlab.Issue, error) {
+		return &gitlab.Issue{IID: issueID, References: &gitlab.IssueReferences{Full: "OWNER/REPO#12"}}, nil
+	}
+	api.GetMR = func(client *gitlab.Client, projectID interface{}, mrID int, opts *gitlab.GetMergeRequestsOptions) (*gitlab.MergeRequest, error) {
+		mr := &gitlab.MergeRequest{}
+		mr.IID = mrID
+		return mr, nil
+	}
+
+	io, _, stdout, _ := iostreams.Test()
+	f := cmdtest.StubFactory("https://gitlab.com/OWNER/REPO")
+	f.IO = io
+
+	_, err := cmdtest.RunCommand(NewCmdTime(f, kind), args)
+	return stdout.String(), err
+}
+
+func TestTimeAdd(t *testing.T) {
+	var gotOpts *gitlab.AddSpentTimeOptions
+	api.AddIssueTimeSpent = func(client *gitlab.Client, projectID interface{}, issueIID int, opts *gitlab.AddSpentTimeOptions) (*gitlab.TimeStats, error) {
+		assert.Equal(t, "OWNER/REPO", projectID)
+		assert.Equal(t, 12, issueIID)
+		gotOpts = opts
+		return &gitlab.TimeStats{HumanTotalTimeSpent: "3h 30m", HumanTimeEstimate: "1d"}, nil
+	}
+
+	output, err := runCommand(t, Issue, `add 12 1h30m --summary "Code review"`)
+	require.NoError(t, err)
+
+	assert.Equal(t, &gitlab.AddSpentTimeOptions{Duration: gitlab.Ptr("1h30m"), Summary: gitlab.Ptr("Code review")}, gotOpts)
+	assert.Equal(t, "✓ Added 1h30m to OWNER/REPO#12.\n  Spent: 3h 30m (1d estimated)\n", output)
+}
+
+func TestTimeEstimate_MergeRequest(t *testing.T) {
+	var gotOpts *gitlab.SetTimeEstimateOptions
+	api.SetMRTimeEstimate = func(client *gitlab.Client, projectID interface{}, mrID int, opts *gitlab.SetTimeEstimateOptions) (*gitlab.TimeStats, error) {
+		assert.Equal(t, 3, mrID)
+		gotOpts = opts
+		return &gitlab.TimeStats{HumanTimeEstimate: "1w 2d"}, nil
+	}
+
+	output, err := runCommand(t, MergeRequest, `estimate 3 "1w 2d"`)
+	require.NoError(t, err)
+
+	assert.Equal(t, &gitlab.SetTimeEstimateOptions{Duration: gitlab.Ptr("1w 2d")}, gotOpts)
+	assert.Equal(t, "✓ Set the time estimate of !3 to 1w 2d.\n  Spent: 0h (1w 2d estimated)\n", output)
+}
+
+func TestTimeReset(t *testing.T) {
+	var reset []string
+	api.ResetIssueTimeSpent = func(client *gitlab.Client, projectID interface{}, issueIID int) (*gitlab.TimeStats, error) {
+		reset = append(reset, "spent")
+		return &gitlab.TimeStats{HumanTimeEstimate: "1d"}, nil
+	}
+	api.ResetIssueTimeEstimate = func(client *gitlab.Client, projectID interface{}, issueIID int) (*gitlab.TimeStats, error) {
+		reset = append(reset, "estimate")
+		return &gitlab.TimeStats{}, nil
+	}
+
+	output, err := runCommand(t, Issue, "reset 12 --spent --estimate")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"spent", "estimate"}, reset)
+	assert.Equal(t, "✓ Reset the time tracking of OWNER/REPO#12.\n  Spent: 0h (no estimate)\n", output)
+
+	_, err = runCommand(t, Issue, "reset 12")
+	assert.EqualError(t, err, "specify --spent, --estimate, or both.")
+}
+
+func TestTimeAdd_InvalidDuration(t *testing.T) {
+	_, err := runCommand(t, Issue, "add 12 90minutes")
+	assert.EqualError(t, err, `invalid duration "90minutes": use a duration like 1h30m, 3d, or 1w 2d.`)
+}
@@ -3,7 +3,11 @@ package board
 import (
 	"github.com/spf13/cobra"
 	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	boardColumnCmd "gitlab.com/gitlab-org/cli/commands/issue/board/column"
 	boardCreateCmd "gitlab.com/gitlab-org/cli/commands/issue/board/create"
+	boardDeleteCmd "gitlab.com/gitlab-org/cli/commands/issue/board/delete"
+	boardListCmd "gitlab.com/gitlab-org/cli/commands/issue/board/list"
+	boardUpdateCmd "gitlab.com/gitlab-org/cli/commands/issue/board/update"
 	boardViewCmd "gitlab.com/gitlab-org/cli/commands/issue/board/view"
 )
 
@@ -16,6 +20,10 @@ func NewCmdBoard(f *cmdutils.Factory) *cobra.Command {
 
 	issueCmd.AddCommand(boardCreateCmd.NewCmdCreate(f))
 	issueCmd.AddCommand(boardViewCmd.NewCmdView(f))
+	issueCmd.AddCommand(boardListCmd.NewCmdList(f))
+	issueCmd.AddCommand(boardUpdateCmd.NewCmdUpdate(f))
+	issueCmd.AddCommand(boardDeleteCmd.NewCmdDelete(f))
+	issueCmd.AddCommand(boardColumnCmd.NewCmdColumn(f))
 	issueCmd.PersistentFlags().StringP("repo", "R", "", "Select another repository using the OWNER/REPO format or the project ID. Supports group namespaces")
 
 	return issueCmd
@@ -0,0 +1,82 @@
+package boardutils
+
+import (
+	"fmt"
+	"strconv"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+)
+
+// ParseID parses the ID of a board or of a board list.
+func ParseID(value, name string) (int, error) {
+	id, err := strconv.Atoi(value)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("invalid %s ID: %q", name, value)
+	}
+	return id, nil
+}
+
+// LabelID returns the ID of the label with the given name or ID in the scope.
+func LabelID(client *gitlab.Client, scope cmdutils.Scope, label string) (int, error) {
+	if scope.IsGroup() {
+		l, err := api.GetGroupLabel(client, scope.Group, label)
+		if err != nil {
+			return 0, fmt.Errorf("finding label %q: %w", label, err)
+		}
+		return l.ID, nil
+	}
+
+	l, err := api.GetLabel(client, scope.Project, label)
+	if err != nil {
+		return 0, fmt.Errorf("finding label %q: %w", label, err)
+	}
+	return l.ID, nil
+}
+
+// MilestoneID returns the ID of the milestone with the given title or ID in the scope.
+func MilestoneID(client *gitlab.Client, scope cmdutils.Scope, milestone string) (int, error) {
+	if id, err := strconv.Atoi(milestone); err == nil {
+		return id, nil
+	}
+
+	if scope.IsGroup() {
+		m, err := api.GroupMilestoneByTitle(client, scope.Group, milestone)
+		if err != nil {
+			return 0, err
+		}
+		return m.ID, nil
+	}
+
+	m, err := api.ProjectMilestoneByTitle(client, scope.Project, milestone)
+	if err != nil {
+		return 0, err
+	}
+	return m.ID, nil
+}
+
+// UserID returns the ID of the user with the given username.
+func UserID(client *gitlab.Client, username string) (int, error) {
+	user, err := api.UserByName(client, username)
+	if err != nil {
+		return 0, err
+	}
+	return user.ID, nil
+}
+
+// ListKind returns the kind of a board list, and the name of what it lists.
+func ListKind(l *gitlab.BoardList) (string, string) {
+	switch {
+	case l.Label != nil:
+		return "label", l.Label.Name
+	case l.Milestone != nil:
+		return "milestone", l.Milestone.Title
+	case l.Assignee != nil:
+		return "assignee", l.Assignee.Username
+	case l.Iteration != nil:
+		return "iteration", l.Iteration.Title
+	}
+	return "unknown", ""
+}
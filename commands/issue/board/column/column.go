@@ -0,0 +1,27 @@
+package column
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+)
+
+func NewCmdColumn(f *cmdutils.Factory) *cobra.Command {
+	columnCmd := &cobra.Command{
+		Use:   "column <command> [flags]",
+		Short: `Manage the lists of an issue board.`,
+		Long: heredoc.Doc(`
+			Manage the lists of an issue board. Each list, shown as a column of the board,
+			holds the issues with a label or a milestone, assigned to a user, or in an iteration.
+		`),
+		Aliases: []string{"columns"},
+	}
+
+	columnCmd.AddCommand(NewCmdList(f))
+	columnCmd.AddCommand(NewCmdAdd(f))
+	columnCmd.AddCommand(NewCmdDelete(f))
+	columnCmd.AddCommand(NewCmdMove(f))
+
+	return columnCmd
+}
@@ -0,0 +1,127 @@
+package column
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/issue/board/boardutils"
+	"gitlab.com/gitlab-org/cli/commands/iteration/iterationutils"
+)
+
+type addOptions struct {
+	label     string
+	milestone string
+	assignee  string
+	iteration string
+}
+
+func NewCmdAdd(f *cmdutils.Factory) *cobra.Command {
+	opts := &addOptions{}
+
+	columnAddCmd := &cobra.Command{
+		Use:   "add <board-id> [flags]",
+		Short: `Add a list to an issue board.`,
+		Long: heredoc.Doc(`
+			Add a list to an issue board, for a label, a milestone, an assignee, or an iteration.
+			The list is added at the end of the board. Group boards only support label lists.
+		`),
+		Example: heredoc.Doc(`
+			glab issue board column add 42 --label "In review"
+			glab issue board column add 42 --milestone v1.0
+			glab issue board column add 42 --assignee alice
+			glab issue board column add 42 --iteration 7
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			boardID, err := boardutils.ParseID(args[0], "board")
+			if err != nil {
+				return err
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			scope, err := cmdutils.ScopeFromCmd(cmd, f)
+			if err != nil {
+				return err
+			}
+
+			if scope.IsGroup() && opts.label == "" {
+				return &cmdutils.FlagError{Err: errors.New("group boards only support label lists. Use --label.")}
+			}
+
+			createOpts, err := opts.createOptions(apiClient, scope)
+			if err != nil {
+				return err
+			}
+
+			var boardList *gitlab.BoardList
+			if scope.IsGroup() {
+				boardList, err = api.CreateGroupIssueBoardList(apiClient, scope.Group, boardID, &gitlab.CreateGroupIssueBoardListOptions{
+					LabelID: createOpts.LabelID,
+				})
+			} else {
+				boardList, err = api.CreateIssueBoardList(apiClient, scope.Project, boardID, createOpts)
+			}
+			if err != nil {
+				return err
+			}
+
+			kind, name := boardutils.ListKind(boardList)
+			fmt.Fprintf(f.IO.StdOut, "%s Added %s list %q to board %d, with ID %d.\n", f.IO.Color().GreenCheck(), kind, name, boardID, boardList.ID)
+			return nil
+		},
+	}
+
+	cmdutils.EnableGroupFlag(columnAddCmd, "boards")
+	columnAddCmd.Flags().StringVarP(&opts.label, "label", "l", "", "Add a list for the label, by name or ID.")
+	columnAddCmd.Flags().StringVarP(&opts.milestone, "milestone", "m", "", "Add a list for the milestone, by title or ID.")
+	columnAddCmd.Flags().StringVarP(&opts.assignee, "assignee", "a", "", "Add a list for the assignee, by username.")
+	columnAddCmd.Flags().StringVarP(&opts.iteration, "iteration", "i", "", "Add a list for the iteration, by ID, @current, or @next.")
+	columnAddCmd.MarkFlagsMutuallyExclusive("label", "milestone", "assignee", "iteration")
+	columnAddCmd.MarkFlagsOneRequired("label", "milestone", "assignee", "iteration")
+
+	return columnAddCmd
+}
+
+// createOptions returns the options to create a board list for the kind of list set in opts.
+func (opts *addOptions) createOptions(client *gitlab.Client, scope cmdutils.Scope) (*gitlab.CreateIssueBoardListOptions, error) {
+	createOpts := &gitlab.CreateIssueBoardListOptions{}
+
+	switch {
+	case opts.label != "":
+		labelID, err := boardutils.LabelID(client, scope, opts.label)
+		if err != nil {
+			return nil, err
+		}
+		createOpts.LabelID = gitlab.Ptr(labelID)
+	case opts.milestone != "":
+		milestoneID, err := boardutils.MilestoneID(client, scope, opts.milestone)
+		if err != nil {
+			return nil, err
+		}
+		createOpts.MilestoneID = gitlab.Ptr(milestoneID)
+	case opts.assignee != "":
+		assigneeID, err := boardutils.UserID(client, opts.assignee)
+		if err != nil {
+			return nil, err
+		}
+		createOpts.AssigneeID = gitlab.Ptr(assigneeID)
+	case opts.iteration != "":
+		iterationID, err := iterationutils.ResolveID(client, scope, opts.iteration)
+		if err != nil {
+			return nil, err
+		}
+		createOpts.IterationID = gitlab.Ptr(iterationID)
+	}
+
+	return createOpts, nil
+}
@@ -0,0 +1,60 @@
+package column
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/issue/board/boardutils"
+)
+
+func NewCmdDelete(f *cmdutils.Factory) *cobra.Command {
+	columnDeleteCmd := &cobra.Command{
+		Use:     "delete <board-id> <list-id> [flags]",
+		Short:   `Remove a list from an issue board.`,
+		Aliases: []string{"del"},
+		Example: heredoc.Doc(`
+			glab issue board column delete 42 7
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			boardID, err := boardutils.ParseID(args[0], "board")
+			if err != nil {
+				return err
+			}
+			listID, err := boardutils.ParseID(args[1], "list")
+			if err != nil {
+				return err
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			scope, err := cmdutils.ScopeFromCmd(cmd, f)
+			if err != nil {
+				return err
+			}
+
+			if scope.IsGroup() {
+				err = api.DeleteGroupIssueBoardList(apiClient, scope.Group, boardID, listID)
+			} else {
+				err = api.DeleteIssueBoardList(apiClient, scope.Project, boardID, listID)
+			}
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(f.IO.StdOut, "%s Removed list %d from board %d.\n", f.IO.Color().RedCheck(), listID, boardID)
+			return nil
+		},
+	}
+
+	cmdutils.EnableGroupFlag(columnDeleteCmd, "boards")
+
+	return columnDeleteCmd
+}
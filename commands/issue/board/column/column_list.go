@@ -0,0 +1,80 @@
+package column
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/issue/board/boardutils"
+	"gitlab.com/gitlab-org/cli/pkg/tableprinter"
+)
+
+func NewCmdList(f *cmdutils.Factory) *cobra.Command {
+	var outputFormat string
+
+	columnListCmd := &cobra.Command{
+		Use:     "list <board-id> [flags]",
+		Short:   `List the lists of an issue board.`,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			glab issue board column list 42
+			glab issue board column list 42 --group my-group --output json
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			boardID, err := boardutils.ParseID(args[0], "board")
+			if err != nil {
+				return err
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			scope, err := cmdutils.ScopeFromCmd(cmd, f)
+			if err != nil {
+				return err
+			}
+
+			var boardLists []*gitlab.BoardList
+			if scope.IsGroup() {
+				boardLists, err = api.GetGroupIssueBoardLists(apiClient, scope.Group, boardID, &gitlab.ListGroupIssueBoardListsOptions{})
+			} else {
+				boardLists, err = api.GetIssueBoardLists(apiClient, scope.Project, boardID, &gitlab.GetIssueBoardListsOptions{})
+			}
+			if err != nil {
+				return err
+			}
+
+			if outputFormat == "json" {
+				boardListsJSON, _ := json.Marshal(boardLists)
+				fmt.Fprintln(f.IO.StdOut, string(boardListsJSON))
+				return nil
+			}
+
+			fmt.Fprint(f.IO.StdOut, formatBoardLists(boardLists))
+			return nil
+		},
+	}
+
+	cmdutils.EnableGroupFlag(columnListCmd, "boards")
+	columnListCmd.Flags().StringVarP(&outputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return columnListCmd
+}
+
+func formatBoardLists(boardLists []*gitlab.BoardList) string {
+	table := tableprinter.NewTablePrinter()
+	table.AddRow("ID", "POSITION", "TYPE", "NAME")
+	for _, l := range boardLists {
+		kind, name := boardutils.ListKind(l)
+		table.AddRow(l.ID, l.Position, kind, name)
+	}
+	return table.String()
+}
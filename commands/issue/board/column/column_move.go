@@ -0,0 +1,77 @@
+package column
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/issue/board/boardutils"
+)
+
+func NewCmdMove(f *cmdutils.Factory) *cobra.Command {
+	var position int
+
+	columnMoveCmd := &cobra.Command{
+		Use:   "move <board-id> <list-id> --position <position> [flags]",
+		Short: `Move a list of an issue board to another position.`,
+		Long: heredoc.Doc(`
+			Move a list of an issue board to another position. Positions start at 0,
+			for the first list after the Open list.
+		`),
+		Example: heredoc.Doc(`
+			glab issue board column move 42 7 --position 0
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			boardID, err := boardutils.ParseID(args[0], "board")
+			if err != nil {
+				return err
+			}
+			listID, err := boardutils.ParseID(args[1], "list")
+			if err != nil {
+				return err
+			}
+
+			if position < 0 {
+				return &cmdutils.FlagError{Err: errors.New("--position must be 0 or more.")}
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			scope, err := cmdutils.ScopeFromCmd(cmd, f)
+			if err != nil {
+				return err
+			}
+
+			if scope.IsGroup() {
+				_, err = api.UpdateGroupIssueBoardList(apiClient, scope.Group, boardID, listID, &gitlab.UpdateGroupIssueBoardListOptions{
+					Position: gitlab.Ptr(position),
+				})
+			} else {
+				_, err = api.UpdateIssueBoardList(apiClient, scope.Project, boardID, listID, &gitlab.UpdateIssueBoardListOptions{
+					Position: gitlab.Ptr(position),
+				})
+			}
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(f.IO.StdOut, "%s Moved list %d of board %d to position %d.\n", f.IO.Color().GreenCheck(), listID, boardID, position)
+			return nil
+		},
+	}
+
+	cmdutils.EnableGroupFlag(columnMoveCmd, "boards")
+	columnMoveCmd.Flags().IntVarP(&position, "position", "p", 0, "New position of the list.")
+	_ = columnMoveCmd.MarkFlagRequired("position")
+
+	return columnMoveCmd
+}
@@ -0,0 +1,127 @@
+package column
+
+import (
+	"testing"
+
+	"github.com/acarl005/stripansi"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+func TestNewCmdColumn(t *testing.T) {
+	api.GetIssueBoardLists = func(client *gitlab.Client, projectID interface{}, boardID int, opts *gitlab.GetIssueBoardListsOptions) ([]*gitlab.BoardList, error) {
+		return []*gitlab.BoardList{
+			{ID: 10, Position: 0, Label: &gitlab.Label{Name: "Doing"}},
+			{ID: 11, Position: 1, Milestone: &gitlab.Milestone{Title: "v1.0"}},
+		}, nil
+	}
+	api.GetLabel = func(client *gitlab.Client, projectID interface{}, labelID interface{}) (*gitlab.Label, error) {
+		return &gitlab.Label{ID: 5, Name: labelID.(string)}, nil
+	}
+	api.CreateIssueBoardList = func(client *gitlab.Client, projectID interface{}, boardID int, opts *gitlab.CreateIssueBoardListOptions) (*gitlab.BoardList, error) {
+		if opts.IterationID != nil {
+			return &gitlab.BoardList{ID: 14, Iteration: &gitlab.ProjectIteration{ID: *opts.IterationID, Title: "Sprint 13"}}, nil
+		}
+		return &gitlab.BoardList{ID: 13, Label: &gitlab.Label{ID: *opts.LabelID, Name: "Review"}}, nil
+	}
+	api.ListProjectIterations = func(client *gitlab.Client, projectID interface{}, opts *gitlab.ListProjectIterationsOptions) ([]*gitlab.ProjectIteration, error) {
+		return []*gitlab.ProjectIteration{{ID: 23, Title: "Sprint 13", State: api.IterationStateUpcoming, StartDate: gitlab.Ptr(gitlab.ISOTime{})}}, nil
+	}
+	var movedTo int
+	api.UpdateIssueBoardList = func(client *gitlab.Client, projectID interface{}, boardID, listID int, opts *gitlab.UpdateIssueBoardListOptions) (*gitlab.BoardList, error) {
+		movedTo = *opts.Position
+		return &gitlab.BoardList{ID: listID, Position: *opts.Position}, nil
+	}
+	api.DeleteIssueBoardList = func(client *gitlab.Client, projectID interface{}, boardID, listID int) error {
+		return nil
+	}
+
+	tests := []struct {
+		name    string
+		newCmd  func(*cmdutils.Factory) *cobra.Command
+		arg     string
+		want    []string
+		wantErr string
+	}{
+		{
+			name:   "list",
+			newCmd: NewCmdList,
+			arg:    "42",
+			want: []string{
+				"ID\tPOSITION\tTYPE\tNAME",
+				"10\t0\tlabel\tDoing",
+				"11\t1\tmilestone\tv1.0",
+			},
+		},
+		{
+			name:   "add label list",
+			newCmd: NewCmdAdd,
+			arg:    `42 --label Review`,
+			want:   []string{`Added label list "Review" to board 42, with ID 13.`},
+		},
+		{
+			name:   "add list for the next iteration",
+			newCmd: NewCmdAdd,
+			arg:    `42 --iteration @next`,
+			want:   []string{`Added iteration list "Sprint 13" to board 42, with ID 14.`},
+		},
+		{
+			name:    "add milestone list to group board",
+			newCmd:  NewCmdAdd,
+			arg:     `42 --milestone v1.0 --group my-group`,
+			wantErr: "group boards only support label lists. Use --label.",
+		},
+		{
+			name:   "move",
+			newCmd: NewCmdMove,
+			arg:    "42 10 --position 2",
+			want:   []string{"Moved list 10 of board 42 to position 2."},
+		},
+		{
+			name:   "delete",
+			newCmd: NewCmdDelete,
+			arg:    "42 10",
+			want:   []string{"Removed list 10 from board 42."},
+		},
+		{
+			name:    "delete with invalid list ID",
+			newCmd:  NewCmdDelete,
+			arg:     "42 doing",
+			wantErr: `invalid list ID: "doing"`,
+		},
+	}
+
+	io, _, stdout, _ := iostreams.Test()
+	f := cmdtest.StubFactory("https://gitlab.com/cli-automated-testing/test")
+	f.IO = io
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stdout.Reset()
+
+			cmd := tc.newCmd(f)
+			cmdutils.EnableRepoOverride(cmd, f)
+
+			_, err := cmdtest.RunCommand(cmd, tc.arg)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			out := stripansi.Strip(stdout.String())
+			for _, want := range tc.want {
+				assert.Contains(t, out, want)
+			}
+		})
+	}
+
+	assert.Equal(t, 2, movedTo)
+}
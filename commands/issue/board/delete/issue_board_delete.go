@@ -0,0 +1,57 @@
+package delete
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/issue/board/boardutils"
+)
+
+func NewCmdDelete(f *cmdutils.Factory) *cobra.Command {
+	boardDeleteCmd := &cobra.Command{
+		Use:     "delete <board-id> [flags]",
+		Short:   `Delete an issue board of a project or group.`,
+		Aliases: []string{"del"},
+		Example: heredoc.Doc(`
+			glab issue board delete 42
+			glab issue board delete 42 --group my-group
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			boardID, err := boardutils.ParseID(args[0], "board")
+			if err != nil {
+				return err
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			scope, err := cmdutils.ScopeFromCmd(cmd, f)
+			if err != nil {
+				return err
+			}
+
+			if scope.IsGroup() {
+				err = api.DeleteGroupIssueBoard(apiClient, scope.Group, boardID)
+			} else {
+				err = api.DeleteIssueBoard(apiClient, scope.Project, boardID)
+			}
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(f.IO.StdOut, "%s Deleted board %d from %s.\n", f.IO.Color().RedCheck(), boardID, scope)
+			return nil
+		},
+	}
+
+	cmdutils.EnableGroupFlag(boardDeleteCmd, "boards")
+
+	return boardDeleteCmd
+}
@@ -0,0 +1,77 @@
+package delete
+
+import (
+	"testing"
+
+	"github.com/acarl005/stripansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+func TestNewCmdDelete(t *testing.T) {
+	var deleted string
+	api.DeleteIssueBoard = func(client *gitlab.Client, projectID interface{}, boardID int) error {
+		deleted = "project"
+		return nil
+	}
+	api.DeleteGroupIssueBoard = func(client *gitlab.Client, groupID interface{}, boardID int) error {
+		deleted = "group"
+		return nil
+	}
+
+	tests := []struct {
+		name        string
+		arg         string
+		want        string
+		wantDeleted string
+		wantErr     string
+	}{
+		{
+			name:        "project board",
+			arg:         "42",
+			want:        "Deleted board 42 from cli-automated-testing/test.",
+			wantDeleted: "project",
+		},
+		{
+			name:        "group board",
+			arg:         "42 --group my-group",
+			want:        "Deleted board 42 from my-group.",
+			wantDeleted: "group",
+		},
+		{
+			name:    "invalid board ID",
+			arg:     "board",
+			wantErr: `invalid board ID: "board"`,
+		},
+	}
+
+	io, _, stdout, _ := iostreams.Test()
+	f := cmdtest.StubFactory("https://gitlab.com/cli-automated-testing/test")
+	f.IO = io
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stdout.Reset()
+			deleted = ""
+
+			cmd := NewCmdDelete(f)
+			cmdutils.EnableRepoOverride(cmd, f)
+
+			_, err := cmdtest.RunCommand(cmd, tc.arg)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Contains(t, stripansi.Strip(stdout.String()), tc.want)
+			assert.Equal(t, tc.wantDeleted, deleted)
+		})
+	}
+}
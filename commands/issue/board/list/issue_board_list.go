@@ -0,0 +1,141 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/tableprinter"
+)
+
+type options struct {
+	outputFormat string
+}
+
+// boardRow is a board of a project or a group, as listed by the command.
+type boardRow struct {
+	id        int
+	name      string
+	milestone string
+	labels    []string
+	assignee  string
+	weight    int
+	lists     int
+}
+
+func NewCmdList(f *cmdutils.Factory) *cobra.Command {
+	opts := &options{}
+
+	boardListCmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List the issue boards of a project or group.`,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			glab issue board list
+			glab issue board list --group my-group
+			glab issue board list --output json
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			scope, err := cmdutils.ScopeFromCmd(cmd, f)
+			if err != nil {
+				return err
+			}
+
+			var boards any
+			var rows []boardRow
+			if scope.IsGroup() {
+				groupBoards, err := api.ListGroupIssueBoards(apiClient, scope.Group, &gitlab.ListGroupIssueBoardsOptions{})
+				if err != nil {
+					return err
+				}
+				boards = groupBoards
+				rows = groupBoardRows(groupBoards)
+			} else {
+				projectBoards, err := api.ListIssueBoards(apiClient, scope.Project, &gitlab.ListIssueBoardsOptions{})
+				if err != nil {
+					return err
+				}
+				boards = projectBoards
+				rows = projectBoardRows(projectBoards)
+			}
+
+			if opts.outputFormat == "json" {
+				boardsJSON, _ := json.Marshal(boards)
+				fmt.Fprintln(f.IO.StdOut, string(boardsJSON))
+				return nil
+			}
+
+			if len(rows) == 0 {
+				fmt.Fprintf(f.IO.StdOut, "No issue boards found for %s.\n", scope)
+				return nil
+			}
+
+			fmt.Fprintf(f.IO.StdOut, "Showing %d issue boards for %s.\n\n", len(rows), scope)
+			fmt.Fprint(f.IO.StdOut, formatBoards(rows))
+			return nil
+		},
+	}
+
+	cmdutils.EnableGroupFlag(boardListCmd, "boards")
+	boardListCmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return boardListCmd
+}
+
+func projectBoardRows(boards []*gitlab.IssueBoard) []boardRow {
+	rows := make([]boardRow, 0, len(boards))
+	for _, board := range boards {
+		row := boardRow{id: board.ID, name: board.Name, weight: board.Weight, lists: len(board.Lists)}
+		if board.Milestone != nil {
+			row.milestone = board.Milestone.Title
+		}
+		if board.Assignee != nil {
+			row.assignee = board.Assignee.Username
+		}
+		for _, label := range board.Labels {
+			row.labels = append(row.labels, label.Name)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func groupBoardRows(boards []*gitlab.GroupIssueBoard) []boardRow {
+	rows := make([]boardRow, 0, len(boards))
+	for _, board := range boards {
+		row := boardRow{id: board.ID, name: board.Name, lists: len(board.Lists)}
+		if board.Milestone != nil {
+			row.milestone = board.Milestone.Title
+		}
+		for _, label := range board.Labels {
+			row.labels = append(row.labels, label.Name)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func formatBoards(rows []boardRow) string {
+	table := tableprinter.NewTablePrinter()
+	table.AddRow("ID", "NAME", "MILESTONE", "LABELS", "ASSIGNEE", "WEIGHT", "LISTS")
+	for _, row := range rows {
+		weight := ""
+		if row.weight > 0 {
+			weight = fmt.Sprint(row.weight)
+		}
+		table.AddRow(row.id, row.name, row.milestone, strings.Join(row.labels, ", "), row.assignee, weight, row.lists)
+	}
+	return table.String()
+}
@@ -0,0 +1,79 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/acarl005/stripansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+func TestNewCmdList(t *testing.T) {
+	api.ListIssueBoards = func(client *gitlab.Client, projectID interface{}, opts *gitlab.ListIssueBoardsOptions) ([]*gitlab.IssueBoard, error) {
+		return []*gitlab.IssueBoard{
+			{
+				ID:        1,
+				Name:      "Development",
+				Milestone: &gitlab.Milestone{Title: "v1.0"},
+				Labels:    []*gitlab.LabelDetails{{Name: "backend"}, {Name: "bug"}},
+				Weight:    3,
+				Lists:     []*gitlab.BoardList{{ID: 10}, {ID: 11}},
+			},
+		}, nil
+	}
+	api.ListGroupIssueBoards = func(client *gitlab.Client, groupID interface{}, opts *gitlab.ListGroupIssueBoardsOptions) ([]*gitlab.GroupIssueBoard, error) {
+		return []*gitlab.GroupIssueBoard{}, nil
+	}
+
+	tests := []struct {
+		name string
+		arg  string
+		want []string
+	}{
+		{
+			name: "project boards",
+			arg:  "",
+			want: []string{
+				"Showing 1 issue boards for cli-automated-testing/test.",
+				"Development", "v1.0", "backend, bug",
+			},
+		},
+		{
+			name: "group without boards",
+			arg:  "--group my-group",
+			want: []string{"No issue boards found for my-group."},
+		},
+		{
+			name: "json output",
+			arg:  "--output json",
+			want: []string{`"name":"Development"`},
+		},
+	}
+
+	io, _, stdout, _ := iostreams.Test()
+	f := cmdtest.StubFactory("https://gitlab.com/cli-automated-testing/test")
+	f.IO = io
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stdout.Reset()
+
+			cmd := NewCmdList(f)
+			cmdutils.EnableRepoOverride(cmd, f)
+
+			_, err := cmdtest.RunCommand(cmd, tc.arg)
+			require.NoError(t, err)
+
+			out := stripansi.Strip(stdout.String())
+			for _, want := range tc.want {
+				assert.Contains(t, out, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package update
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/issue/board/boardutils"
+)
+
+type options struct {
+	name      string
+	milestone string
+	labels    []string
+	assignee  string
+	weight    int
+}
+
+func NewCmdUpdate(f *cmdutils.Factory) *cobra.Command {
+	opts := &options{}
+
+	boardUpdateCmd := &cobra.Command{
+		Use:   "update <board-id> [flags]",
+		Short: `Update the name and scope of an issue board.`,
+		Long: heredoc.Doc(`
+			Update the name of an issue board, and its scope. The scope limits the issues
+			shown on the board to a milestone, labels, an assignee, or a weight.
+		`),
+		Example: heredoc.Doc(`
+			glab issue board update 42 --name "Sprint board"
+			glab issue board update 42 --milestone "v1.0" --labels backend,bug --weight 3
+			glab issue board update 42 --group my-group --assignee @me
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			boardID, err := boardutils.ParseID(args[0], "board")
+			if err != nil {
+				return err
+			}
+
+			if !hasUpdateFlags(cmd) {
+				return &cmdutils.FlagError{Err: errors.New("specify at least one of --name, --milestone, --labels, --assignee, or --weight.")}
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			scope, err := cmdutils.ScopeFromCmd(cmd, f)
+			if err != nil {
+				return err
+			}
+
+			updateOpts, err := opts.updateOptions(cmd, apiClient, scope)
+			if err != nil {
+				return err
+			}
+
+			var name string
+			if scope.IsGroup() {
+				board, err := api.UpdateGroupIssueBoard(apiClient, scope.Group, boardID, &gitlab.UpdateGroupIssueBoardOptions{
+					Name:        updateOpts.Name,
+					AssigneeID:  updateOpts.AssigneeID,
+					MilestoneID: updateOpts.MilestoneID,
+					Labels:      updateOpts.Labels,
+					Weight:      updateOpts.Weight,
+				})
+				if err != nil {
+					return err
+				}
+				name = board.Name
+			} else {
+				board, err := api.UpdateIssueBoard(apiClient, scope.Project, boardID, updateOpts)
+				if err != nil {
+					return err
+				}
+				name = board.Name
+			}
+
+			fmt.Fprintf(f.IO.StdOut, "%s Updated board %d: %q.\n", f.IO.Color().GreenCheck(), boardID, name)
+			return nil
+		},
+	}
+
+	cmdutils.EnableGroupFlag(boardUpdateCmd, "boards")
+	boardUpdateCmd.Flags().StringVarP(&opts.name, "name", "n", "", "New name of the board.")
+	boardUpdateCmd.Flags().StringVarP(&opts.milestone, "milestone", "m", "", "Scope the board to a milestone, by title or ID.")
+	boardUpdateCmd.Flags().StringSliceVarP(&opts.labels, "labels", "l", []string{}, "Scope the board to labels, comma separated.")
+	boardUpdateCmd.Flags().StringVarP(&opts.assignee, "assignee", "a", "", "Scope the board to the issues of an assignee, by username.")
+	boardUpdateCmd.Flags().IntVarP(&opts.weight, "weight", "w", 0, "Scope the board to issues with this weight.")
+
+	return boardUpdateCmd
+}
+
+func hasUpdateFlags(cmd *cobra.Command) bool {
+	for _, name := range []string{"name", "milestone", "labels", "assignee", "weight"} {
+		if cmd.Flags().Changed(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// updateOptions returns the options to update a board with the flags set on cmd.
+func (opts *options) updateOptions(cmd *cobra.Command, client *gitlab.Client, scope cmdutils.Scope) (*gitlab.UpdateIssueBoardOptions, error) {
+	updateOpts := &gitlab.UpdateIssueBoardOptions{}
+
+	if cmd.Flags().Changed("name") {
+		updateOpts.Name = gitlab.Ptr(opts.name)
+	}
+
+	if cmd.Flags().Changed("milestone") {
+		milestoneID, err := boardutils.MilestoneID(client, scope, opts.milestone)
+		if err != nil {
+			return nil, err
+		}
+		updateOpts.MilestoneID = gitlab.Ptr(milestoneID)
+	}
+
+	if cmd.Flags().Changed("labels") {
+		labels := gitlab.LabelOptions(opts.labels)
+		updateOpts.Labels = &labels
+	}
+
+	if cmd.Flags().Changed("assignee") {
+		assigneeID, err := boardutils.UserID(client, opts.assignee)
+		if err != nil {
+			return nil, err
+		}
+		updateOpts.AssigneeID = gitlab.Ptr(assigneeID)
+	}
+
+	if cmd.Flags().Changed("weight") {
+		if opts.weight < 0 {
+			return nil, &cmdutils.FlagError{Err: errors.New("--weight must be a positive number.")}
+		}
+		updateOpts.Weight = gitlab.Ptr(opts.weight)
+	}
+
+	return updateOpts, nil
+}
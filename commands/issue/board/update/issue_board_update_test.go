@@ -0,0 +1,84 @@
+package update
+
+import (
+	"testing"
+
+	"github.com/acarl005/stripansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+func TestNewCmdUpdate(t *testing.T) {
+	var got *gitlab.UpdateIssueBoardOptions
+	api.UpdateIssueBoard = func(client *gitlab.Client, projectID interface{}, boardID int, opts *gitlab.UpdateIssueBoardOptions) (*gitlab.IssueBoard, error) {
+		got = opts
+		name := "Development"
+		if opts.Name != nil {
+			name = *opts.Name
+		}
+		return &gitlab.IssueBoard{ID: boardID, Name: name}, nil
+	}
+	api.ProjectMilestoneByTitle = func(client *gitlab.Client, projectID interface{}, name string) (*gitlab.Milestone, error) {
+		return &gitlab.Milestone{ID: 7, Title: name}, nil
+	}
+
+	tests := []struct {
+		name     string
+		arg      string
+		want     string
+		wantOpts *gitlab.UpdateIssueBoardOptions
+		wantErr  string
+	}{
+		{
+			name:     "rename",
+			arg:      `42 --name "Sprint board"`,
+			want:     `Updated board 42: "Sprint board".`,
+			wantOpts: &gitlab.UpdateIssueBoardOptions{Name: gitlab.Ptr("Sprint board")},
+		},
+		{
+			name: "scope",
+			arg:  `42 --milestone v1.0 --labels backend,bug --weight 3`,
+			want: `Updated board 42: "Development".`,
+			wantOpts: &gitlab.UpdateIssueBoardOptions{
+				MilestoneID: gitlab.Ptr(7),
+				Labels:      &gitlab.LabelOptions{"backend", "bug"},
+				Weight:      gitlab.Ptr(3),
+			},
+		},
+		{
+			name:    "no flags",
+			arg:     "42",
+			wantErr: "specify at least one of --name, --milestone, --labels, --assignee, or --weight.",
+		},
+	}
+
+	io, _, stdout, _ := iostreams.Test()
+	f := cmdtest.StubFactory("https://gitlab.com/cli-automated-testing/test")
+	f.IO = io
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stdout.Reset()
+			got = nil
+
+			cmd := NewCmdUpdate(f)
+			cmdutils.EnableRepoOverride(cmd, f)
+
+			_, err := cmdtest.RunCommand(cmd, tc.arg)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Contains(t, stripansi.Strip(stdout.String()), tc.want)
+			assert.Equal(t, tc.wantOpts, got)
+		})
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"log"
 	"runtime/debug"
 	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/gdamore/tcell/v2"
@@ -28,13 +31,23 @@ var (
 const (
 	closed string = "closed"
 	opened string = "opened"
+
+	// names of the lists added to each board for open and closed issues
+	openListName   = "Open"
+	closedListName = "Closed"
+
+	// IDs of the lists added to each board for open and closed issues. The lists of
+	// GitLab have positive IDs, so labels named like these lists aren't mistaken for them.
+	openListID   = -1
+	closedListID = -2
 )
 
 type issueBoardViewOptions struct {
-	assignee  string
-	labels    []string
-	milestone string
-	state     string
+	assignee        string
+	labels          []string
+	milestone       string
+	state           string
+	refreshInterval time.Duration
 }
 
 type boardMeta struct {
@@ -49,7 +62,29 @@ func NewCmdView(f *cmdutils.Factory) *cobra.Command {
 	viewCmd := &cobra.Command{
 		Use:   "view [flags]",
 		Short: `View project issue board.`,
-		Long:  ``,
+		Long: heredoc.Doc(`
+			View an issue board, and triage its issues from the terminal.
+
+			Use the arrow keys, or h, j, k and l, to select an issue. Move the selected
+			issue to the previous or next list with Shift+Left and Shift+Right, or H and L.
+			Moving an issue relabels it, and moving it to or from the Closed list closes
+			or reopens it.
+
+			Other keys on the selected issue:
+
+			- a: Edit the assignees, comma separated.
+			- w: Edit the weight.
+			- m: Edit the milestone.
+			- o: Open the issue in the browser.
+			- r: Refresh the board.
+			- q: Quit.
+
+			The board refreshes every minute. Use --refresh-interval to change it.
+		`),
+		Example: heredoc.Doc(`
+			glab issue board view
+			glab issue board view --assignee @me --refresh-interval 10s
+		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			var err error
 
@@ -107,59 +142,6 @@ func NewCmdView(f *cmdutils.Factory) *cobra.Command {
 				return fmt.Errorf("getting issue board lists: %w", err)
 			}
 
-			root := tview.NewFlex()
-			root.SetBackgroundColor(tcell.ColorDefault)
-			for _, l := range boardLists {
-				opts.state = ""
-				var boardIssues, listTitle, listColor string
-
-				if l.Label == nil {
-					continue
-				}
-
-				if l.Label != nil {
-					listTitle = l.Label.Name
-					listColor = l.Label.Color
-				}
-
-				// automatically request using state for default "open" and "closed" lists
-				// this is required as these lists aren't returned with the board lists api call
-				switch l.Label.Name {
-				case "Closed":
-					opts.state = closed
-				case "Open":
-					opts.state = opened
-				}
-
-				issues := []*gitlab.Issue{}
-				if selectedBoard.group != nil {
-					groupID := selectedBoard.group.ID
-					issues, err = getGroupBoardIssues(groupID, opts)
-					if err != nil {
-						return fmt.Errorf("getting issue board lists: %w", err)
-					}
-				}
-
-				if selectedBoard.group == nil {
-					issues, err = getProjectBoardIssues(opts)
-					if err != nil {
-						return fmt.Errorf("getting issue board lists: %w", err)
-					}
-				}
-
-				boardIssues = filterIssues(boardLists, issues, l, opts)
-				bx := tview.NewTextView()
-				bx.
-					SetDynamicColors(true).
-					SetText(boardIssues).
-					SetWrap(true).
-					SetBackgroundColor(tcell.ColorDefault).
-					SetBorder(true).
-					SetTitle(listTitle).
-					SetTitleColor(tcell.GetColor(listColor))
-				root.AddItem(bx, 0, 1, false)
-			}
-
 			// format table title
 			caser := cases.Title(language.English)
 			var boardType, boardContext string
@@ -170,18 +152,18 @@ func NewCmdView(f *cmdutils.Factory) *cobra.Command {
 				boardType = caser.String("project")
 				boardContext = project.NameWithNamespace
 			}
-			root.SetBorderPadding(1, 1, 2, 2).SetBorder(true).SetTitle(
-				fmt.Sprintf(" %s • %s ", caser.String(boardType+" issue board"), boardContext),
-			)
+			title := fmt.Sprintf(" %s • %s ", caser.String(boardType+" issue board"), boardContext)
+
+			cfg, _ := f.Config()
+			browser, _ := cfg.Get(repo.RepoHost(), "browser")
+
+			board := newBoardApp(a, selectedBoard, boardLists, opts, title, browser)
 
 			screen, err := tcell.NewScreen()
 			if err != nil {
 				return err
 			}
-			if err := a.SetScreen(screen).SetRoot(root, true).Run(); err != nil {
-				return err
-			}
-			return nil
+			return board.run(screen)
 		},
 	}
 
@@ -191,6 +173,8 @@ func NewCmdView(f *cmdutils.Factory) *cobra.Command {
 		StringSliceVarP(&opts.labels, "labels", "l", []string{}, "Filter board issues by labels, comma separated.")
 	viewCmd.Flags().
 		StringVarP(&opts.milestone, "milestone", "m", "", "Filter board issues by milestone.")
+	viewCmd.Flags().
+		DurationVar(&opts.refreshInterval, "refresh-interval", time.Minute, "Reload the board issues at this interval. Set to 0 to disable live refresh.")
 	return viewCmd
 }
 
@@ -394,11 +378,22 @@ func getBoardLists(board boardMeta) ([]*gitlab.BoardList, error) {
 		}
 	}
 
+	// only label lists are shown, as assignee, milestone and iteration lists
+	// are not supported yet
+	labelLists := []*gitlab.BoardList{}
+	for _, l := range boardLists {
+		if l.Label != nil {
+			labelLists = append(labelLists, l)
+		}
+	}
+	boardLists = labelLists
+
 	// add empty 'opened' and 'closed' lists before and after fetched lists
 	// these are used later when reading the issues into the table view
 	opened := &gitlab.BoardList{
+		ID: openListID,
 		Label: &gitlab.Label{
-			Name:      "Open",
+			Name:      openListName,
 			Color:     "#fabd2f",
 			TextColor: "#000000",
 		},
@@ -407,8 +402,9 @@ func getBoardLists(board boardMeta) ([]*gitlab.BoardList, error) {
 	boardLists = append([]*gitlab.BoardList{opened}, boardLists...)
 
 	closed := &gitlab.BoardList{
+		ID: closedListID,
 		Label: &gitlab.Label{
-			Name:      "Closed",
+			Name:      closedListName,
 			Color:     "#8ec07c",
 			TextColor: "#000000",
 		},
@@ -436,15 +432,42 @@ func getProjectBoardIssues(opts *issueBoardViewOptions) ([]*gitlab.Issue, error)
 	return issues, nil
 }
 
+// getListIssues gets the issues of the board that belong in targetList.
+func getListIssues(board boardMeta, boardLists []*gitlab.BoardList, targetList *gitlab.BoardList, opts *issueBoardViewOptions) ([]*gitlab.Issue, error) {
+	// automatically request using state for default "open" and "closed" lists
+	// this is required as these lists aren't returned with the board lists api call
+	listOpts := *opts
+	switch targetList.ID {
+	case closedListID:
+		listOpts.state = closed
+	case openListID:
+		listOpts.state = opened
+	default:
+		listOpts.state = ""
+	}
+
+	var issues []*gitlab.Issue
+	var err error
+	if board.group != nil {
+		issues, err = getGroupBoardIssues(board.group.ID, &listOpts)
+	} else {
+		issues, err = getProjectBoardIssues(&listOpts)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return filterIssues(boardLists, issues, targetList, &listOpts), nil
+}
+
 // filterIssues scans through the issues passed to it, filtering for the ones that belong in targetList
-// This function returns a string representation of the issues for targetList which will be displayed in the table view
 func filterIssues(
 	boardLists []*gitlab.BoardList,
 	issues []*gitlab.Issue,
 	targetList *gitlab.BoardList,
 	opts *issueBoardViewOptions,
-) string {
-	var boardIssues string
+) []*gitlab.Issue {
+	boardIssues := []*gitlab.Issue{}
 next:
 	for _, issue := range issues {
 		switch opts.state {
@@ -476,16 +499,7 @@ next:
 			}
 		}
 
-		var assignee, labelString string
-		if len(issue.Labels) > 0 {
-			labelString = buildLabelString(issue.LabelDetails)
-		}
-		if issue.Assignee != nil {
-			assignee = issue.Assignee.Username
-		}
-
-		boardIssues += fmt.Sprintf("[white::b]%s\n%s[green:-:-]#%d[darkgray] - %s\n\n",
-			issue.Title, labelString, issue.IID, assignee)
+		boardIssues = append(boardIssues, issue)
 	}
 	return boardIssues
 }
@@ -0,0 +1,495 @@
+package view
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+const (
+	boardPage = "board"
+	editPage  = "edit"
+
+	helpText = "[::b]←/→[::-] list  [::b]↑/↓[::-] issue  [::b]H/L[::-] move issue  " +
+		"[::b]a[::-] assignee  [::b]w[::-] weight  [::b]m[::-] milestone  " +
+		"[::b]o[::-] open in browser  [::b]r[::-] refresh  [::b]q[::-] quit"
+)
+
+// quick edit fields of an issue card
+const (
+	assigneeField  = "Assignee"
+	weightField    = "Weight"
+	milestoneField = "Milestone"
+)
+
+// boardColumn is a list of the issue board, and the issues shown in it.
+type boardColumn struct {
+	list   *gitlab.BoardList
+	issues []*gitlab.Issue
+	view   *tview.List
+}
+
+// boardApp is the interactive view of an issue board.
+type boardApp struct {
+	app     *tview.Application
+	pages   *tview.Pages
+	status  *tview.TextView
+	columns []*boardColumn
+	focused int
+
+	board   boardMeta
+	opts    *issueBoardViewOptions
+	browser string
+
+	// mu serializes the API calls of the board, which run outside of the UI goroutine.
+	mu sync.Mutex
+}
+
+func newBoardApp(a *tview.Application, board boardMeta, boardLists []*gitlab.BoardList, opts *issueBoardViewOptions, title, browser string) *boardApp {
+	b := &boardApp{
+		app:     a,
+		pages:   tview.NewPages(),
+		board:   board,
+		opts:    opts,
+		browser: browser,
+	}
+
+	columnsFlex := tview.NewFlex()
+	columnsFlex.SetBackgroundColor(tcell.ColorDefault)
+	for _, l := range boardLists {
+		view := tview.NewList().
+			SetSelectedFocusOnly(true).
+			SetSecondaryTextColor(tcell.ColorDarkGray).
+			SetSelectedBackgroundColor(tcell.GetColor(l.Label.Color))
+		view.
+			SetBackgroundColor(tcell.ColorDefault).
+			SetBorder(true).
+			SetTitle(l.Label.Name).
+			SetTitleColor(tcell.GetColor(l.Label.Color))
+
+		b.columns = append(b.columns, &boardColumn{list: l, view: view})
+		columnsFlex.AddItem(view, 0, 1, false)
+	}
+	columnsFlex.SetBorderPadding(1, 1, 2, 2).SetBorder(true).SetTitle(title)
+
+	b.status = tview.NewTextView().SetDynamicColors(true).SetText(helpText)
+	b.status.SetBackgroundColor(tcell.ColorDefault)
+
+	root := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(columnsFlex, 0, 1, true).
+		AddItem(b.status, 1, 0, false)
+	b.pages.AddPage(boardPage, root, true, true)
+
+	a.SetRoot(b.pages, true).SetInputCapture(b.handleKey)
+
+	return b
+}
+
+// run loads the issues of the board, and runs the application until the user quits.
+// With a refresh interval, the issues are reloaded at each interval.
+func (b *boardApp) run(screen tcell.Screen) error {
+	columnIssues, err := b.fetchIssues()
+	if err != nil {
+		return err
+	}
+	b.setIssues(columnIssues, 0)
+	b.focus(0)
+
+	if b.opts.refreshInterval > 0 {
+		ticker := time.NewTicker(b.opts.refreshInterval)
+		done := make(chan struct{})
+		defer func() {
+			ticker.Stop()
+			close(done)
+		}()
+
+		go func() {
+			for {
+				select {
+				case <-ticker.C:
+					b.update("", nil, 0)
+				case <-done:
+					return
+				}
+			}
+		}()
+	}
+
+	return b.app.SetScreen(screen).Run()
+}
+
+func (b *boardApp) handleKey(event *tcell.EventKey) *tcell.EventKey {
+	// the quick edit form handles its own keys
+	if name, _ := b.pages.GetFrontPage(); name != boardPage {
+		return event
+	}
+
+	switch event.Key() {
+	case tcell.KeyLeft:
+		if event.Modifiers()&tcell.ModShift != 0 {
+			b.moveIssue(-1)
+		} else {
+			b.focus(b.focused - 1)
+		}
+		return nil
+	case tcell.KeyRight:
+		if event.Modifiers()&tcell.ModShift != 0 {
+			b.moveIssue(1)
+		} else {
+			b.focus(b.focused + 1)
+		}
+		return nil
+	case tcell.KeyEscape:
+		b.app.Stop()
+		return nil
+	case tcell.KeyRune:
+	default:
+		return event
+	}
+
+	switch event.Rune() {
+	case 'h':
+		b.focus(b.focused - 1)
+	case 'l':
+		b.focus(b.focused + 1)
+	case 'H', '<':
+		b.moveIssue(-1)
+	case 'L', '>':
+		b.moveIssue(1)
+	case 'j':
+		return tcell.NewEventKey(tcell.KeyDown, 0, tcell.ModNone)
+	case 'k':
+		return tcell.NewEventKey(tcell.KeyUp, 0, tcell.ModNone)
+	case 'a':
+		b.editIssue(assigneeField)
+	case 'w':
+		b.editIssue(weightField)
+	case 'm':
+		b.editIssue(milestoneField)
+	case 'o':
+		b.openIssue()
+	case 'r':
+		b.update("Refreshing board...", nil, 0)
+	case 'q':
+		b.app.Stop()
+	default:
+		return event
+	}
+
+	return nil
+}
+
+// focus moves the focus to the column at index, if it exists.
+func (b *boardApp) focus(index int) {
+	if index < 0 || index >= len(b.columns) {
+		return
+	}
+
+	b.columns[b.focused].view.SetBorderColor(tcell.ColorDefault)
+	b.focused = index
+	b.columns[index].view.SetBorderColor(tcell.ColorWhite)
+	b.app.SetFocus(b.columns[index].view)
+}
+
+// selectedIssue returns the issue selected in the focused column, if any.
+func (b *boardApp) selectedIssue() *gitlab.Issue {
+	column := b.columns[b.focused]
+	if len(column.issues) == 0 {
+		return nil
+	}
+
+	return column.issues[column.view.GetCurrentItem()]
+}
+
+// moveIssue moves the selected issue to the column at offset from the focused
+// column, by relabeling it, and follows it there.
+func (b *boardApp) moveIssue(offset int) {
+	issue := b.selectedIssue()
+	target := b.focused + offset
+	if issue == nil || target < 0 || target >= len(b.columns) {
+		return
+	}
+
+	from := b.columns[b.focused].list
+	to := b.columns[target].list
+	b.focus(target)
+
+	status := fmt.Sprintf("Moving #%d to %s...", issue.IID, to.Label.Name)
+	b.update(status, func() error {
+		_, err := api.UpdateIssue(apiClient, issue.ProjectID, issue.IID, moveIssueOptions(from, to))
+		if err != nil {
+			return fmt.Errorf("moving #%d: %w", issue.IID, err)
+		}
+		return nil
+	}, issue.IID)
+}
+
+// editIssue shows a form to edit the field of the selected issue.
+func (b *boardApp) editIssue(field string) {
+	issue := b.selectedIssue()
+	if issue == nil {
+		return
+	}
+
+	closeForm := func() {
+		b.pages.RemovePage(editPage)
+		b.app.SetFocus(b.columns[b.focused].view)
+	}
+
+	form := tview.NewForm()
+	form.AddInputField(field, quickEditValue(issue, field), 30, nil, nil).
+		AddButton("Save", func() {
+			value := form.GetFormItemByLabel(field).(*tview.InputField).GetText()
+			closeForm()
+
+			status := fmt.Sprintf("Updating the %s of #%d...", strings.ToLower(field), issue.IID)
+			b.update(status, func() error {
+				updateOpts, err := quickEditOptions(issue, field, value)
+				if err != nil {
+					return err
+				}
+
+				_, err = api.UpdateIssue(apiClient, issue.ProjectID, issue.IID, updateOpts)
+				if err != nil {
+					return fmt.Errorf("updating #%d: %w", issue.IID, err)
+				}
+				return nil
+			}, issue.IID)
+		}).
+		AddButton("Cancel", closeForm).
+		SetCancelFunc(closeForm)
+	form.SetBorder(true).SetTitle(fmt.Sprintf(" #%d %s ", issue.IID, issue.Title))
+
+	b.pages.AddPage(editPage, centered(form, 60, 7), true, true)
+	b.app.SetFocus(form)
+}
+
+// openIssue opens the selected issue in the browser.
+func (b *boardApp) openIssue() {
+	issue := b.selectedIssue()
+	if issue == nil {
+		return
+	}
+
+	err := utils.OpenInBrowser(issue.WebURL, b.browser)
+	if err != nil {
+		b.setStatus(fmt.Sprintf("[red]opening #%d in the browser: %s", issue.IID, err))
+		return
+	}
+	b.setStatus(fmt.Sprintf("Opened #%d in the browser.", issue.IID))
+}
+
+// update runs fn, if any, then reloads the issues of the board.
+// Both run outside of the UI goroutine, so the board stays responsive.
+// The issue with the IID selectIID, if not 0, is selected in the focused column
+// once the board reloads.
+func (b *boardApp) update(status string, fn func() error, selectIID int) {
+	if status != "" {
+		b.setStatus(status)
+	}
+
+	go func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		if fn != nil {
+			if err := fn(); err != nil {
+				b.app.QueueUpdateDraw(func() { b.setStatus("[red]" + err.Error()) })
+				return
+			}
+		}
+
+		columnIssues, err := b.fetchIssues()
+		b.app.QueueUpdateDraw(func() {
+			if err != nil {
+				b.setStatus(fmt.Sprintf("[red]refreshing board: %s", err))
+				return
+			}
+
+			b.setIssues(columnIssues, selectIID)
+			if status != "" {
+				b.setStatus(helpText)
+			}
+		})
+	}()
+}
+
+// fetchIssues gets the issues of each column of the board.
+func (b *boardApp) fetchIssues() ([][]*gitlab.Issue, error) {
+	boardLists := make([]*gitlab.BoardList, 0, len(b.columns))
+	for _, column := range b.columns {
+		boardLists = append(boardLists, column.list)
+	}
+
+	columnIssues := make([][]*gitlab.Issue, 0, len(b.columns))
+	for _, l := range boardLists {
+		issues, err := getListIssues(b.board, boardLists, l, b.opts)
+		if err != nil {
+			return nil, fmt.Errorf("getting issue board lists: %w", err)
+		}
+		columnIssues = append(columnIssues, issues)
+	}
+
+	return columnIssues, nil
+}
+
+// setIssues shows the issues in the columns, keeping the selected issue of each
+// column when possible. The issue with the IID selectIID, if not 0, is selected
+// in the focused column instead.
+func (b *boardApp) setIssues(columnIssues [][]*gitlab.Issue, selectIID int) {
+	for i, column := range b.columns {
+		selected := column.view.GetCurrentItem()
+
+		iid := 0
+		if i == b.focused && selectIID != 0 {
+			iid = selectIID
+		} else if len(column.issues) > 0 {
+			iid = column.issues[selected].IID
+		}
+
+		column.issues = columnIssues[i]
+		column.view.Clear()
+		for j, issue := range column.issues {
+			main, secondary := formatIssue(issue)
+			column.view.AddItem(main, secondary, 0, nil)
+
+			if issue.IID == iid {
+				selected = j
+			}
+		}
+		column.view.SetCurrentItem(selected)
+	}
+}
+
+func (b *boardApp) setStatus(text string) {
+	b.status.SetText(text)
+}
+
+// centered returns p in the middle of the screen, with the given width and height.
+func centered(p tview.Primitive, width, height int) tview.Primitive {
+	return tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(p, height, 0, true).
+			AddItem(nil, 0, 1, false), width, 0, true).
+		AddItem(nil, 0, 1, false)
+}
+
+// formatIssue returns the main and secondary texts of the card of an issue.
+func formatIssue(issue *gitlab.Issue) (string, string) {
+	var assignee string
+	if issue.Assignee != nil {
+		assignee = issue.Assignee.Username
+	}
+
+	secondary := fmt.Sprintf("[green]#%d[darkgray] - %s", issue.IID, assignee)
+	if labels := strings.TrimSpace(buildLabelString(issue.LabelDetails)); labels != "" {
+		secondary += " " + labels
+	}
+
+	return fmt.Sprintf("[white::b]%s", tview.Escape(issue.Title)), secondary
+}
+
+// moveIssueOptions returns the options to move an issue from one board list to another.
+// Label lists add or remove their label, and the "Closed" list closes or reopens the issue.
+func moveIssueOptions(from, to *gitlab.BoardList) *gitlab.UpdateIssueOptions {
+	opts := &gitlab.UpdateIssueOptions{}
+
+	switch from.ID {
+	case openListID:
+	case closedListID:
+		opts.StateEvent = gitlab.Ptr("reopen")
+	default:
+		opts.RemoveLabels = &gitlab.LabelOptions{from.Label.Name}
+	}
+
+	switch to.ID {
+	case openListID:
+	case closedListID:
+		opts.StateEvent = gitlab.Ptr("close")
+	default:
+		opts.AddLabels = &gitlab.LabelOptions{to.Label.Name}
+	}
+
+	return opts
+}
+
+// quickEditValue returns the current value of the quick edit field of issue.
+func quickEditValue(issue *gitlab.Issue, field string) string {
+	switch field {
+	case assigneeField:
+		usernames := make([]string, 0, len(issue.Assignees))
+		for _, assignee := range issue.Assignees {
+			usernames = append(usernames, assignee.Username)
+		}
+		return strings.Join(usernames, ",")
+	case weightField:
+		if issue.Weight == 0 {
+			return ""
+		}
+		return strconv.Itoa(issue.Weight)
+	case milestoneField:
+		if issue.Milestone == nil {
+			return ""
+		}
+		return issue.Milestone.Title
+	}
+
+	return ""
+}
+
+// quickEditOptions returns the options to set the quick edit field of issue to value.
+// An empty value removes the assignees, weight, or milestone.
+func quickEditOptions(issue *gitlab.Issue, field, value string) (*gitlab.UpdateIssueOptions, error) {
+	value = strings.TrimSpace(value)
+	opts := &gitlab.UpdateIssueOptions{}
+
+	switch field {
+	case assigneeField:
+		ids := []int{}
+		if value != "" {
+			users, err := api.UsersByNames(apiClient, strings.Split(value, ","))
+			if err != nil {
+				return nil, err
+			}
+			for _, user := range users {
+				ids = append(ids, user.ID)
+			}
+		}
+		opts.AssigneeIDs = &ids
+	case weightField:
+		weight := 0
+		if value != "" {
+			var err error
+			weight, err = strconv.Atoi(value)
+			if err != nil || weight < 0 {
+				return nil, fmt.Errorf("weight must be a positive number, got %q", value)
+			}
+		}
+		opts.Weight = &weight
+	case milestoneField:
+		id := 0
+		if value != "" {
+			milestone, err := api.ProjectMilestoneByTitle(apiClient, issue.ProjectID, value)
+			if err != nil {
+				return nil, fmt.Errorf("finding milestone %q: %w", value, err)
+			}
+			id = milestone.ID
+		}
+		opts.MilestoneID = &id
+	default:
+		return nil, fmt.Errorf("unknown field %q", field)
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,203 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/assert"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func Test_moveIssueOptions(t *testing.T) {
+	openList := &gitlab.BoardList{ID: openListID, Label: &gitlab.Label{Name: openListName}}
+	closedList := &gitlab.BoardList{ID: closedListID, Label: &gitlab.Label{Name: closedListName}}
+	doingList := &gitlab.BoardList{Label: &gitlab.Label{Name: "Doing"}}
+	reviewList := &gitlab.BoardList{Label: &gitlab.Label{Name: "Review"}}
+	closedLabelList := &gitlab.BoardList{ID: 12, Label: &gitlab.Label{Name: closedListName}}
+
+	tests := []struct {
+		name string
+		from *gitlab.BoardList
+		to   *gitlab.BoardList
+		want *gitlab.UpdateIssueOptions
+	}{
+		{
+			name: "from open list to label list adds the label",
+			from: openList,
+			to:   doingList,
+			want: &gitlab.UpdateIssueOptions{AddLabels: &gitlab.LabelOptions{"Doing"}},
+		},
+		{
+			name: "between label lists relabels",
+			from: doingList,
+			to:   reviewList,
+			want: &gitlab.UpdateIssueOptions{
+				AddLabels:    &gitlab.LabelOptions{"Review"},
+				RemoveLabels: &gitlab.LabelOptions{"Doing"},
+			},
+		},
+		{
+			name: "from label list to open list removes the label",
+			from: reviewList,
+			to:   openList,
+			want: &gitlab.UpdateIssueOptions{RemoveLabels: &gitlab.LabelOptions{"Review"}},
+		},
+		{
+			name: "to closed list closes the issue",
+			from: reviewList,
+			to:   closedList,
+			want: &gitlab.UpdateIssueOptions{
+				RemoveLabels: &gitlab.LabelOptions{"Review"},
+				StateEvent:   gitlab.Ptr("close"),
+			},
+		},
+		{
+			name: "from closed list reopens the issue",
+			from: closedList,
+			to:   reviewList,
+			want: &gitlab.UpdateIssueOptions{
+				AddLabels:  &gitlab.LabelOptions{"Review"},
+				StateEvent: gitlab.Ptr("reopen"),
+			},
+		},
+		{
+			name: "to a label list named like the closed list adds the label",
+			from: doingList,
+			to:   closedLabelList,
+			want: &gitlab.UpdateIssueOptions{
+				AddLabels:    &gitlab.LabelOptions{"Closed"},
+				RemoveLabels: &gitlab.LabelOptions{"Doing"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, moveIssueOptions(tt.from, tt.to))
+		})
+	}
+}
+
+func Test_quickEditValue(t *testing.T) {
+	issue := &gitlab.Issue{
+		Assignees: []*gitlab.IssueAssignee{{Username: "alice"}, {Username: "bob"}},
+		Weight:    3,
+		Milestone: &gitlab.Milestone{Title: "v1.0"},
+	}
+
+	assert.Equal(t, "alice,bob", quickEditValue(issue, assigneeField))
+	assert.Equal(t, "3", quickEditValue(issue, weightField))
+	assert.Equal(t, "v1.0", quickEditValue(issue, milestoneField))
+
+	empty := &gitlab.Issue{}
+	assert.Equal(t, "", quickEditValue(empty, assigneeField))
+	assert.Equal(t, "", quickEditValue(empty, weightField))
+	assert.Equal(t, "", quickEditValue(empty, milestoneField))
+}
+
+func Test_quickEditOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		field   string
+		value   string
+		want    *gitlab.UpdateIssueOptions
+		wantErr string
+	}{
+		{
+			name:  "set weight",
+			field: weightField,
+			value: " 5 ",
+			want:  &gitlab.UpdateIssueOptions{Weight: gitlab.Ptr(5)},
+		},
+		{
+			name:    "invalid weight",
+			field:   weightField,
+			value:   "heavy",
+			wantErr: `weight must be a positive number, got "heavy"`,
+		},
+		{
+			name:  "empty weight removes it",
+			field: weightField,
+			want:  &gitlab.UpdateIssueOptions{Weight: gitlab.Ptr(0)},
+		},
+		{
+			name:  "empty assignee unassigns",
+			field: assigneeField,
+			want:  &gitlab.UpdateIssueOptions{AssigneeIDs: &[]int{}},
+		},
+		{
+			name:  "empty milestone removes it",
+			field: milestoneField,
+			want:  &gitlab.UpdateIssueOptions{MilestoneID: gitlab.Ptr(0)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := quickEditOptions(&gitlab.Issue{}, tt.field, tt.value)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_formatIssue(t *testing.T) {
+	main, secondary := formatIssue(&gitlab.Issue{
+		Assignee:     &gitlab.IssueAssignee{Username: "user"},
+		LabelDetails: []*gitlab.LabelDetails{{Name: "A", Color: "green"}},
+		Title:        "Issue [draft]",
+		IID:          1,
+	})
+
+	assert.Equal(t, "[white::b]Issue [draft[]", main)
+	assert.Equal(t, "[green]#1[darkgray] - user [white:green:-]A[white:-:-]", secondary)
+}
+
+func Test_boardApp_handleKey(t *testing.T) {
+	boardLists := []*gitlab.BoardList{
+		{ID: openListID, Label: &gitlab.Label{Name: openListName}},
+		{Label: &gitlab.Label{Name: "Doing"}},
+		{ID: closedListID, Label: &gitlab.Label{Name: closedListName}},
+	}
+	b := newBoardApp(tview.NewApplication(), boardMeta{}, boardLists, &issueBoardViewOptions{}, "board", "")
+	b.setIssues([][]*gitlab.Issue{
+		{{IID: 1, Title: "first"}, {IID: 2, Title: "second"}},
+		{},
+		{{IID: 3, Title: "third"}},
+	}, 0)
+
+	assert.Equal(t, 0, b.focused)
+	assert.Equal(t, 1, b.selectedIssue().IID)
+
+	b.handleKey(tcell.NewEventKey(tcell.KeyRight, 0, tcell.ModNone))
+	assert.Equal(t, 1, b.focused)
+	assert.Nil(t, b.selectedIssue())
+
+	b.handleKey(tcell.NewEventKey(tcell.KeyRune, 'l', tcell.ModNone))
+	assert.Equal(t, 2, b.focused)
+	assert.Equal(t, 3, b.selectedIssue().IID)
+
+	// the last list is the rightmost one
+	b.handleKey(tcell.NewEventKey(tcell.KeyRight, 0, tcell.ModNone))
+	assert.Equal(t, 2, b.focused)
+
+	b.handleKey(tcell.NewEventKey(tcell.KeyRune, 'h', tcell.ModNone))
+	b.handleKey(tcell.NewEventKey(tcell.KeyRune, 'h', tcell.ModNone))
+	assert.Equal(t, 0, b.focused)
+
+	// j is passed to the list as a down arrow key
+	event := b.handleKey(tcell.NewEventKey(tcell.KeyRune, 'j', tcell.ModNone))
+	assert.Equal(t, tcell.KeyDown, event.Key())
+
+	// the quick edit form gets all keys
+	b.handleKey(tcell.NewEventKey(tcell.KeyRune, 'w', tcell.ModNone))
+	name, _ := b.pages.GetFrontPage()
+	assert.Equal(t, editPage, name)
+
+	event = b.handleKey(tcell.NewEventKey(tcell.KeyRune, 'l', tcell.ModNone))
+	assert.Equal(t, 'l', event.Rune())
+	assert.Equal(t, 0, b.focused)
+}
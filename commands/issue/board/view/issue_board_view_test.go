@@ -239,11 +239,11 @@ func Test_filterIssues(t *testing.T) {
 	tests := []struct {
 		name string
 		args args
-		want string
+		want []*gitlab.Issue
 	}{
 		{
-			name: "return empty string on no matches",
-			want: "",
+			name: "return no issues on no matches",
+			want: []*gitlab.Issue{},
 		},
 		{
 			name: "filter out closed issues when targetList is not the 'closed' list",
@@ -253,7 +253,7 @@ func Test_filterIssues(t *testing.T) {
 				targetList: &gitlab.BoardList{Label: &gitlab.Label{Name: "A"}},
 				opts:       &issueBoardViewOptions{},
 			},
-			want: "",
+			want: []*gitlab.Issue{},
 		},
 		{
 			name: "filter out issues not in the 'closed' state when populating the 'closed' list",
@@ -266,7 +266,7 @@ func Test_filterIssues(t *testing.T) {
 				targetList: &gitlab.BoardList{Label: &gitlab.Label{Name: "Closed"}},
 				opts:       &issueBoardViewOptions{state: closed},
 			},
-			want: "",
+			want: []*gitlab.Issue{},
 		},
 		{
 			name: "filter out issues labeled for other board lists when iterating over the 'open' list",
@@ -279,10 +279,10 @@ func Test_filterIssues(t *testing.T) {
 				targetList: &gitlab.BoardList{Label: &gitlab.Label{Name: "Open"}},
 				opts:       &issueBoardViewOptions{state: opened},
 			},
-			want: "",
+			want: []*gitlab.Issue{},
 		},
 		{
-			name: "return formatted string on successful filter and match",
+			name: "return matching issues on successful filter and match",
 			args: args{
 				boardLists: []*gitlab.BoardList{
 					{Label: &gitlab.Label{Name: "A"}},
@@ -301,7 +301,15 @@ func Test_filterIssues(t *testing.T) {
 				targetList: &gitlab.BoardList{Label: &gitlab.Label{Name: "A"}},
 				opts:       &issueBoardViewOptions{},
 			},
-			want: "[white::b]Issue\n[white:green:-]A[white:-:-]\n[green:-:-]#1[darkgray] - user\n\n",
+			want: []*gitlab.Issue{
+				{
+					Assignee:     &gitlab.IssueAssignee{Username: "user"},
+					Labels:       []string{"A"},
+					LabelDetails: []*gitlab.LabelDetails{{Name: "A", Color: "green"}},
+					Title:        "Issue",
+					IID:          1,
+				},
+			},
 		},
 	}
 	for _, tt := range tests {
@@ -43,6 +43,9 @@ type CreateOpts struct {
 
 	MilestoneFlag string `json:"milestone_flag"`
 
+	Template        string `json:"-"`
+	RemoteTemplates bool   `json:"-"`
+
 	NoEditor       bool `json:"-"`
 	IsConfidential bool `json:"is_confidential,omitempty"`
 	IsInteractive  bool `json:"-"`
@@ -77,6 +80,7 @@ func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
 			glab issue create -m release-2.0.0 -t "we need this feature" --label important
 			glab issue new -t "Fix CVE-YYYY-XXXX" -l security --linked-mr 123
 			glab issue create -m release-1.0.1 -t "security fix" --label security --web --recover
+			glab issue create -t "Crash on start" --template Bug --yes
 		`),
 		Args: cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -100,7 +104,7 @@ func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
 				return err
 			}
 			hasTitle := cmd.Flags().Changed("title")
-			hasDescription := cmd.Flags().Changed("description")
+			hasDescription := cmd.Flags().Changed("description") || cmd.Flags().Changed("template")
 
 			// disable interactive mode if title and description are explicitly defined
 			opts.IsInteractive = !(hasTitle && hasDescription)
@@ -109,6 +113,9 @@ func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
 				return &cmdutils.FlagError{Err: errors.New("'--title' and '--description' required for non-interactive mode.")}
 			}
 
+			// with --repo, the templates of the working directory are not the templates of the project
+			opts.RemoteTemplates = cmdutils.IsRepoOverridden(cmd)
+
 			// Remove this once --yes does more than just skip the prompts that --web happen to skip
 			// by design
 			if opts.Yes && opts.Web {
@@ -158,6 +165,8 @@ func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
 	issueCreateCmd.Flags().BoolVar(&opts.Recover, "recover", false, "Save the options to a file if the issue fails to be created. If the file exists, the options will be loaded from the recovery file. (EXPERIMENTAL.)")
 	issueCreateCmd.Flags().IntVarP(&opts.EpicID, "epic", "", 0, "ID of the epic to add the issue to.")
 	issueCreateCmd.Flags().StringVarP(&opts.DueDate, "due-date", "", "", "A date in 'YYYY-MM-DD' format.")
+	issueCreateCmd.Flags().StringVar(&opts.Template, "template", "", "Use this description template, from '.gitlab/issue_templates', or from the templates of the project, its group, or the instance. Its quick actions, like '/label', set the metadata of the issue.")
+	issueCreateCmd.MarkFlagsMutuallyExclusive("template", "description")
 
 	return issueCreateCmd
 }
@@ -196,6 +205,15 @@ var createRun = func(opts *CreateOpts) error {
 		}
 	}
 
+	// a recovered description already comes from the template
+	if opts.Template != "" && opts.Description == "" {
+		templateName = opts.Template
+		opts.Description, err = cmdutils.LoadTemplate(apiClient, repo, cmdutils.IssueTemplate, opts.Template, opts.RemoteTemplates)
+		if err != nil {
+			return err
+		}
+	}
+
 	if opts.IsInteractive {
 		if opts.Description == "" {
 			if opts.NoEditor {
@@ -208,7 +226,7 @@ var createRun = func(opts *CreateOpts) error {
 				templateResponse := struct {
 					Index int
 				}{}
-				templateNames, err := cmdutils.ListGitLabTemplates(cmdutils.IssueTemplate)
+				templateNames, err := cmdutils.ListTemplates(apiClient, repo, cmdutils.IssueTemplate, opts.RemoteTemplates)
 				if err != nil {
 					return fmt.Errorf("error getting templates: %w", err)
 				}
@@ -230,7 +248,7 @@ var createRun = func(opts *CreateOpts) error {
 				}
 				if templateResponse.Index != len(templateNames) {
 					templateName = templateNames[templateResponse.Index]
-					templateContents, err = cmdutils.LoadGitLabTemplate(cmdutils.IssueTemplate, templateName)
+					templateContents, err = cmdutils.LoadTemplate(apiClient, repo, cmdutils.IssueTemplate, templateName, opts.RemoteTemplates)
 					if err != nil {
 						return fmt.Errorf("failed to get template contents: %w", err)
 					}
@@ -264,6 +282,12 @@ var createRun = func(opts *CreateOpts) error {
 		return fmt.Errorf("title can't be blank")
 	}
 
+	if templateName != "" {
+		if err := applyQuickActions(apiClient, repo, opts); err != nil {
+			return err
+		}
+	}
+
 	var action cmdutils.Action
 
 	// submit without prompting for non interactive mode
@@ -430,6 +454,39 @@ func postCreateActions(apiClient *gitlab.Client, issue *gitlab.Issue, opts *Crea
 	return nil
 }
 
+// applyQuickActions removes the quick actions setting metadata from the description, which
+// comes from a template, and adds their metadata to the metadata set with the flags.
+func applyQuickActions(apiClient *gitlab.Client, repo glrepo.Interface, opts *CreateOpts) error {
+	var qa cmdutils.QuickActions
+	opts.Description, qa = cmdutils.ParseQuickActions(opts.Description, cmdutils.IssueTemplate)
+
+	for _, label := range qa.Labels {
+		if !utils.PresentInStringSlice(opts.Labels, label) {
+			opts.Labels = append(opts.Labels, label)
+		}
+	}
+	for _, assignee := range qa.Assignees {
+		if !utils.PresentInStringSlice(opts.Assignees, assignee) {
+			opts.Assignees = append(opts.Assignees, assignee)
+		}
+	}
+	if qa.Milestone != "" && opts.Milestone == 0 {
+		milestone, err := cmdutils.ParseMilestone(apiClient, repo, qa.Milestone)
+		if err != nil {
+			return err
+		}
+		opts.Milestone = milestone
+	}
+	if qa.Weight != 0 && opts.Weight == 0 {
+		opts.Weight = qa.Weight
+	}
+	if qa.DueDate != "" && opts.DueDate == "" {
+		opts.DueDate = qa.DueDate
+	}
+	opts.IsConfidential = opts.IsConfidential || qa.Confidential
+	return nil
+}
+
 func previewIssue(opts *CreateOpts) error {
 	repo, err := opts.BaseRepo()
 	if err != nil {
@@ -1,10 +1,12 @@
 package create
 
 import (
+	"encoding/json"
 	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 	"gitlab.com/gitlab-org/cli/commands/cmdtest"
 	"gitlab.com/gitlab-org/cli/pkg/httpmock"
@@ -78,3 +80,52 @@ func TestIssueCreateWhenIssuesDisabled(t *testing.T) {
 		"Make sure issues are enabled for the \"OWNER/REPO\" project, and if required, you are a member of the project.\n",
 		output.Stderr())
 }
+
+func TestIssueCreateWithRemoteTemplate(t *testing.T) {
+	t.Setenv("GITLAB_REPO", "OWNER/REPO")
+
+	fakeHTTP := &httpmock.Mocker{
+		MatchURL: httpmock.PathAndQuerystring,
+	}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO?license=true&with_custom_attributes=true",
+		httpmock.NewStringResponse(http.StatusOK, `{
+			"id": 1,
+			"path_with_namespace": "OWNER/REPO",
+			"web_url": "https://gitlab.com/OWNER/REPO",
+			"issues_enabled": true
+		}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/templates/issues/Bug%20Report",
+		httpmock.NewStringResponse(http.StatusOK, `{
+			"name": "Bug Report",
+			"content": "## Steps to reproduce\n\n/label ~bug ~\"needs triage\"\n/weight 2\n/confidential\n/cc @bob\n"
+		}`))
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/projects/OWNER/REPO/issues",
+		func(req *http.Request) (*http.Response, error) {
+			var body struct {
+				Description  string `json:"description"`
+				Labels       string `json:"labels"`
+				Weight       int    `json:"weight"`
+				Confidential bool   `json:"confidential"`
+			}
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			assert.Equal(t, "## Steps to reproduce\n\n/cc @bob", body.Description)
+			assert.Equal(t, "security,bug,needs triage", body.Labels)
+			assert.Equal(t, 2, body.Weight)
+			assert.True(t, body.Confidential)
+
+			return httpmock.NewStringResponse(http.StatusCreated, `{
+				"id": 1,
+				"iid": 12,
+				"title": "Crash on start",
+				"state": "opened",
+				"created_at": "2025-06-01T10:00:00Z",
+				"web_url": "https://gitlab.com/OWNER/REPO/-/issues/12"
+			}`)(req)
+		})
+
+	output, err := runCommand(fakeHTTP, false, `--title "Crash on start" --template "Bug Report" --label security`)
+	require.NoError(t, err)
+	assert.Equal(t, "https://gitlab.com/OWNER/REPO/-/issues/12\n", output.String())
+}
@@ -3,14 +3,18 @@ package issue
 import (
 	"github.com/MakeNowJust/heredoc/v2"
 	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/issuable/timetracking"
 	issueBoardCmd "gitlab.com/gitlab-org/cli/commands/issue/board"
 	issueCloseCmd "gitlab.com/gitlab-org/cli/commands/issue/close"
 	issueCreateCmd "gitlab.com/gitlab-org/cli/commands/issue/create"
 	issueDeleteCmd "gitlab.com/gitlab-org/cli/commands/issue/delete"
+	issueLinkCmd "gitlab.com/gitlab-org/cli/commands/issue/link"
+	issueLinksCmd "gitlab.com/gitlab-org/cli/commands/issue/links"
 	issueListCmd "gitlab.com/gitlab-org/cli/commands/issue/list"
 	issueNoteCmd "gitlab.com/gitlab-org/cli/commands/issue/note"
 	issueReopenCmd "gitlab.com/gitlab-org/cli/commands/issue/reopen"
 	issueSubscribeCmd "gitlab.com/gitlab-org/cli/commands/issue/subscribe"
+	issueUnlinkCmd "gitlab.com/gitlab-org/cli/commands/issue/unlink"
 	issueUnsubscribeCmd "gitlab.com/gitlab-org/cli/commands/issue/unsubscribe"
 	issueUpdateCmd "gitlab.com/gitlab-org/cli/commands/issue/update"
 	issueViewCmd "gitlab.com/gitlab-org/cli/commands/issue/view"
@@ -44,11 +48,15 @@ func NewCmdIssue(f *cmdutils.Factory) *cobra.Command {
 	issueCmd.AddCommand(issueBoardCmd.NewCmdBoard(f))
 	issueCmd.AddCommand(issueCreateCmd.NewCmdCreate(f))
 	issueCmd.AddCommand(issueDeleteCmd.NewCmdDelete(f))
+	issueCmd.AddCommand(issueLinkCmd.NewCmdLink(f))
+	issueCmd.AddCommand(issueLinksCmd.NewCmdLinks(f))
 	issueCmd.AddCommand(issueListCmd.NewCmdList(f, nil))
 	issueCmd.AddCommand(issueNoteCmd.NewCmdNote(f))
 	issueCmd.AddCommand(issueReopenCmd.NewCmdReopen(f))
+	issueCmd.AddCommand(timetracking.NewCmdTime(f, timetracking.Issue))
 	issueCmd.AddCommand(issueViewCmd.NewCmdView(f))
 	issueCmd.AddCommand(issueSubscribeCmd.NewCmdSubscribe(f))
+	issueCmd.AddCommand(issueUnlinkCmd.NewCmdUnlink(f))
 	issueCmd.AddCommand(issueUnsubscribeCmd.NewCmdUnsubscribe(f))
 	issueCmd.AddCommand(issueUpdateCmd.NewCmdUpdate(f))
 	return issueCmd
@@ -67,6 +67,25 @@ func IssueState(c *iostreams.ColorPalette, i *gitlab.Issue) (issueID string) {
 	return
 }
 
+// Reference returns the full reference of an issue, like OWNER/REPO#12.
+func Reference(i *gitlab.Issue) string {
+	if i.References != nil && i.References.Full != "" {
+		return i.References.Full
+	}
+	return fmt.Sprintf("#%d", i.IID)
+}
+
+// LinkVerb returns the type of a link between issues, as a verb.
+func LinkVerb(linkType string) string {
+	switch linkType {
+	case "blocks":
+		return "blocks"
+	case "is_blocked_by":
+		return "is blocked by"
+	}
+	return "relates to"
+}
+
 func IssuesFromArgs(apiClient *gitlab.Client, baseRepoFn func() (glrepo.Interface, error), args []string) ([]*gitlab.Issue, glrepo.Interface, error) {
 	var baseRepo glrepo.Interface
 
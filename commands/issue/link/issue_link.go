@@ -0,0 +1,86 @@
+package link
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/issue/issueutils"
+)
+
+// LinkTypes are the types of links between issues.
+var LinkTypes = []string{"relates_to", "blocks", "is_blocked_by"}
+
+func NewCmdLink(f *cmdutils.Factory) *cobra.Command {
+	var linkType string
+
+	issueLinkCmd := &cobra.Command{
+		Use:   "link <id> <target-id>... [flags]",
+		Short: `Link an issue to other issues.`,
+		Long: heredoc.Doc(`
+			Link an issue to other issues, in the same project or in other projects.
+
+			The type of the link is relative to the first issue: with --type blocks, the
+			first issue blocks the target issues. Blocking links require GitLab Premium.
+		`),
+		Example: heredoc.Doc(`
+			glab issue link 12 34
+			glab issue link 12 34 56 --type blocks
+			glab issue link 12 https://gitlab.com/OWNER/OTHER-REPO/-/issues/7 --type is_blocked_by
+		`),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !validLinkType(linkType) {
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid --type %q: use relates_to, blocks, or is_blocked_by.", linkType)}
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			issue, repo, err := issueutils.IssueFromArg(apiClient, f.BaseRepo, args[0])
+			if err != nil {
+				return err
+			}
+
+			c := f.IO.Color()
+			for _, arg := range args[1:] {
+				target, _, err := issueutils.IssueFromArg(apiClient, f.BaseRepo, arg)
+				if err != nil {
+					return err
+				}
+
+				_, _, err = api.LinkIssues(apiClient, repo.FullName(), issue.IID, &gitlab.CreateIssueLinkOptions{
+					TargetProjectID: gitlab.Ptr(strconv.Itoa(target.ProjectID)),
+					TargetIssueIID:  gitlab.Ptr(strconv.Itoa(target.IID)),
+					LinkType:        gitlab.Ptr(linkType),
+				})
+				if err != nil {
+					return fmt.Errorf("failed to link %s to %s: %w", issueutils.Reference(issue), issueutils.Reference(target), err)
+				}
+
+				fmt.Fprintf(f.IO.StdOut, "%s %s %s %s\n", c.GreenCheck(), issueutils.Reference(issue), issueutils.LinkVerb(linkType), issueutils.Reference(target))
+			}
+			return nil
+		},
+	}
+
+	issueLinkCmd.Flags().StringVarP(&linkType, "type", "t", "relates_to", "Type of the links: relates_to, blocks, or is_blocked_by.")
+
+	return issueLinkCmd
+}
+
+func validLinkType(linkType string) bool {
+	for _, t := range LinkTypes {
+		if t == linkType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,327 @@
+package links
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/pkg/tableprinter"
+)
+
+type options struct {
+	depth        int
+	relatesTo    bool
+	outputFormat string
+}
+
+// node is an issue of the graph.
+type node struct {
+	Reference string `json:"reference"`
+	ProjectID int    `json:"project_id"`
+	IID       int    `json:"iid"`
+	Title     string `json:"title"`
+	State     string `json:"state"`
+	WebURL    string `json:"web_url"`
+	// Blocked is set for the open issues blocked by open issues.
+	Blocked bool `json:"blocked"`
+
+	depth int
+}
+
+// edge is a link between two issues. Blocking links go from the blocking issue
+// to the blocked issue.
+type edge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Type string `json:"type"`
+}
+
+type graph struct {
+	Root   string  `json:"root"`
+	Issues []*node `json:"issues"`
+	Links  []*edge `json:"links"`
+}
+
+func NewCmdLinks(f *cmdutils.Factory) *cobra.Command {
+	opts := &options{}
+
+	issueLinksCmd := &cobra.Command{
+		Use:   "links <id> [flags]",
+		Short: `Show the graph of the issues linked to an issue.`,
+		Long: heredoc.Doc(`
+			Show the graph of the issues linked to an issue, following the blocking links
+			transitively, across projects. Open issues blocked by open issues are flagged.
+
+			Export the graph with --output dot for Graphviz, or --output mermaid for Mermaid
+			diagrams, which GitLab renders in Markdown.
+		`),
+		Example: heredoc.Doc(`
+			glab issue links 12
+			glab issue links 12 --depth 2 --relates-to
+			glab issue links 12 --output dot | dot -Tsvg > issues.svg
+			glab issue links 12 --output mermaid
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			switch opts.outputFormat {
+			case "text", "json", "dot", "mermaid":
+			default:
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid --output %q: use text, json, dot, or mermaid.", opts.outputFormat)}
+			}
+			if opts.depth < 0 {
+				return &cmdutils.FlagError{Err: errors.New("--depth must be 0 or more.")}
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			issue, _, err := issueutils.IssueFromArg(apiClient, f.BaseRepo, args[0])
+			if err != nil {
+				return err
+			}
+
+			g, err := walk(apiClient, issue, opts.depth, opts.relatesTo)
+			if err != nil {
+				return err
+			}
+
+			switch opts.outputFormat {
+			case "json":
+				graphJSON, _ := json.Marshal(g)
+				fmt.Fprintln(f.IO.StdOut, string(graphJSON))
+			case "dot":
+				fmt.Fprint(f.IO.StdOut, formatDOT(g))
+			case "mermaid":
+				fmt.Fprint(f.IO.StdOut, formatMermaid(g))
+			default:
+				fmt.Fprint(f.IO.StdOut, formatText(f.IO, g))
+			}
+			return nil
+		},
+	}
+
+	issueLinksCmd.Flags().IntVarP(&opts.depth, "depth", "D", 0, "Maximum number of links from the issue to follow. 0 follows all the links.")
+	issueLinksCmd.Flags().BoolVar(&opts.relatesTo, "relates-to", false, "Also follow the relates_to links, not only the blocking links.")
+	issueLinksCmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json, dot, mermaid.")
+
+	return issueLinksCmd
+}
+
+// walk returns the graph of the issues linked to root, from a breadth-first walk of the links.
+func walk(client *gitlab.Client, root *gitlab.Issue, maxDepth int, relatesTo bool) (*graph, error) {
+	rootNode := &node{
+		Reference: issueutils.Reference(root),
+		ProjectID: root.ProjectID,
+		IID:       root.IID,
+		Title:     root.Title,
+		State:     root.State,
+		WebURL:    root.WebURL,
+	}
+	g := &graph{Root: rootNode.Reference, Issues: []*node{rootNode}}
+
+	nodes := map[string]*node{key(root.ProjectID, root.IID): rootNode}
+	edges := map[edge]bool{}
+
+	for queue := []*node{rootNode}; len(queue) > 0; queue = queue[1:] {
+		n := queue[0]
+		if maxDepth > 0 && n.depth >= maxDepth {
+			continue
+		}
+
+		relations, err := api.ListIssueRelations(client, n.ProjectID, n.IID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the links of %s: %w", n.Reference, err)
+		}
+
+		for _, r := range relations {
+			if r.LinkType == "relates_to" && !relatesTo {
+				continue
+			}
+
+			linked, ok := nodes[key(r.ProjectID, r.IID)]
+			if !ok {
+				linked = &node{
+					Reference: relationReference(r),
+					ProjectID: r.ProjectID,
+					IID:       r.IID,
+					Title:     r.Title,
+					State:     r.State,
+					WebURL:    r.WebURL,
+					depth:     n.depth + 1,
+				}
+				nodes[key(r.ProjectID, r.IID)] = linked
+				g.Issues = append(g.Issues, linked)
+				queue = append(queue, linked)
+			}
+
+			e := edge{From: n.Reference, To: linked.Reference, Type: "blocks"}
+			switch r.LinkType {
+			case "is_blocked_by":
+				e.From, e.To = e.To, e.From
+			case "relates_to":
+				e.Type = "relates_to"
+				// the links between related issues have no direction
+				if e.To < e.From {
+					e.From, e.To = e.To, e.From
+				}
+			}
+			if !edges[e] {
+				edges[e] = true
+				g.Links = append(g.Links, &e)
+			}
+		}
+	}
+
+	byReference := map[string]*node{}
+	for _, n := range g.Issues {
+		byReference[n.Reference] = n
+	}
+	for _, e := range g.Links {
+		from, to := byReference[e.From], byReference[e.To]
+		if e.Type == "blocks" && from.State == "opened" && to.State == "opened" {
+			to.Blocked = true
+		}
+	}
+
+	return g, nil
+}
+
+func key(projectID, iid int) string {
+	return fmt.Sprintf("%d#%d", projectID, iid)
+}
+
+func relationReference(r *gitlab.IssueRelation) string {
+	if r.References != nil && r.References.Full != "" {
+		return r.References.Full
+	}
+	return fmt.Sprintf("#%d", r.IID)
+}
+
+func (g *graph) blocked() int {
+	count := 0
+	for _, n := range g.Issues {
+		if n.Blocked {
+			count++
+		}
+	}
+	return count
+}
+
+func formatText(io *iostreams.IOStreams, g *graph) string {
+	c := io.Color()
+
+	if len(g.Links) == 0 {
+		return fmt.Sprintf("No linked issues found for %s.\n", g.Root)
+	}
+
+	issues := tableprinter.NewTablePrinter()
+	issues.AddRow("ISSUE", "STATE", "TITLE")
+	for _, n := range g.Issues {
+		state := c.Green("open")
+		switch {
+		case n.Blocked:
+			state = c.Red("blocked")
+		case n.State == "closed":
+			state = c.Gray("closed")
+		}
+		issues.AddRow(n.Reference, state, n.Title)
+	}
+
+	links := tableprinter.NewTablePrinter()
+	for _, e := range g.Links {
+		links.AddRow(e.From, issueutils.LinkVerb(e.Type), e.To)
+	}
+
+	out := fmt.Sprintf("Showing %d issues linked to %s.\n\n%s\n%s\n%s", len(g.Issues)-1, g.Root, issues.String(), c.Bold("Links"), links.String())
+	if blocked := g.blocked(); blocked > 0 {
+		out += fmt.Sprintf("\n%s %d open issues are blocked by open issues.\n", c.WarnIcon(), blocked)
+	}
+	return out
+}
+
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// formatDOT returns the graph in the DOT language of Graphviz.
+func formatDOT(g *graph) string {
+	var b strings.Builder
+	b.WriteString("digraph issues {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box];\n")
+	for _, n := range g.Issues {
+		attrs := fmt.Sprintf(`label="%s\n%s"`, dotEscaper.Replace(n.Reference), dotEscaper.Replace(n.Title))
+		if n.WebURL != "" {
+			attrs += fmt.Sprintf(`, URL="%s"`, dotEscaper.Replace(n.WebURL))
+		}
+		switch {
+		case n.Blocked:
+			attrs += ", color=red, fontcolor=red"
+		case n.State == "closed":
+			attrs += ", style=dashed, color=gray, fontcolor=gray"
+		}
+		if n.Reference == g.Root {
+			attrs += ", penwidth=2"
+		}
+		fmt.Fprintf(&b, "  \"%s\" [%s];\n", dotEscaper.Replace(n.Reference), attrs)
+	}
+	for _, e := range g.Links {
+		attrs := ""
+		if e.Type == "relates_to" {
+			attrs = " [dir=none, style=dashed]"
+		}
+		fmt.Fprintf(&b, "  \"%s\" -> \"%s\"%s;\n", dotEscaper.Replace(e.From), dotEscaper.Replace(e.To), attrs)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// mermaidEscaper escapes the labels of Mermaid nodes, which can't contain double quotes.
+var mermaidEscaper = strings.NewReplacer(`"`, "#quot;")
+
+// formatMermaid returns the graph as a Mermaid flowchart.
+func formatMermaid(g *graph) string {
+	ids := map[string]string{}
+
+	var b strings.Builder
+	b.WriteString("graph LR\n")
+	for i, n := range g.Issues {
+		ids[n.Reference] = fmt.Sprintf("n%d", i)
+		fmt.Fprintf(&b, "  %s[\"%s: %s\"]\n", ids[n.Reference], mermaidEscaper.Replace(n.Reference), mermaidEscaper.Replace(n.Title))
+	}
+	for _, e := range g.Links {
+		arrow := "-->"
+		if e.Type == "relates_to" {
+			arrow = "-.-"
+		}
+		fmt.Fprintf(&b, "  %s %s %s\n", ids[e.From], arrow, ids[e.To])
+	}
+
+	var blocked, closed []string
+	for _, n := range g.Issues {
+		switch {
+		case n.Blocked:
+			blocked = append(blocked, ids[n.Reference])
+		case n.State == "closed":
+			closed = append(closed, ids[n.Reference])
+		}
+	}
+	if len(blocked) > 0 {
+		b.WriteString("  classDef blocked stroke:#d00,color:#d00\n")
+		fmt.Fprintf(&b, "  class %s blocked\n", strings.Join(blocked, ","))
+	}
+	if len(closed) > 0 {
+		b.WriteString("  classDef closed stroke:#888,color:#888,stroke-dasharray:4\n")
+		fmt.Fprintf(&b, "  class %s closed\n", strings.Join(closed, ","))
+	}
+	return b.String()
+}
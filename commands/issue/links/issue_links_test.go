@@ -0,0 +1,139 @@
+package links
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+func TestMain(m *testing.M) {
+	cmdtest.InitTest(m, "issue_links_test")
+}
+
+func relation(projectID, iid int, state, linkType string) *gitlab.IssueRelation {
+	ref := "OWNER/REPO#" + string(rune('0'+iid))
+	if projectID != 1 {
+		ref = "OWNER/OTHER#" + string(rune('0'+iid))
+	}
+	return &gitlab.IssueRelation{
+		ProjectID:  projectID,
+		IID:        iid,
+		Title:      "Issue \"" + ref + "\"",
+		State:      state,
+		LinkType:   linkType,
+		References: &gitlab.IssueReferences{Full: ref},
+	}
+}
+
+func runCommand(t *testing.T, args string) (string, error) {
+	t.Helper()
+
+	api.GetIssue = func(client *gitlab.Client, projectID interface{}, issueID int) (*gitlab.Issue, error) {
+		return &gitlab.Issue{
+			ProjectID:  1,
+			IID:        issueID,
+			Title:      "Root",
+			State:      "opened",
+			References: &gitlab.IssueReferences{Full: "OWNER/REPO#1"},
+		}, nil
+	}
+	// OWNER/REPO#2 blocks OWNER/REPO#1, which blocks OWNER/OTHER#4.
+	// OWNER/REPO#2 relates to OWNER/REPO#3.
+	relations := map[int][]*gitlab.IssueRelation{
+		1: {relation(1, 2, "opened", "is_blocked_by"), relation(2, 4, "closed", "blocks")},
+		2: {relation(1, 1, "opened", "blocks"), relation(1, 3, "opened", "relates_to")},
+		3: {relation(1, 2, "opened", "relates_to")},
+		4: {relation(1, 1, "opened", "is_blocked_by")},
+	}
+	api.ListIssueRelations = func(client *gitlab.Client, projectID interface{}, issueIID int) ([]*gitlab.IssueRelation, error) {
+		return relations[issueIID], nil
+	}
+
+	io, _, stdout, _ := iostreams.Test()
+	f := cmdtest.StubFactory("https://gitlab.com/OWNER/REPO")
+	f.IO = io
+
+	_, err := cmdtest.RunCommand(NewCmdLinks(f), args)
+	return stdout.String(), err
+}
+
+func TestIssueLinks(t *testing.T) {
+	output, err := runCommand(t, "1")
+	require.NoError(t, err)
+
+	assert.Contains(t, output, "Showing 2 issues linked to OWNER/REPO#1.")
+	assert.Regexp(t, `OWNER/REPO#1\s+blocked\s+Root`, output)
+	assert.Regexp(t, `OWNER/OTHER#4\s+closed`, output)
+	assert.Regexp(t, `OWNER/REPO#2\s+blocks\s+OWNER/REPO#1`, output)
+	assert.Regexp(t, `OWNER/REPO#1\s+blocks\s+OWNER/OTHER#4`, output)
+	assert.NotContains(t, output, "OWNER/REPO#3")
+	assert.Contains(t, output, "1 open issues are blocked by open issues.")
+}
+
+func TestIssueLinks_JSON(t *testing.T) {
+	output, err := runCommand(t, "1 --relates-to --output json")
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"root": "OWNER/REPO#1",
+		"issues": [
+			{"reference": "OWNER/REPO#1", "project_id": 1, "iid": 1, "title": "Root", "state": "opened", "web_url": "", "blocked": true},
+			{"reference": "OWNER/REPO#2", "project_id": 1, "iid": 2, "title": "Issue \"OWNER/REPO#2\"", "state": "opened", "web_url": "", "blocked": false},
+			{"reference": "OWNER/OTHER#4", "project_id": 2, "iid": 4, "title": "Issue \"OWNER/OTHER#4\"", "state": "closed", "web_url": "", "blocked": false},
+			{"reference": "OWNER/REPO#3", "project_id": 1, "iid": 3, "title": "Issue \"OWNER/REPO#3\"", "state": "opened", "web_url": "", "blocked": false}
+		],
+		"links": [
+			{"from": "OWNER/REPO#2", "to": "OWNER/REPO#1", "type": "blocks"},
+			{"from": "OWNER/REPO#1", "to": "OWNER/OTHER#4", "type": "blocks"},
+			{"from": "OWNER/REPO#2", "to": "OWNER/REPO#3", "type": "relates_to"}
+		]
+	}`, output)
+}
+
+func TestIssueLinks_Depth(t *testing.T) {
+	output, err := runCommand(t, "1 --relates-to --depth 1 --output json")
+	require.NoError(t, err)
+
+	assert.NotContains(t, output, "OWNER/REPO#3")
+}
+
+func TestIssueLinks_DOT(t *testing.T) {
+	output, err := runCommand(t, "1 --relates-to --output dot")
+	require.NoError(t, err)
+
+	assert.Contains(t, output, "digraph issues {\n")
+	assert.Contains(t, output, `"OWNER/REPO#1" [label="OWNER/REPO#1\nRoot", color=red, fontcolor=red, penwidth=2];`)
+	assert.Contains(t, output, `label="OWNER/REPO#2\nIssue \"OWNER/REPO#2\""`)
+	assert.Contains(t, output, `"OWNER/REPO#2" -> "OWNER/REPO#1";`)
+	assert.Contains(t, output, `"OWNER/REPO#2" -> "OWNER/REPO#3" [dir=none, style=dashed];`)
+}
+
+func TestIssueLinks_Mermaid(t *testing.T) {
+	output, err := runCommand(t, "1 --relates-to --output mermaid")
+	require.NoError(t, err)
+
+	assert.Equal(t, `graph LR
+  n0["OWNER/REPO#1: Root"]
+  n1["OWNER/REPO#2: Issue #quot;OWNER/REPO#2#quot;"]
+  n2["OWNER/OTHER#4: Issue #quot;OWNER/OTHER#4#quot;"]
+  n3["OWNER/REPO#3: Issue #quot;OWNER/REPO#3#quot;"]
+  n1 --> n0
+  n0 --> n2
+  n1 -.- n3
+  classDef blocked stroke:#d00,color:#d00
+  class n0 blocked
+  classDef closed stroke:#888,color:#888,stroke-dasharray:4
+  class n2 closed
+`, output)
+}
+
+func TestIssueLinks_InvalidOutput(t *testing.T) {
+	_, err := runCommand(t, "1 --output svg")
+	assert.EqualError(t, err, `invalid --output "svg": use text, json, dot, or mermaid.`)
+}
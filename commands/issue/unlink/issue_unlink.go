@@ -0,0 +1,71 @@
+package unlink
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/issue/issueutils"
+)
+
+func NewCmdUnlink(f *cmdutils.Factory) *cobra.Command {
+	issueUnlinkCmd := &cobra.Command{
+		Use:   "unlink <id> <target-id>... [flags]",
+		Short: `Remove the links between an issue and other issues.`,
+		Long: heredoc.Doc(`
+			Remove the links between an issue and other issues, of any type.
+		`),
+		Example: heredoc.Doc(`
+			glab issue unlink 12 34
+			glab issue unlink 12 https://gitlab.com/OWNER/OTHER-REPO/-/issues/7
+		`),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			issue, repo, err := issueutils.IssueFromArg(apiClient, f.BaseRepo, args[0])
+			if err != nil {
+				return err
+			}
+
+			relations, err := api.ListIssueRelations(apiClient, repo.FullName(), issue.IID)
+			if err != nil {
+				return err
+			}
+
+			c := f.IO.Color()
+			for _, arg := range args[1:] {
+				target, _, err := issueutils.IssueFromArg(apiClient, f.BaseRepo, arg)
+				if err != nil {
+					return err
+				}
+
+				linkID := 0
+				for _, r := range relations {
+					if r.ProjectID == target.ProjectID && r.IID == target.IID {
+						linkID = r.IssueLinkID
+						break
+					}
+				}
+				if linkID == 0 {
+					return fmt.Errorf("%s is not linked to %s.", issueutils.Reference(issue), issueutils.Reference(target))
+				}
+
+				if err := api.DeleteIssueLink(apiClient, repo.FullName(), issue.IID, linkID); err != nil {
+					return fmt.Errorf("failed to unlink %s from %s: %w", issueutils.Reference(issue), issueutils.Reference(target), err)
+				}
+
+				fmt.Fprintf(f.IO.StdOut, "%s Unlinked %s from %s\n", c.GreenCheck(), issueutils.Reference(issue), issueutils.Reference(target))
+			}
+			return nil
+		},
+	}
+
+	return issueUnlinkCmd
+}
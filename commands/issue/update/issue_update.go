@@ -8,6 +8,7 @@ import (
 	"gitlab.com/gitlab-org/cli/api"
 	"gitlab.com/gitlab-org/cli/commands/cmdutils"
 	"gitlab.com/gitlab-org/cli/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/spf13/cobra"
@@ -16,14 +17,24 @@ import (
 
 func NewCmdUpdate(f *cmdutils.Factory) *cobra.Command {
 	issueUpdateCmd := &cobra.Command{
-		Use:   "update <id>",
+		Use:   "update [<id> | -] [flags]",
 		Short: `Update issue`,
-		Long:  ``,
+		Long: heredoc.Doc(`
+			Update an issue, or several issues at once.
+
+			To update several issues, select them with the --filter flags, or pass - to read
+			issue IDs from standard input, one per line. glab shows the issues and the
+			changes, and asks for confirmation before applying them. The issues are updated
+			concurrently, within the rate limits of the GitLab instance.
+		`),
 		Example: heredoc.Doc(`
 	glab issue update 42 --label ui,ux
 	glab issue update 42 --unlabel working
+	glab issue update --filter-label bug --filter-milestone v1.0 --milestone v1.1
+	glab issue update --filter-iteration @current --filter-assignee @me --close --yes
+	glab issue list --label stale -F ids | glab issue update - --unlabel stale --close
 	`),
-		Args: cobra.ExactArgs(1),
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			var err error
 			var actions []string
@@ -57,6 +68,21 @@ func NewCmdUpdate(f *cmdutils.Factory) *cobra.Command {
 			if cmd.Flags().Changed("confidential") && cmd.Flags().Changed("public") {
 				return &cmdutils.FlagError{Err: errors.New("--public and --confidential can't be used together.")}
 			}
+			if cmd.Flags().Changed("close") && cmd.Flags().Changed("reopen") {
+				return &cmdutils.FlagError{Err: errors.New("--close and --reopen can't be used together.")}
+			}
+			if _, err := dueDate(cmd); err != nil {
+				return err
+			}
+
+			if len(args) == 0 || args[0] == "-" {
+				return bulkRun(f, cmd, args, ua)
+			}
+			for _, name := range filterFlags {
+				if cmd.Flags().Changed(name) {
+					return &cmdutils.FlagError{Err: fmt.Errorf("--%s can't be used with the ID of an issue.", name)}
+				}
+			}
 
 			apiClient, err := f.HttpClient()
 			if err != nil {
@@ -111,39 +137,9 @@ func NewCmdUpdate(f *cmdutils.Factory) *cobra.Command {
 					l.Description = gitlab.Ptr(m)
 				}
 			}
-			if m, _ := cmd.Flags().GetStringSlice("label"); len(m) != 0 {
-				actions = append(actions, fmt.Sprintf("added labels %s", strings.Join(m, " ")))
-				l.AddLabels = (*gitlab.LabelOptions)(&m)
-			}
-			if m, _ := cmd.Flags().GetStringSlice("unlabel"); len(m) != 0 {
-				actions = append(actions, fmt.Sprintf("removed labels %s", strings.Join(m, " ")))
-				l.RemoveLabels = (*gitlab.LabelOptions)(&m)
-			}
-			if m, _ := cmd.Flags().GetBool("public"); m {
-				actions = append(actions, "made public")
-				l.Confidential = gitlab.Ptr(false)
-			}
-			if m, _ := cmd.Flags().GetBool("confidential"); m {
-				actions = append(actions, "made confidential")
-				l.Confidential = gitlab.Ptr(true)
-			}
-			if ok := cmd.Flags().Changed("milestone"); ok {
-				if m, _ := cmd.Flags().GetString("milestone"); m != "" || m == "0" {
-					mID, err := cmdutils.ParseMilestone(apiClient, repo, m)
-					if err != nil {
-						return err
-					}
-					actions = append(actions, fmt.Sprintf("added milestone %q", m))
-					l.MilestoneID = gitlab.Ptr(mID)
-				} else {
-					// Unassign the Milestone
-					actions = append(actions, "unassigned milestone")
-					l.MilestoneID = gitlab.Ptr(0)
-				}
-			}
-			if cmd.Flags().Changed("unassign") {
-				l.AssigneeIDs = &[]int{0} // 0 or an empty int[] is the documented way to unassign
-				actions = append(actions, "unassigned all users")
+			actions, err = metadataOptions(cmd, apiClient, repo, l, actions)
+			if err != nil {
+				return err
 			}
 			if ua != nil {
 				if len(ua.ToReplace) != 0 {
@@ -191,6 +187,90 @@ func NewCmdUpdate(f *cmdutils.Factory) *cobra.Command {
 	issueUpdateCmd.Flags().
 		StringSliceP("assignee", "a", []string{}, "Assign users by username. Prefix with '!' or '-' to remove from existing assignees, or '+' to add new. Otherwise, replace existing assignees with these users.")
 	issueUpdateCmd.Flags().Bool("unassign", false, "Unassign all users.")
+	issueUpdateCmd.Flags().String("due-date", "", "Set the due date, in YYYY-MM-DD format. Set to \"\" to remove the due date.")
+	issueUpdateCmd.Flags().Bool("close", false, "Close the issue.")
+	issueUpdateCmd.Flags().Bool("reopen", false, "Reopen the issue.")
+
+	issueUpdateCmd.Flags().StringSlice("filter-label", []string{}, "Update the issues with these labels.")
+	issueUpdateCmd.Flags().String("filter-milestone", "", "Update the issues of this milestone.")
+	issueUpdateCmd.Flags().String("filter-assignee", "", "Update the issues assigned to this <username>. Use @me for yourself.")
+	issueUpdateCmd.Flags().String("filter-search", "", "Update the issues matching this search in their title or description.")
+	issueUpdateCmd.Flags().String("filter-iteration", "", "Update the issues of this iteration <id>, @current, or @next.")
+	issueUpdateCmd.Flags().String("filter-state", "opened", "Update the issues in this state: opened, closed, or all.")
+	issueUpdateCmd.Flags().Int("concurrency", 5, "Maximum number of issues updated at the same time.")
+	issueUpdateCmd.Flags().Bool("dry-run", false, "Show the issues and the changes, without updating the issues.")
+	issueUpdateCmd.Flags().BoolP("yes", "y", false, "Update several issues without prompting for confirmation.")
 
 	return issueUpdateCmd
 }
+
+// metadataOptions sets the changes to the labels, the confidentiality, the milestone,
+// the assignees, the due date, and the state of issues, which can be updated in bulk.
+func metadataOptions(cmd *cobra.Command, apiClient *gitlab.Client, repo glrepo.Interface, l *gitlab.UpdateIssueOptions, actions []string) ([]string, error) {
+	if m, _ := cmd.Flags().GetStringSlice("label"); len(m) != 0 {
+		actions = append(actions, fmt.Sprintf("added labels %s", strings.Join(m, " ")))
+		l.AddLabels = (*gitlab.LabelOptions)(&m)
+	}
+	if m, _ := cmd.Flags().GetStringSlice("unlabel"); len(m) != 0 {
+		actions = append(actions, fmt.Sprintf("removed labels %s", strings.Join(m, " ")))
+		l.RemoveLabels = (*gitlab.LabelOptions)(&m)
+	}
+	if m, _ := cmd.Flags().GetBool("public"); m {
+		actions = append(actions, "made public")
+		l.Confidential = gitlab.Ptr(false)
+	}
+	if m, _ := cmd.Flags().GetBool("confidential"); m {
+		actions = append(actions, "made confidential")
+		l.Confidential = gitlab.Ptr(true)
+	}
+	if ok := cmd.Flags().Changed("milestone"); ok {
+		if m, _ := cmd.Flags().GetString("milestone"); m != "" || m == "0" {
+			mID, err := cmdutils.ParseMilestone(apiClient, repo, m)
+			if err != nil {
+				return nil, err
+			}
+			actions = append(actions, fmt.Sprintf("added milestone %q", m))
+			l.MilestoneID = gitlab.Ptr(mID)
+		} else {
+			// Unassign the Milestone
+			actions = append(actions, "unassigned milestone")
+			l.MilestoneID = gitlab.Ptr(0)
+		}
+	}
+	if cmd.Flags().Changed("unassign") {
+		l.AssigneeIDs = &[]int{0} // 0 or an empty int[] is the documented way to unassign
+		actions = append(actions, "unassigned all users")
+	}
+	if cmd.Flags().Changed("due-date") {
+		date, _ := dueDate(cmd)
+		if date == nil {
+			actions = append(actions, "removed due date")
+			l.DueDate = &gitlab.ISOTime{}
+		} else {
+			actions = append(actions, fmt.Sprintf("set due date to %s", date))
+			l.DueDate = date
+		}
+	}
+	if m, _ := cmd.Flags().GetBool("close"); m {
+		actions = append(actions, "closed")
+		l.StateEvent = gitlab.Ptr("close")
+	}
+	if m, _ := cmd.Flags().GetBool("reopen"); m {
+		actions = append(actions, "reopened")
+		l.StateEvent = gitlab.Ptr("reopen")
+	}
+	return actions, nil
+}
+
+// dueDate returns the date of the --due-date flag, or nil to remove the due date.
+func dueDate(cmd *cobra.Command) (*gitlab.ISOTime, error) {
+	m, _ := cmd.Flags().GetString("due-date")
+	if m == "" {
+		return nil, nil
+	}
+	date, err := gitlab.ParseISOTime(m)
+	if err != nil {
+		return nil, &cmdutils.FlagError{Err: fmt.Errorf("invalid --due-date %q: use the YYYY-MM-DD format.", m)}
+	}
+	return &date, nil
+}
@@ -0,0 +1,281 @@
+package update
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"golang.org/x/sync/errgroup"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/commands/iteration/iterationutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/pkg/prompt"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+var filterFlags = []string{"filter-label", "filter-milestone", "filter-assignee", "filter-search", "filter-iteration", "filter-state"}
+
+// singleIssueFlags can't be used to update several issues.
+var singleIssueFlags = []string{"title", "description", "lock-discussion", "unlock-discussion"}
+
+// bulkRun updates the issues read from standard input, or the issues selected with the --filter flags.
+func bulkRun(f *cmdutils.Factory, cmd *cobra.Command, args []string, ua *cmdutils.UserAssignments) error {
+	fromStdin := len(args) == 1
+	filtered := false
+	for _, name := range filterFlags {
+		filtered = filtered || cmd.Flags().Changed(name)
+	}
+	switch {
+	case fromStdin && filtered:
+		return &cmdutils.FlagError{Err: errors.New("the --filter flags can't be used with issue IDs from standard input.")}
+	case !fromStdin && !filtered:
+		return &cmdutils.FlagError{Err: errors.New("specify the ID of the issue, - to read issue IDs from standard input, or --filter flags to select issues.")}
+	}
+	for _, name := range singleIssueFlags {
+		if cmd.Flags().Changed(name) {
+			return &cmdutils.FlagError{Err: fmt.Errorf("--%s can't be used to update several issues.", name)}
+		}
+	}
+
+	concurrency, _ := cmd.Flags().GetInt("concurrency")
+	if concurrency < 1 {
+		return &cmdutils.FlagError{Err: errors.New("--concurrency must be at least 1.")}
+	}
+
+	apiClient, err := f.HttpClient()
+	if err != nil {
+		return err
+	}
+	repo, err := f.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	listOpts := &gitlab.ListProjectIssuesOptions{}
+	if fromStdin {
+		iids, err := readIssueIDs(f)
+		if err != nil {
+			return err
+		}
+		listOpts.IIDs = &iids
+		listOpts.State = gitlab.Ptr("all")
+	} else if err := filterOptions(cmd, apiClient, repo, listOpts); err != nil {
+		return err
+	}
+
+	issues, err := listIssues(apiClient, repo, listOpts)
+	if err != nil {
+		return err
+	}
+	if len(issues) == 0 {
+		fmt.Fprintf(f.IO.StdOut, "No issues to update in %s.\n", repo.FullName())
+		return nil
+	}
+
+	l := &gitlab.UpdateIssueOptions{}
+	actions, err := metadataOptions(cmd, apiClient, repo, l, nil)
+	if err != nil {
+		return err
+	}
+	assignees, actions, err := bulkAssignees(apiClient, ua, actions)
+	if err != nil {
+		return err
+	}
+	if len(actions) == 0 {
+		return &cmdutils.FlagError{Err: errors.New("no changes to apply: use the flags to set the changes to the issues.")}
+	}
+
+	c := f.IO.Color()
+	out := f.IO.StdOut
+	fmt.Fprintf(out, "%s to update in %s:\n%s\n", utils.Pluralize(len(issues), "issue"), repo.FullName(), issueutils.DisplayIssueList(f.IO, issues, repo.FullName()))
+	fmt.Fprintln(out, "Changes:")
+	for _, s := range actions {
+		fmt.Fprintf(out, "  - %s\n", s)
+	}
+	fmt.Fprintln(out)
+
+	if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+		return nil
+	}
+
+	if yes, _ := cmd.Flags().GetBool("yes"); !yes {
+		if !f.IO.PromptEnabled() {
+			return &cmdutils.FlagError{Err: errors.New("--yes is required to update several issues when not running interactively.")}
+		}
+		confirmed := false
+		err := prompt.Confirm(&confirmed, fmt.Sprintf("Update %s?", utils.Pluralize(len(issues), "issue")), false)
+		if err != nil {
+			return fmt.Errorf("could not prompt: %w", err)
+		}
+		if !confirmed {
+			return cmdutils.CancelError()
+		}
+	}
+
+	// The updates are throttled by the API client, which follows the rate limit
+	// headers of the instance and retries the requests rejected with a 429 status.
+	var mu sync.Mutex
+	failed := 0
+	g, _ := errgroup.WithContext(context.Background())
+	g.SetLimit(concurrency)
+	for _, issue := range issues {
+		opts := *l
+		if assignees != nil {
+			opts.AssigneeIDs = assignees(issue)
+		}
+		g.Go(func() error {
+			updated, err := api.UpdateIssue(apiClient, repo.FullName(), issue.IID, &opts)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				failed++
+				fmt.Fprintf(f.IO.StdErr, "%s #%d: %s\n", c.FailedIcon(), issue.IID, err)
+				return nil
+			}
+			fmt.Fprintf(out, "%s %s %s\n", c.GreenCheck(), issueutils.IssueState(c, updated), updated.Title)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	if failed > 0 {
+		fmt.Fprintf(f.IO.StdErr, "\nUpdated %d of %s. %d failed.\n", len(issues)-failed, utils.Pluralize(len(issues), "issue"), failed)
+		return cmdutils.SilentError
+	}
+	fmt.Fprintf(out, "\nUpdated %s.\n", utils.Pluralize(len(issues), "issue"))
+	return nil
+}
+
+// readIssueIDs reads the IDs of issues from standard input, separated by spaces,
+// commas, or new lines, like the output of `glab issue list --output-format ids`.
+func readIssueIDs(f *cmdutils.Factory) ([]int, error) {
+	var iids []int
+	scanner := bufio.NewScanner(f.IO.In)
+	scanner.Split(bufio.ScanWords)
+	for scanner.Scan() {
+		for _, word := range strings.Split(scanner.Text(), ",") {
+			if word == "" {
+				continue
+			}
+			iid, err := strconv.Atoi(strings.TrimPrefix(word, "#"))
+			if err != nil || iid <= 0 {
+				return nil, fmt.Errorf("invalid issue ID on standard input: %q", word)
+			}
+			iids = append(iids, iid)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("could not read issue IDs from standard input: %w", err)
+	}
+	if len(iids) == 0 {
+		return nil, errors.New("no issue IDs on standard input.")
+	}
+	return iids, nil
+}
+
+// filterOptions sets the options to list the issues selected with the --filter flags.
+func filterOptions(cmd *cobra.Command, apiClient *gitlab.Client, repo glrepo.Interface, listOpts *gitlab.ListProjectIssuesOptions) error {
+	state, _ := cmd.Flags().GetString("filter-state")
+	switch state {
+	case "opened", "closed", "all":
+		listOpts.State = gitlab.Ptr(state)
+	default:
+		return &cmdutils.FlagError{Err: fmt.Errorf("invalid --filter-state %q: use opened, closed, or all.", state)}
+	}
+
+	if m, _ := cmd.Flags().GetStringSlice("filter-label"); len(m) != 0 {
+		listOpts.Labels = (*gitlab.LabelOptions)(&m)
+	}
+	if m, _ := cmd.Flags().GetString("filter-milestone"); m != "" {
+		listOpts.Milestone = gitlab.Ptr(m)
+	}
+	if m, _ := cmd.Flags().GetString("filter-assignee"); m != "" {
+		if m == "@me" {
+			u, err := api.CurrentUser(apiClient)
+			if err != nil {
+				return err
+			}
+			m = u.Username
+		}
+		listOpts.AssigneeUsername = gitlab.Ptr(m)
+	}
+	if m, _ := cmd.Flags().GetString("filter-search"); m != "" {
+		listOpts.Search = gitlab.Ptr(m)
+	}
+	if m, _ := cmd.Flags().GetString("filter-iteration"); m != "" {
+		iterationID, err := iterationutils.ResolveID(apiClient, cmdutils.Scope{Project: repo.FullName()}, m)
+		if err != nil {
+			return err
+		}
+		listOpts.IterationID = gitlab.Ptr(iterationID)
+	}
+	return nil
+}
+
+// listIssues returns all the issues matching listOpts, from all the pages.
+func listIssues(apiClient *gitlab.Client, repo glrepo.Interface, listOpts *gitlab.ListProjectIssuesOptions) ([]*gitlab.Issue, error) {
+	listOpts.PerPage = 100
+
+	var issues []*gitlab.Issue
+	for listOpts.Page = 1; ; listOpts.Page++ {
+		page, err := api.ListIssues(apiClient, repo.FullName(), listOpts)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, page...)
+		if len(page) < listOpts.PerPage {
+			return issues, nil
+		}
+	}
+}
+
+// bulkAssignees returns a function giving the assignees of each issue for the
+// --assignee flag, or nil when the assignees don't change. The users are looked up once
+// for all the issues.
+func bulkAssignees(apiClient *gitlab.Client, ua *cmdutils.UserAssignments, actions []string) (func(*gitlab.Issue) *[]int, []string, error) {
+	if ua == nil {
+		return nil, actions, nil
+	}
+
+	if len(ua.ToReplace) != 0 {
+		ids, actions, err := ua.UsersFromReplaces(apiClient, actions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return func(*gitlab.Issue) *[]int { return ids }, actions, nil
+	}
+
+	// the users to add don't depend on the issue: get them with no current assignees.
+	added, actions, err := ua.UsersFromAddRemove([]*gitlab.IssueAssignee{}, nil, apiClient, actions)
+	if err != nil {
+		return nil, nil, err
+	}
+	return func(issue *gitlab.Issue) *[]int {
+		var ids []int
+		for _, a := range issue.Assignees {
+			if !utils.PresentInStringSlice(ua.ToRemove, a.Username) {
+				ids = append(ids, a.ID)
+			}
+		}
+		for _, id := range *added {
+			if id != 0 && !utils.PresentInIntSlice(ids, id) {
+				ids = append(ids, id)
+			}
+		}
+		if len(ids) == 0 {
+			// 0 is the documented way to unassign
+			ids = []int{0}
+		}
+		return &ids
+	}, actions, nil
+}
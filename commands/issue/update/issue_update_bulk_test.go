@@ -0,0 +1,190 @@
+package update
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+func TestBulkUpdate(t *testing.T) {
+	createdAt := time.Now()
+	var listOpts *gitlab.ListProjectIssuesOptions
+	api.ListIssues = func(client *gitlab.Client, projectID interface{}, opts *gitlab.ListProjectIssuesOptions) ([]*gitlab.Issue, error) {
+		listOpts = opts
+		return []*gitlab.Issue{
+			{IID: 1, Title: "First", State: "opened", CreatedAt: &createdAt, Assignees: []*gitlab.IssueAssignee{{ID: 7, Username: "alice"}}},
+			{IID: 2, Title: "Second", State: "opened", CreatedAt: &createdAt},
+		}, nil
+	}
+
+	api.ListProjectIterations = func(client *gitlab.Client, projectID interface{}, opts *gitlab.ListProjectIterationsOptions) ([]*gitlab.ProjectIteration, error) {
+		return []*gitlab.ProjectIteration{{ID: 12, Title: "Sprint 12", State: api.IterationStateCurrent}}, nil
+	}
+
+	var mu sync.Mutex
+	var updated map[int]*gitlab.UpdateIssueOptions
+	api.UpdateIssue = func(client *gitlab.Client, projectID interface{}, issueID int, opts *gitlab.UpdateIssueOptions) (*gitlab.Issue, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if issueID == 2 && opts.StateEvent != nil && *opts.StateEvent == "reopen" {
+			return nil, errors.New("403 Forbidden")
+		}
+		updated[issueID] = opts
+		return &gitlab.Issue{IID: issueID, Title: "Updated", State: "opened"}, nil
+	}
+
+	tests := []struct {
+		name         string
+		args         string
+		stdin        string
+		wantUpdated  []int
+		wantOut      []string
+		wantErr      string
+		wantListOpts func(t *testing.T, opts *gitlab.ListProjectIssuesOptions)
+	}{
+		{
+			name:        "filters",
+			args:        "--filter-label bug --filter-search crash --label triaged --unlabel bug --due-date 2025-07-01 --yes",
+			wantUpdated: []int{1, 2},
+			wantOut: []string{
+				"2 issues to update in cli-automated-testing/test:",
+				"  - added labels triaged",
+				"  - removed labels bug",
+				"  - set due date to 2025-07-01",
+				"Updated 2 issues.",
+			},
+			wantListOpts: func(t *testing.T, opts *gitlab.ListProjectIssuesOptions) {
+				assert.Equal(t, "opened", *opts.State)
+				assert.Equal(t, gitlab.LabelOptions{"bug"}, *opts.Labels)
+				assert.Equal(t, "crash", *opts.Search)
+			},
+		},
+		{
+			name:        "issue IDs from standard input",
+			args:        "- --close --yes",
+			stdin:       "1\n#2, 3\n",
+			wantUpdated: []int{1, 2},
+			wantOut:     []string{"  - closed", "Updated 2 issues."},
+			wantListOpts: func(t *testing.T, opts *gitlab.ListProjectIssuesOptions) {
+				assert.Equal(t, []int{1, 2, 3}, *opts.IIDs)
+				assert.Equal(t, "all", *opts.State)
+			},
+		},
+		{
+			name:        "current iteration",
+			args:        "--filter-iteration @current --close --yes",
+			wantUpdated: []int{1, 2},
+			wantListOpts: func(t *testing.T, opts *gitlab.ListProjectIssuesOptions) {
+				assert.Equal(t, 12, *opts.IterationID)
+			},
+		},
+		{
+			name:        "dry run",
+			args:        "--filter-milestone v1.0 --milestone 3 --dry-run",
+			wantUpdated: []int{},
+			wantOut:     []string{`  - added milestone "3"`},
+		},
+		{
+			name:        "failed update",
+			args:        "--filter-state closed --reopen --yes",
+			wantUpdated: []int{1},
+			wantErr:     "SilentError",
+		},
+		{
+			name:    "no confirmation when not interactive",
+			args:    "--filter-label bug --confidential",
+			wantErr: "--yes is required to update several issues when not running interactively.",
+		},
+		{
+			name:    "no issues selected",
+			args:    "--label bug",
+			wantErr: "specify the ID of the issue, - to read issue IDs from standard input, or --filter flags to select issues.",
+		},
+		{
+			name:    "single issue flag",
+			args:    "--filter-label bug --title new",
+			wantErr: "--title can't be used to update several issues.",
+		},
+		{
+			name:    "filter with an issue ID",
+			args:    "42 --filter-label bug",
+			wantErr: "--filter-label can't be used with the ID of an issue.",
+		},
+		{
+			name:    "no changes",
+			args:    "--filter-label bug --yes",
+			wantErr: "no changes to apply: use the flags to set the changes to the issues.",
+		},
+		{
+			name:    "invalid issue ID on standard input",
+			args:    "- --close",
+			stdin:   "1 two",
+			wantErr: `invalid issue ID on standard input: "two"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			updated = map[int]*gitlab.UpdateIssueOptions{}
+			listOpts = nil
+
+			io, stdin, stdout, _ := iostreams.Test()
+			stdin.WriteString(tc.stdin)
+			f := cmdtest.StubFactory("https://gitlab.com/cli-automated-testing/test")
+			f.IO = io
+
+			cmd := NewCmdUpdate(f)
+			cmdutils.EnableRepoOverride(cmd, f)
+
+			_, err := cmdtest.RunCommand(cmd, tc.args)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tc.wantUpdated != nil {
+				ids := []int{}
+				for id := range updated {
+					ids = append(ids, id)
+				}
+				sort.Ints(ids)
+				assert.Equal(t, tc.wantUpdated, ids)
+			}
+			for _, want := range tc.wantOut {
+				assert.Contains(t, stdout.String(), want)
+			}
+			if tc.wantListOpts != nil {
+				tc.wantListOpts(t, listOpts)
+			}
+		})
+	}
+}
+
+func TestBulkAssignees(t *testing.T) {
+	ua := cmdutils.ParseAssignees([]string{"-alice"})
+	assignees, actions, err := bulkAssignees(nil, ua, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{`unassigned "@alice"`}, actions)
+
+	issue := &gitlab.Issue{Assignees: []*gitlab.IssueAssignee{{ID: 7, Username: "alice"}, {ID: 8, Username: "bob"}}}
+	assert.Equal(t, &[]int{8}, assignees(issue))
+
+	issue = &gitlab.Issue{Assignees: []*gitlab.IssueAssignee{{ID: 7, Username: "alice"}}}
+	assert.Equal(t, &[]int{0}, assignees(issue))
+
+	assignees, _, err = bulkAssignees(nil, nil, nil)
+	require.NoError(t, err)
+	assert.Nil(t, assignees)
+}
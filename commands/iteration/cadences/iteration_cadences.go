@@ -0,0 +1,93 @@
+package cadences
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/flag"
+	"gitlab.com/gitlab-org/cli/pkg/tableprinter"
+)
+
+func NewCmdCadences(f *cmdutils.Factory) *cobra.Command {
+	var outputFormat string
+
+	iterationCadencesCmd := &cobra.Command{
+		Use:   "cadences [flags]",
+		Short: `List the iteration cadences of a group.`,
+		Long: heredoc.Doc(`
+			List the iteration cadences of a group, including the cadences of its ancestor
+			groups. Defaults to the group of the current project.
+		`),
+		Aliases: []string{"cadence"},
+		Example: heredoc.Doc(`
+			glab iteration cadences
+			glab iteration cadences --group my-group --output json
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			group, err := flag.GroupOverride(cmd)
+			if err != nil {
+				return err
+			}
+			if group == "" {
+				repo, err := f.BaseRepo()
+				if err != nil {
+					return err
+				}
+				group = repo.RepoOwner()
+			}
+
+			cadences, err := api.ListGroupIterationCadences(apiClient, group)
+			if err != nil {
+				return err
+			}
+
+			if outputFormat == "json" {
+				cadencesJSON, _ := json.Marshal(cadences)
+				fmt.Fprintln(f.IO.StdOut, string(cadencesJSON))
+				return nil
+			}
+
+			if len(cadences) == 0 {
+				fmt.Fprintf(f.IO.StdOut, "No iteration cadences found for %s.\n", group)
+				return nil
+			}
+
+			fmt.Fprintf(f.IO.StdOut, "Showing %d iteration cadences for %s.\n\n", len(cadences), group)
+			fmt.Fprint(f.IO.StdOut, formatCadences(cadences))
+			return nil
+		},
+	}
+
+	iterationCadencesCmd.Flags().StringP("group", "g", "", "Select a group or subgroup. Ignored if a repository argument is set.")
+	iterationCadencesCmd.Flags().StringVarP(&outputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return iterationCadencesCmd
+}
+
+func formatCadences(cadences []*api.IterationCadence) string {
+	table := tableprinter.NewTablePrinter()
+	table.AddRow("ID", "TITLE", "SCHEDULING", "DURATION", "START", "ACTIVE")
+	for _, c := range cadences {
+		scheduling := "manual"
+		duration := ""
+		if c.Automatic {
+			scheduling = "automatic"
+			duration = fmt.Sprintf("%d weeks", c.DurationInWeeks)
+		}
+		// the ID is a global ID, like gid://gitlab/Iterations::Cadence/12
+		table.AddRow(path.Base(c.ID), c.Title, scheduling, duration, c.StartDate, c.Active)
+	}
+	return table.String()
+}
@@ -0,0 +1,36 @@
+package current
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/iteration/iterationutils"
+	"gitlab.com/gitlab-org/cli/commands/iteration/view"
+)
+
+func NewCmdCurrent(f *cmdutils.Factory) *cobra.Command {
+	var outputFormat string
+
+	iterationCurrentCmd := &cobra.Command{
+		Use:   "current [flags]",
+		Short: `Display the current iteration and its issues.`,
+		Long: heredoc.Docf(`
+			Display the current iteration and its issues, grouped by status and by assignee.
+			Same as %[1]sglab iteration view %[2]s%[1]s.
+		`, "`", iterationutils.Current),
+		Example: heredoc.Doc(`
+			glab iteration current
+			glab iteration current --group my-group --output json
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return view.Run(f, cmd, iterationutils.Current, outputFormat)
+		},
+	}
+
+	cmdutils.EnableGroupFlag(iterationCurrentCmd, "iterations")
+	iterationCurrentCmd.Flags().StringVarP(&outputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return iterationCurrentCmd
+}
@@ -0,0 +1,27 @@
+package iteration
+
+import (
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	iterationCadencesCmd "gitlab.com/gitlab-org/cli/commands/iteration/cadences"
+	iterationCurrentCmd "gitlab.com/gitlab-org/cli/commands/iteration/current"
+	iterationListCmd "gitlab.com/gitlab-org/cli/commands/iteration/list"
+	iterationViewCmd "gitlab.com/gitlab-org/cli/commands/iteration/view"
+)
+
+func NewCmdIteration(f *cmdutils.Factory) *cobra.Command {
+	iterationCmd := &cobra.Command{
+		Use:   "iteration <command> [flags]",
+		Short: `Work with project and group iterations.`,
+		Long:  ``,
+	}
+
+	cmdutils.EnableRepoOverride(iterationCmd, f)
+
+	iterationCmd.AddCommand(iterationListCmd.NewCmdList(f))
+	iterationCmd.AddCommand(iterationViewCmd.NewCmdView(f))
+	iterationCmd.AddCommand(iterationCurrentCmd.NewCmdCurrent(f))
+	iterationCmd.AddCommand(iterationCadencesCmd.NewCmdCadences(f))
+	return iterationCmd
+}
@@ -0,0 +1,201 @@
+package iterationutils
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+)
+
+const (
+	// Current selects the current iteration, in place of an iteration ID.
+	Current = "@current"
+	// Next selects the next upcoming iteration, in place of an iteration ID.
+	Next = "@next"
+)
+
+// List returns the iterations in the given state: opened, upcoming, current, closed, or all.
+// The iterations of the ancestor groups are included.
+func List(client *gitlab.Client, scope cmdutils.Scope, state, search string, page, perPage int) ([]*gitlab.GroupIteration, error) {
+	listOpts := gitlab.ListOptions{Page: page, PerPage: perPage}
+	var stateOpt, searchOpt *string
+	if state != "" {
+		stateOpt = gitlab.Ptr(state)
+	}
+	if search != "" {
+		searchOpt = gitlab.Ptr(search)
+	}
+
+	if scope.IsGroup() {
+		return api.ListGroupIterations(client, scope.Group, &gitlab.ListGroupIterationsOptions{
+			ListOptions:      listOpts,
+			State:            stateOpt,
+			Search:           searchOpt,
+			IncludeAncestors: gitlab.Ptr(true),
+		})
+	}
+
+	projectIterations, err := api.ListProjectIterations(client, scope.Project, &gitlab.ListProjectIterationsOptions{
+		ListOptions:      listOpts,
+		State:            stateOpt,
+		Search:           searchOpt,
+		IncludeAncestors: gitlab.Ptr(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	iterations := make([]*gitlab.GroupIteration, 0, len(projectIterations))
+	for _, it := range projectIterations {
+		iterations = append(iterations, &gitlab.GroupIteration{
+			ID:          it.ID,
+			IID:         it.IID,
+			Sequence:    it.Sequence,
+			GroupID:     it.GroupID,
+			Title:       it.Title,
+			Description: it.Description,
+			State:       it.State,
+			CreatedAt:   it.CreatedAt,
+			UpdatedAt:   it.UpdatedAt,
+			DueDate:     it.DueDate,
+			StartDate:   it.StartDate,
+			WebURL:      it.WebURL,
+		})
+	}
+	return iterations, nil
+}
+
+// Resolve returns the iteration with the given ID, or the iteration selected by @current or @next.
+func Resolve(client *gitlab.Client, scope cmdutils.Scope, value string) (*gitlab.GroupIteration, error) {
+	switch value {
+	case Current:
+		iterations, err := List(client, scope, "current", "", 1, 100)
+		if err != nil {
+			return nil, err
+		}
+		return single(iterations, "current", scope)
+	case Next:
+		iterations, err := List(client, scope, "upcoming", "", 1, 100)
+		if err != nil {
+			return nil, err
+		}
+		return single(earliest(iterations), "upcoming", scope)
+	}
+
+	id, err := parseID(value)
+	if err != nil {
+		return nil, err
+	}
+
+	// iterations can only be listed, so look for the ID in all of them
+	for page := 1; ; page++ {
+		iterations, err := List(client, scope, "all", "", page, 100)
+		if err != nil {
+			return nil, err
+		}
+		for _, it := range iterations {
+			if it.ID == id {
+				return it, nil
+			}
+		}
+		if len(iterations) < 100 {
+			return nil, fmt.Errorf("iteration %d not found in %s.", id, scope)
+		}
+	}
+}
+
+// ResolveID returns the ID of an iteration set by ID, @current, or @next.
+// An ID is returned as is, without looking up the iteration.
+func ResolveID(client *gitlab.Client, scope cmdutils.Scope, value string) (int, error) {
+	if value != Current && value != Next {
+		return parseID(value)
+	}
+
+	iteration, err := Resolve(client, scope, value)
+	if err != nil {
+		return 0, err
+	}
+	return iteration.ID, nil
+}
+
+func parseID(value string) (int, error) {
+	id, err := strconv.Atoi(value)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("invalid iteration: %q. Use the ID of the iteration, %s, or %s.", value, Current, Next)
+	}
+	return id, nil
+}
+
+// earliest returns the iterations that start first. Several cadences can have
+// iterations that start on the same day.
+func earliest(iterations []*gitlab.GroupIteration) []*gitlab.GroupIteration {
+	sort.SliceStable(iterations, func(i, j int) bool {
+		return startDate(iterations[i]) < startDate(iterations[j])
+	})
+
+	for i, it := range iterations {
+		if startDate(it) != startDate(iterations[0]) {
+			return iterations[:i]
+		}
+	}
+	return iterations
+}
+
+func startDate(it *gitlab.GroupIteration) string {
+	if it.StartDate == nil {
+		return ""
+	}
+	return it.StartDate.String()
+}
+
+// single returns the only iteration of iterations. With several cadences, there
+// can be several current or upcoming iterations, which must then be set by ID.
+func single(iterations []*gitlab.GroupIteration, state string, scope cmdutils.Scope) (*gitlab.GroupIteration, error) {
+	switch len(iterations) {
+	case 0:
+		return nil, fmt.Errorf("no %s iteration found in %s.", state, scope)
+	case 1:
+		return iterations[0], nil
+	}
+
+	names := make([]string, 0, len(iterations))
+	for _, it := range iterations {
+		names = append(names, fmt.Sprintf("%d (%s)", it.ID, Title(it)))
+	}
+	return nil, fmt.Errorf("%d %s iterations found in %s, in different cadences: %s. Use the ID of the iteration.",
+		len(iterations), state, scope, strings.Join(names, ", "))
+}
+
+// Title returns the title of an iteration or, as the iterations of automatic
+// cadences have none, its dates.
+func Title(it *gitlab.GroupIteration) string {
+	if it.Title != "" {
+		return it.Title
+	}
+	return fmt.Sprintf("%s → %s", startDate(it), dueDate(it))
+}
+
+func dueDate(it *gitlab.GroupIteration) string {
+	if it.DueDate == nil {
+		return ""
+	}
+	return it.DueDate.String()
+}
+
+// State returns the name of the state of an iteration.
+func State(it *gitlab.GroupIteration) string {
+	switch it.State {
+	case api.IterationStateUpcoming:
+		return "upcoming"
+	case api.IterationStateCurrent:
+		return "current"
+	case api.IterationStateClosed:
+		return "closed"
+	}
+	return "unknown"
+}
@@ -0,0 +1,91 @@
+package iterationutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+)
+
+func isoTime(t *testing.T, s string) *gitlab.ISOTime {
+	d, err := gitlab.ParseISOTime(s)
+	require.NoError(t, err)
+	return &d
+}
+
+func TestResolve(t *testing.T) {
+	byState := map[string][]*gitlab.ProjectIteration{
+		"current": {
+			{ID: 10, Title: "Sprint 10", State: api.IterationStateCurrent, StartDate: isoTime(t, "2025-06-02")},
+		},
+		"upcoming": {
+			{ID: 12, StartDate: isoTime(t, "2025-06-30"), DueDate: isoTime(t, "2025-07-13")},
+			{ID: 11, Title: "Sprint 11", StartDate: isoTime(t, "2025-06-16")},
+		},
+		"all": {
+			{ID: 9, Title: "Sprint 9", State: api.IterationStateClosed},
+			{ID: 10, Title: "Sprint 10", State: api.IterationStateCurrent},
+		},
+	}
+	api.ListProjectIterations = func(client *gitlab.Client, projectID interface{}, opts *gitlab.ListProjectIterationsOptions) ([]*gitlab.ProjectIteration, error) {
+		assert.True(t, *opts.IncludeAncestors)
+		return byState[*opts.State], nil
+	}
+
+	scope := cmdutils.Scope{Project: "OWNER/REPO"}
+
+	tests := []struct {
+		name    string
+		value   string
+		wantID  int
+		wantErr string
+	}{
+		{name: "current", value: "@current", wantID: 10},
+		{name: "next is the earliest upcoming", value: "@next", wantID: 11},
+		{name: "by ID", value: "9", wantID: 9},
+		{name: "unknown ID", value: "99", wantErr: "iteration 99 not found in OWNER/REPO."},
+		{name: "invalid", value: "@last", wantErr: `invalid iteration: "@last". Use the ID of the iteration, @current, or @next.`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			iteration, err := Resolve(nil, scope, tc.value)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantID, iteration.ID)
+		})
+	}
+}
+
+func TestResolve_severalCadences(t *testing.T) {
+	api.ListGroupIterations = func(client *gitlab.Client, groupID interface{}, opts *gitlab.ListGroupIterationsOptions) ([]*gitlab.GroupIteration, error) {
+		return []*gitlab.GroupIteration{
+			{ID: 1, Title: "Backend 4", StartDate: isoTime(t, "2025-06-02"), DueDate: isoTime(t, "2025-06-15")},
+			{ID: 2, StartDate: isoTime(t, "2025-06-02"), DueDate: isoTime(t, "2025-06-29")},
+		}, nil
+	}
+
+	_, err := Resolve(nil, cmdutils.Scope{Group: "GROUP"}, Current)
+	require.EqualError(t, err, "2 current iterations found in GROUP, in different cadences: 1 (Backend 4), 2 (2025-06-02 → 2025-06-29). Use the ID of the iteration.")
+
+	_, err = Resolve(nil, cmdutils.Scope{Group: "GROUP"}, Next)
+	require.Error(t, err)
+}
+
+func TestResolveID(t *testing.T) {
+	api.ListProjectIterations = func(client *gitlab.Client, projectID interface{}, opts *gitlab.ListProjectIterationsOptions) ([]*gitlab.ProjectIteration, error) {
+		t.Fatal("an iteration ID must not be looked up")
+		return nil, nil
+	}
+
+	id, err := ResolveID(nil, cmdutils.Scope{Project: "OWNER/REPO"}, "42")
+	require.NoError(t, err)
+	assert.Equal(t, 42, id)
+}
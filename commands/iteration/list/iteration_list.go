@@ -0,0 +1,106 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/iteration/iterationutils"
+	"gitlab.com/gitlab-org/cli/pkg/tableprinter"
+)
+
+type options struct {
+	state        string
+	search       string
+	page         int
+	perPage      int
+	outputFormat string
+}
+
+var states = []string{"opened", "upcoming", "current", "closed", "all"}
+
+func NewCmdList(f *cmdutils.Factory) *cobra.Command {
+	opts := &options{}
+
+	iterationListCmd := &cobra.Command{
+		Use:   "list [flags]",
+		Short: `List the iterations of a project or group.`,
+		Long: heredoc.Doc(`
+			List the iterations of a project or group, including the iterations
+			of the ancestor groups.
+		`),
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			glab iteration list
+			glab iteration list --state closed --per-page 10
+			glab iteration list --group my-group --output json
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !validState(opts.state) {
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid --state %q: use opened, upcoming, current, closed, or all.", opts.state)}
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			scope, err := cmdutils.ScopeFromCmd(cmd, f)
+			if err != nil {
+				return err
+			}
+
+			iterations, err := iterationutils.List(apiClient, scope, opts.state, opts.search, opts.page, opts.perPage)
+			if err != nil {
+				return err
+			}
+
+			if opts.outputFormat == "json" {
+				iterationsJSON, _ := json.Marshal(iterations)
+				fmt.Fprintln(f.IO.StdOut, string(iterationsJSON))
+				return nil
+			}
+
+			if len(iterations) == 0 {
+				fmt.Fprintf(f.IO.StdOut, "No iterations found for %s.\n", scope)
+				return nil
+			}
+
+			fmt.Fprintf(f.IO.StdOut, "Showing %d iterations for %s.\n\n", len(iterations), scope)
+			fmt.Fprint(f.IO.StdOut, formatIterations(iterations))
+			return nil
+		},
+	}
+
+	cmdutils.EnableGroupFlag(iterationListCmd, "iterations")
+	iterationListCmd.Flags().StringVarP(&opts.state, "state", "s", "opened", "Filter iterations by state: opened, upcoming, current, closed, or all.")
+	iterationListCmd.Flags().StringVar(&opts.search, "search", "", "Filter iterations by title.")
+	iterationListCmd.Flags().IntVarP(&opts.page, "page", "p", 1, "Page number.")
+	iterationListCmd.Flags().IntVarP(&opts.perPage, "per-page", "P", 30, "Number of items to list per page.")
+	iterationListCmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return iterationListCmd
+}
+
+func validState(state string) bool {
+	for _, s := range states {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+func formatIterations(iterations []*gitlab.GroupIteration) string {
+	table := tableprinter.NewTablePrinter()
+	table.AddRow("ID", "TITLE", "STATE", "START", "DUE")
+	for _, it := range iterations {
+		table.AddRow(it.ID, iterationutils.Title(it), iterationutils.State(it), it.StartDate, it.DueDate)
+	}
+	return table.String()
+}
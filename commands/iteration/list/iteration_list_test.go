@@ -0,0 +1,88 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+func TestNewCmdList(t *testing.T) {
+	startDate, _ := gitlab.ParseISOTime("2025-06-02")
+	dueDate, _ := gitlab.ParseISOTime("2025-06-15")
+
+	var gotState *string
+	api.ListProjectIterations = func(client *gitlab.Client, projectID interface{}, opts *gitlab.ListProjectIterationsOptions) ([]*gitlab.ProjectIteration, error) {
+		gotState = opts.State
+		return []*gitlab.ProjectIteration{
+			{ID: 10, State: api.IterationStateCurrent, StartDate: &startDate, DueDate: &dueDate},
+		}, nil
+	}
+	api.ListGroupIterations = func(client *gitlab.Client, groupID interface{}, opts *gitlab.ListGroupIterationsOptions) ([]*gitlab.GroupIteration, error) {
+		gotState = opts.State
+		return []*gitlab.GroupIteration{}, nil
+	}
+
+	tests := []struct {
+		name      string
+		arg       string
+		want      []string
+		wantState string
+		wantErr   string
+	}{
+		{
+			name:      "project iterations",
+			arg:       "",
+			want:      []string{"Showing 1 iterations for cli-automated-testing/test.", "2025-06-02 → 2025-06-15", "current"},
+			wantState: "opened",
+		},
+		{
+			name:      "closed group iterations",
+			arg:       "--group my-group --state closed",
+			want:      []string{"No iterations found for my-group."},
+			wantState: "closed",
+		},
+		{
+			name:      "json output",
+			arg:       "--state all --output json",
+			want:      []string{`"id":10`},
+			wantState: "all",
+		},
+		{
+			name:    "invalid state",
+			arg:     "--state active",
+			wantErr: `invalid --state "active": use opened, upcoming, current, closed, or all.`,
+		},
+	}
+
+	io, _, stdout, _ := iostreams.Test()
+	f := cmdtest.StubFactory("https://gitlab.com/cli-automated-testing/test")
+	f.IO = io
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stdout.Reset()
+
+			cmd := NewCmdList(f)
+			cmdutils.EnableRepoOverride(cmd, f)
+
+			_, err := cmdtest.RunCommand(cmd, tc.arg)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			for _, want := range tc.want {
+				assert.Contains(t, stdout.String(), want)
+			}
+			assert.Equal(t, tc.wantState, *gotState)
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/commands/iteration/iterationutils"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/pkg/tableprinter"
+)
+
+// unassigned is the name used for the issues without assignee.
+const unassigned = "unassigned"
+
+type assigneeSummary struct {
+	Assignee string `json:"assignee"`
+	Opened   int    `json:"opened"`
+	Closed   int    `json:"closed"`
+}
+
+type iterationReport struct {
+	Iteration *gitlab.GroupIteration `json:"iteration"`
+	Issues    []*gitlab.Issue        `json:"issues"`
+	Assignees []assigneeSummary      `json:"assignees"`
+}
+
+func NewCmdView(f *cmdutils.Factory) *cobra.Command {
+	var outputFormat string
+
+	iterationViewCmd := &cobra.Command{
+		Use:   "view <iteration> [flags]",
+		Short: `Display an iteration and its issues.`,
+		Long: heredoc.Docf(`
+			Display an iteration and its issues, grouped by status and by assignee.
+
+			Set the iteration by ID, or use %[1]s%[2]s%[1]s for the current iteration
+			and %[1]s%[3]s%[1]s for the next one.
+		`, "`", iterationutils.Current, iterationutils.Next),
+		Aliases: []string{"show"},
+		Example: heredoc.Doc(`
+			glab iteration view 1234
+			glab iteration view @next
+			glab iteration view @current --group my-group --output json
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return Run(f, cmd, args[0], outputFormat)
+		},
+	}
+
+	cmdutils.EnableGroupFlag(iterationViewCmd, "iterations")
+	iterationViewCmd.Flags().StringVarP(&outputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return iterationViewCmd
+}
+
+// Run displays the iteration set by ID, @current, or @next, with its issues.
+func Run(f *cmdutils.Factory, cmd *cobra.Command, value, outputFormat string) error {
+	apiClient, err := f.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	scope, err := cmdutils.ScopeFromCmd(cmd, f)
+	if err != nil {
+		return err
+	}
+
+	iteration, err := iterationutils.Resolve(apiClient, scope, value)
+	if err != nil {
+		return err
+	}
+
+	issues, err := listIssues(apiClient, scope, iteration.ID)
+	if err != nil {
+		return err
+	}
+
+	report := &iterationReport{
+		Iteration: iteration,
+		Issues:    issues,
+		Assignees: summarizeAssignees(issues),
+	}
+
+	if outputFormat == "json" {
+		reportJSON, _ := json.Marshal(report)
+		fmt.Fprintln(f.IO.StdOut, string(reportJSON))
+		return nil
+	}
+
+	fmt.Fprint(f.IO.StdOut, displayReport(f.IO, report))
+	return nil
+}
+
+func listIssues(client *gitlab.Client, scope cmdutils.Scope, iterationID int) ([]*gitlab.Issue, error) {
+	opts := &gitlab.ListProjectIssuesOptions{
+		State:       gitlab.Ptr("all"),
+		IterationID: gitlab.Ptr(iterationID),
+	}
+	opts.PerPage = 100
+
+	var issues []*gitlab.Issue
+	for opts.Page = 1; ; opts.Page++ {
+		var page []*gitlab.Issue
+		var err error
+		if scope.IsGroup() {
+			page, err = api.ListGroupIssues(client, scope.Group, api.ProjectListIssueOptionsToGroup(opts))
+		} else {
+			page, err = api.ListIssues(client, scope.Project, opts)
+		}
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, page...)
+		if len(page) < opts.PerPage {
+			return issues, nil
+		}
+	}
+}
+
+// summarizeAssignees counts the open and closed issues of each assignee. An
+// issue with several assignees counts for each of them.
+func summarizeAssignees(issues []*gitlab.Issue) []assigneeSummary {
+	byAssignee := map[string]*assigneeSummary{}
+	count := func(name string, issue *gitlab.Issue) {
+		s, ok := byAssignee[name]
+		if !ok {
+			s = &assigneeSummary{Assignee: name}
+			byAssignee[name] = s
+		}
+		if issue.State == "closed" {
+			s.Closed++
+		} else {
+			s.Opened++
+		}
+	}
+
+	for _, issue := range issues {
+		if len(issue.Assignees) == 0 {
+			count(unassigned, issue)
+			continue
+		}
+		for _, a := range issue.Assignees {
+			count(a.Username, issue)
+		}
+	}
+
+	summary := make([]assigneeSummary, 0, len(byAssignee))
+	for _, s := range byAssignee {
+		summary = append(summary, *s)
+	}
+	sort.Slice(summary, func(i, j int) bool {
+		// the issues without assignee come last
+		if (summary[i].Assignee == unassigned) != (summary[j].Assignee == unassigned) {
+			return summary[j].Assignee == unassigned
+		}
+		return summary[i].Assignee < summary[j].Assignee
+	})
+	return summary
+}
+
+func displayReport(io *iostreams.IOStreams, r *iterationReport) string {
+	c := io.Color()
+	it := r.Iteration
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s\n", c.Bold(iterationutils.Title(it)))
+	fmt.Fprintf(&b, "ID:\t\t%d\n", it.ID)
+	fmt.Fprintf(&b, "State:\t\t%s\n", iterationutils.State(it))
+	if it.StartDate != nil {
+		fmt.Fprintf(&b, "Start date:\t%s\n", it.StartDate)
+	}
+	if it.DueDate != nil {
+		fmt.Fprintf(&b, "Due date:\t%s\n", it.DueDate)
+	}
+	if it.WebURL != "" {
+		fmt.Fprintf(&b, "%s\n", c.Gray(it.WebURL))
+	}
+
+	if len(r.Issues) == 0 {
+		fmt.Fprintf(&b, "\nNo issues in this iteration.\n")
+		return b.String()
+	}
+
+	var opened, closed []*gitlab.Issue
+	for _, issue := range r.Issues {
+		if issue.State == "closed" {
+			closed = append(closed, issue)
+		} else {
+			opened = append(opened, issue)
+		}
+	}
+
+	for _, section := range []struct {
+		title  string
+		issues []*gitlab.Issue
+	}{{"Open", opened}, {"Closed", closed}} {
+		if len(section.issues) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "\n%s (%d)\n", c.Bold(section.title), len(section.issues))
+		fmt.Fprint(&b, issueutils.DisplayIssueList(io, section.issues, ""))
+	}
+
+	table := tableprinter.NewTablePrinter()
+	table.AddRow("ASSIGNEE", "OPEN", "CLOSED")
+	for _, s := range r.Assignees {
+		table.AddRow(s.Assignee, s.Opened, s.Closed)
+	}
+	fmt.Fprintf(&b, "\n%s\n%s", c.Bold("By assignee"), table.String())
+
+	return b.String()
+}
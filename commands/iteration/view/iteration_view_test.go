@@ -0,0 +1,82 @@
+package view
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+func TestSummarizeAssignees(t *testing.T) {
+	issues := []*gitlab.Issue{
+		{IID: 1, State: "opened", Assignees: []*gitlab.IssueAssignee{{Username: "bob"}}},
+		{IID: 2, State: "closed", Assignees: []*gitlab.IssueAssignee{{Username: "bob"}, {Username: "alice"}}},
+		{IID: 3, State: "opened"},
+		{IID: 4, State: "closed", Assignees: []*gitlab.IssueAssignee{{Username: "alice"}}},
+	}
+
+	assert.Equal(t, []assigneeSummary{
+		{Assignee: "alice", Opened: 0, Closed: 2},
+		{Assignee: "bob", Opened: 1, Closed: 1},
+		{Assignee: "unassigned", Opened: 1, Closed: 0},
+	}, summarizeAssignees(issues))
+}
+
+func TestNewCmdView(t *testing.T) {
+	createdAt := time.Now()
+	api.ListProjectIterations = func(client *gitlab.Client, projectID interface{}, opts *gitlab.ListProjectIterationsOptions) ([]*gitlab.ProjectIteration, error) {
+		require.Equal(t, "current", *opts.State)
+		return []*gitlab.ProjectIteration{
+			{ID: 10, Title: "Sprint 10", State: api.IterationStateCurrent, WebURL: "https://gitlab.com/groups/cli-automated-testing/-/iterations/10"},
+		}, nil
+	}
+
+	var gotIterationID *int
+	api.ListIssues = func(client *gitlab.Client, projectID interface{}, opts *gitlab.ListProjectIssuesOptions) ([]*gitlab.Issue, error) {
+		gotIterationID = opts.IterationID
+		return []*gitlab.Issue{
+			{IID: 1, Title: "Open issue", State: "opened", CreatedAt: &createdAt},
+			{IID: 2, Title: "Closed issue", State: "closed", CreatedAt: &createdAt, Assignees: []*gitlab.IssueAssignee{{Username: "alice"}}},
+		}, nil
+	}
+
+	io, _, stdout, _ := iostreams.Test()
+	f := cmdtest.StubFactory("https://gitlab.com/cli-automated-testing/test")
+	f.IO = io
+
+	t.Run("text output", func(t *testing.T) {
+		stdout.Reset()
+		cmd := NewCmdView(f)
+		cmdutils.EnableRepoOverride(cmd, f)
+
+		_, err := cmdtest.RunCommand(cmd, "@current")
+		require.NoError(t, err)
+
+		require.NotNil(t, gotIterationID)
+		assert.Equal(t, 10, *gotIterationID)
+		out := stdout.String()
+		for _, want := range []string{"Sprint 10", "State:\t\tcurrent", "Open (1)", "Open issue", "Closed (1)", "Closed issue", "By assignee", "alice", "unassigned"} {
+			assert.Contains(t, out, want)
+		}
+		assert.Less(t, strings.Index(out, "Open issue"), strings.Index(out, "Closed issue"))
+	})
+
+	t.Run("json output", func(t *testing.T) {
+		stdout.Reset()
+		cmd := NewCmdView(f)
+		cmdutils.EnableRepoOverride(cmd, f)
+
+		_, err := cmdtest.RunCommand(cmd, "@current --output json")
+		require.NoError(t, err)
+
+		assert.Contains(t, stdout.String(), `"assignees":[{"assignee":"alice","opened":0,"closed":1},{"assignee":"unassigned","opened":1,"closed":0}]`)
+	})
+}
@@ -0,0 +1,59 @@
+package close
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/milestone/milestoneutils"
+)
+
+func NewCmdClose(f *cmdutils.Factory) *cobra.Command {
+	milestoneCloseCmd := &cobra.Command{
+		Use:   "close <milestone> [flags]",
+		Short: `Close a milestone, by ID or title.`,
+		Example: heredoc.Doc(`
+			glab milestone close v1.0
+			glab milestone close 42 --group my-group
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			scope, err := cmdutils.ScopeFromCmd(cmd, f)
+			if err != nil {
+				return err
+			}
+
+			milestone, err := milestoneutils.Get(apiClient, scope, args[0])
+			if err != nil {
+				return err
+			}
+
+			if milestone.State == "closed" {
+				fmt.Fprintf(f.IO.StdErr, "Milestone %q is already closed.\n", milestone.Title)
+				return nil
+			}
+
+			milestone, err = milestoneutils.Update(apiClient, scope, milestone.ID, &gitlab.UpdateMilestoneOptions{
+				StateEvent: gitlab.Ptr("close"),
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(f.IO.StdOut, "%s Closed milestone %q.\n", f.IO.Color().RedCheck(), milestone.Title)
+			return nil
+		},
+	}
+
+	cmdutils.EnableGroupFlag(milestoneCloseCmd, "milestones")
+
+	return milestoneCloseCmd
+}
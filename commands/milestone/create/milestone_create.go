@@ -0,0 +1,97 @@
+package create
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/milestone/milestoneutils"
+)
+
+type options struct {
+	title       string
+	description string
+	startDate   string
+	dueDate     string
+}
+
+func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
+	opts := &options{}
+
+	milestoneCreateCmd := &cobra.Command{
+		Use:     "create [flags]",
+		Short:   `Create a milestone in a project or group.`,
+		Aliases: []string{"new"},
+		Example: heredoc.Doc(`
+			glab milestone create --title v1.0 --due-date 2025-06-30
+			glab milestone create --title "Q3 2025" --start-date 2025-07-01 --due-date 2025-09-30 --group my-group
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			createOpts := &gitlab.CreateMilestoneOptions{Title: gitlab.Ptr(opts.title)}
+			if opts.description != "" {
+				createOpts.Description = gitlab.Ptr(opts.description)
+			}
+
+			var err error
+			if opts.startDate != "" {
+				if createOpts.StartDate, err = milestoneutils.ParseDate(opts.startDate, "start-date"); err != nil {
+					return err
+				}
+			}
+			if opts.dueDate != "" {
+				if createOpts.DueDate, err = milestoneutils.ParseDate(opts.dueDate, "due-date"); err != nil {
+					return err
+				}
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			scope, err := cmdutils.ScopeFromCmd(cmd, f)
+			if err != nil {
+				return err
+			}
+
+			var milestone *gitlab.Milestone
+			if scope.IsGroup() {
+				groupMilestone, err := api.CreateGroupMilestone(apiClient, scope.Group, &gitlab.CreateGroupMilestoneOptions{
+					Title:       createOpts.Title,
+					Description: createOpts.Description,
+					StartDate:   createOpts.StartDate,
+					DueDate:     createOpts.DueDate,
+				})
+				if err != nil {
+					return err
+				}
+				milestone = milestoneutils.FromGroupMilestone(groupMilestone)
+			} else {
+				milestone, err = api.CreateProjectMilestone(apiClient, scope.Project, createOpts)
+				if err != nil {
+					return err
+				}
+			}
+
+			fmt.Fprintf(f.IO.StdOut, "%s Created milestone %q in %s, with ID %d.\n", f.IO.Color().GreenCheck(), milestone.Title, scope, milestone.ID)
+			if milestone.WebURL != "" {
+				fmt.Fprintln(f.IO.StdOut, milestone.WebURL)
+			}
+			return nil
+		},
+	}
+
+	cmdutils.EnableGroupFlag(milestoneCreateCmd, "milestones")
+	milestoneCreateCmd.Flags().StringVarP(&opts.title, "title", "t", "", "Title of the milestone.")
+	milestoneCreateCmd.Flags().StringVarP(&opts.description, "description", "d", "", "Description of the milestone.")
+	milestoneCreateCmd.Flags().StringVar(&opts.startDate, "start-date", "", "Start date of the milestone, in the YYYY-MM-DD format.")
+	milestoneCreateCmd.Flags().StringVar(&opts.dueDate, "due-date", "", "Due date of the milestone, in the YYYY-MM-DD format.")
+	_ = milestoneCreateCmd.MarkFlagRequired("title")
+
+	return milestoneCreateCmd
+}
@@ -0,0 +1,126 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/milestone/milestoneutils"
+	"gitlab.com/gitlab-org/cli/pkg/tableprinter"
+)
+
+type options struct {
+	state         string
+	search        string
+	includeParent bool
+	page          int
+	perPage       int
+	outputFormat  string
+}
+
+func NewCmdList(f *cmdutils.Factory) *cobra.Command {
+	opts := &options{}
+
+	milestoneListCmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List the milestones of a project or group.`,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			glab milestone list
+			glab milestone list --state closed --search "v1."
+			glab milestone list --group my-group --output json
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.state != "active" && opts.state != "closed" && opts.state != "all" {
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid --state %q: use active, closed, or all.", opts.state)}
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			scope, err := cmdutils.ScopeFromCmd(cmd, f)
+			if err != nil {
+				return err
+			}
+
+			listOpts := &api.ListMilestonesOptions{
+				PerPage: opts.perPage,
+				Page:    opts.page,
+			}
+			if opts.state != "all" {
+				listOpts.State = gitlab.Ptr(opts.state)
+			}
+			if opts.search != "" {
+				listOpts.Search = gitlab.Ptr(opts.search)
+			}
+			if opts.includeParent {
+				listOpts.IncludeParentMilestones = gitlab.Ptr(true)
+			}
+
+			var milestones []*gitlab.Milestone
+			if scope.IsGroup() {
+				groupMilestones, err := api.ListGroupMilestones(apiClient, scope.Group, listOpts.ListGroupMilestonesOptions())
+				if err != nil {
+					return err
+				}
+				for _, m := range groupMilestones {
+					milestones = append(milestones, milestoneutils.FromGroupMilestone(m))
+				}
+			} else {
+				projectOpts := listOpts.ListProjectMilestonesOptions()
+				projectOpts.IncludeParentMilestones = listOpts.IncludeParentMilestones
+				milestones, err = api.ListProjectMilestones(apiClient, scope.Project, projectOpts)
+				if err != nil {
+					return err
+				}
+			}
+
+			if opts.outputFormat == "json" {
+				milestonesJSON, _ := json.Marshal(milestones)
+				fmt.Fprintln(f.IO.StdOut, string(milestonesJSON))
+				return nil
+			}
+
+			if len(milestones) == 0 {
+				fmt.Fprintf(f.IO.StdOut, "No milestones found for %s.\n", scope)
+				return nil
+			}
+
+			fmt.Fprintf(f.IO.StdOut, "Showing %d milestones for %s.\n\n", len(milestones), scope)
+			fmt.Fprint(f.IO.StdOut, formatMilestones(f, milestones))
+			return nil
+		},
+	}
+
+	cmdutils.EnableGroupFlag(milestoneListCmd, "milestones")
+	milestoneListCmd.Flags().StringVarP(&opts.state, "state", "s", "active", "Filter milestones by state: active, closed, or all.")
+	milestoneListCmd.Flags().StringVar(&opts.search, "search", "", "Filter milestones by title or description.")
+	milestoneListCmd.Flags().BoolVar(&opts.includeParent, "include-parent", false, "Include the milestones of the parent groups.")
+	milestoneListCmd.Flags().IntVarP(&opts.page, "page", "p", 1, "Page number.")
+	milestoneListCmd.Flags().IntVarP(&opts.perPage, "per-page", "P", 30, "Number of items to list per page.")
+	milestoneListCmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return milestoneListCmd
+}
+
+func formatMilestones(f *cmdutils.Factory, milestones []*gitlab.Milestone) string {
+	c := f.IO.Color()
+	table := tableprinter.NewTablePrinter()
+	table.AddRow("ID", "TITLE", "STATE", "START", "DUE")
+	for _, m := range milestones {
+		due := milestoneutils.FormatDate(m.DueDate)
+		if m.State == "active" && m.Expired != nil && *m.Expired {
+			due = c.Red(due + " (expired)")
+		}
+		table.AddRow(m.ID, m.Title, m.State, milestoneutils.FormatDate(m.StartDate), due)
+	}
+	return table.String()
+}
@@ -0,0 +1,89 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/acarl005/stripansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+func TestNewCmdList(t *testing.T) {
+	dueDate, _ := gitlab.ParseISOTime("2025-06-30")
+
+	var gotState *string
+	api.ListProjectMilestones = func(client *gitlab.Client, projectID interface{}, opts *gitlab.ListMilestonesOptions) ([]*gitlab.Milestone, error) {
+		gotState = opts.State
+		return []*gitlab.Milestone{
+			{ID: 1, Title: "v1.0", State: "active", DueDate: &dueDate},
+		}, nil
+	}
+	api.ListGroupMilestones = func(client *gitlab.Client, groupID interface{}, opts *gitlab.ListGroupMilestonesOptions) ([]*gitlab.GroupMilestone, error) {
+		gotState = opts.State
+		return []*gitlab.GroupMilestone{}, nil
+	}
+
+	tests := []struct {
+		name      string
+		arg       string
+		want      []string
+		wantState *string
+		wantErr   string
+	}{
+		{
+			name:      "project milestones",
+			arg:       "",
+			want:      []string{"Showing 1 milestones for cli-automated-testing/test.", "v1.0", "2025-06-30"},
+			wantState: gitlab.Ptr("active"),
+		},
+		{
+			name:      "all group milestones",
+			arg:       "--group my-group --state all",
+			want:      []string{"No milestones found for my-group."},
+			wantState: nil,
+		},
+		{
+			name:      "json output",
+			arg:       "--state closed --output json",
+			want:      []string{`"title":"v1.0"`},
+			wantState: gitlab.Ptr("closed"),
+		},
+		{
+			name:    "invalid state",
+			arg:     "--state opened",
+			wantErr: `invalid --state "opened": use active, closed, or all.`,
+		},
+	}
+
+	io, _, stdout, _ := iostreams.Test()
+	f := cmdtest.StubFactory("https://gitlab.com/cli-automated-testing/test")
+	f.IO = io
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stdout.Reset()
+
+			cmd := NewCmdList(f)
+			cmdutils.EnableRepoOverride(cmd, f)
+
+			_, err := cmdtest.RunCommand(cmd, tc.arg)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			out := stripansi.Strip(stdout.String())
+			for _, want := range tc.want {
+				assert.Contains(t, out, want)
+			}
+			assert.Equal(t, tc.wantState, gotState)
+		})
+	}
+}
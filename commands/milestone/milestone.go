@@ -0,0 +1,31 @@
+package milestone
+
+import (
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	milestoneCloseCmd "gitlab.com/gitlab-org/cli/commands/milestone/close"
+	milestoneCreateCmd "gitlab.com/gitlab-org/cli/commands/milestone/create"
+	milestoneListCmd "gitlab.com/gitlab-org/cli/commands/milestone/list"
+	milestoneReportCmd "gitlab.com/gitlab-org/cli/commands/milestone/report"
+	milestoneUpdateCmd "gitlab.com/gitlab-org/cli/commands/milestone/update"
+	milestoneViewCmd "gitlab.com/gitlab-org/cli/commands/milestone/view"
+)
+
+func NewCmdMilestone(f *cmdutils.Factory) *cobra.Command {
+	milestoneCmd := &cobra.Command{
+		Use:   "milestone <command> [flags]",
+		Short: `Manage project and group milestones.`,
+		Long:  ``,
+	}
+
+	cmdutils.EnableRepoOverride(milestoneCmd, f)
+
+	milestoneCmd.AddCommand(milestoneListCmd.NewCmdList(f))
+	milestoneCmd.AddCommand(milestoneCreateCmd.NewCmdCreate(f))
+	milestoneCmd.AddCommand(milestoneViewCmd.NewCmdView(f))
+	milestoneCmd.AddCommand(milestoneUpdateCmd.NewCmdUpdate(f))
+	milestoneCmd.AddCommand(milestoneCloseCmd.NewCmdClose(f))
+	milestoneCmd.AddCommand(milestoneReportCmd.NewCmdReport(f))
+	return milestoneCmd
+}
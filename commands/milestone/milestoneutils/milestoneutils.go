@@ -0,0 +1,88 @@
+package milestoneutils
+
+import (
+	"fmt"
+	"strconv"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+)
+
+// FromGroupMilestone converts a group milestone, so that project and group
+// milestones can be handled the same way.
+func FromGroupMilestone(m *gitlab.GroupMilestone) *gitlab.Milestone {
+	return &gitlab.Milestone{
+		ID:          m.ID,
+		IID:         m.IID,
+		GroupID:     m.GroupID,
+		Title:       m.Title,
+		Description: m.Description,
+		StartDate:   m.StartDate,
+		DueDate:     m.DueDate,
+		State:       m.State,
+		UpdatedAt:   m.UpdatedAt,
+		CreatedAt:   m.CreatedAt,
+		Expired:     m.Expired,
+	}
+}
+
+// Get returns the milestone with the given ID or title in the scope.
+func Get(client *gitlab.Client, scope cmdutils.Scope, milestone string) (*gitlab.Milestone, error) {
+	id, err := strconv.Atoi(milestone)
+	if err != nil {
+		if scope.IsGroup() {
+			m, err := api.GroupMilestoneByTitle(client, scope.Group, milestone)
+			if err != nil {
+				return nil, err
+			}
+			return FromGroupMilestone(m), nil
+		}
+		return api.ProjectMilestoneByTitle(client, scope.Project, milestone)
+	}
+
+	if scope.IsGroup() {
+		m, err := api.GetGroupMilestone(client, scope.Group, id)
+		if err != nil {
+			return nil, err
+		}
+		return FromGroupMilestone(m), nil
+	}
+	return api.GetProjectMilestone(client, scope.Project, id)
+}
+
+// Update updates the milestone with the given ID in the scope.
+func Update(client *gitlab.Client, scope cmdutils.Scope, id int, opts *gitlab.UpdateMilestoneOptions) (*gitlab.Milestone, error) {
+	if scope.IsGroup() {
+		m, err := api.UpdateGroupMilestone(client, scope.Group, id, &gitlab.UpdateGroupMilestoneOptions{
+			Title:       opts.Title,
+			Description: opts.Description,
+			StartDate:   opts.StartDate,
+			DueDate:     opts.DueDate,
+			StateEvent:  opts.StateEvent,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return FromGroupMilestone(m), nil
+	}
+	return api.UpdateProjectMilestone(client, scope.Project, id, opts)
+}
+
+// ParseDate parses the value of a date flag, in the YYYY-MM-DD format.
+func ParseDate(value, name string) (*gitlab.ISOTime, error) {
+	date, err := gitlab.ParseISOTime(value)
+	if err != nil {
+		return nil, &cmdutils.FlagError{Err: fmt.Errorf("invalid --%s %q: use the YYYY-MM-DD format.", name, value)}
+	}
+	return &date, nil
+}
+
+// FormatDate returns the date in the YYYY-MM-DD format, or an empty string for no date.
+func FormatDate(date *gitlab.ISOTime) string {
+	if date == nil {
+		return ""
+	}
+	return date.String()
+}
@@ -0,0 +1,294 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"golang.org/x/sync/errgroup"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/milestone/milestoneutils"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+const progressBarWidth = 30
+
+// now is replaced in tests.
+var now = time.Now
+
+type report struct {
+	Milestone     *gitlab.Milestone `json:"milestone"`
+	Issues        issueCounts       `json:"issues"`
+	MergeRequests mrCounts          `json:"merge_requests"`
+	Weight        weightCounts      `json:"weight"`
+	Time          timeCounts        `json:"time"`
+	// Progress is the percentage of closed issues, counted by weight when the issues are weighted.
+	Progress float64       `json:"progress"`
+	Overdue  []overdueItem `json:"overdue"`
+}
+
+type issueCounts struct {
+	Total  int `json:"total"`
+	Open   int `json:"open"`
+	Closed int `json:"closed"`
+}
+
+type mrCounts struct {
+	Total  int `json:"total"`
+	Open   int `json:"open"`
+	Merged int `json:"merged"`
+	Closed int `json:"closed"`
+}
+
+type weightCounts struct {
+	Total  int `json:"total"`
+	Closed int `json:"closed"`
+}
+
+// timeCounts are the time estimates and the time spent, in seconds.
+type timeCounts struct {
+	Estimate int `json:"estimate"`
+	Spent    int `json:"spent"`
+}
+
+// overdueItem is an open issue or merge request past its due date, or past the due date of the milestone.
+type overdueItem struct {
+	Type      string `json:"type"`
+	Reference string `json:"reference"`
+	Title     string `json:"title"`
+	DueDate   string `json:"due_date"`
+	WebURL    string `json:"web_url"`
+}
+
+func NewCmdReport(f *cmdutils.Factory) *cobra.Command {
+	var outputFormat string
+
+	milestoneReportCmd := &cobra.Command{
+		Use:   "report <milestone> [flags]",
+		Short: `Show the progress of a milestone.`,
+		Long: heredoc.Doc(`
+			Show the progress of a milestone, by ID or title: the open and closed issues
+			and merge requests, the total and closed weight, the time estimates and the
+			time spent, and the open issues and merge requests that are overdue.
+
+			An item is overdue if it is open and past its own due date or, if it has none,
+			past the due date of the milestone.
+		`),
+		Example: heredoc.Doc(`
+			glab milestone report v1.0
+			glab milestone report "Q3 2025" --group my-group --output json
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			scope, err := cmdutils.ScopeFromCmd(cmd, f)
+			if err != nil {
+				return err
+			}
+
+			milestone, err := milestoneutils.Get(apiClient, scope, args[0])
+			if err != nil {
+				return err
+			}
+
+			var issues []*gitlab.Issue
+			var mrs []*gitlab.MergeRequest
+			errGroup := &errgroup.Group{}
+			errGroup.Go(func() error {
+				var err error
+				if scope.IsGroup() {
+					issues, err = api.ListGroupMilestoneIssues(apiClient, scope.Group, milestone.ID)
+				} else {
+					issues, err = api.ListProjectMilestoneIssues(apiClient, scope.Project, milestone.ID)
+				}
+				return err
+			})
+			errGroup.Go(func() error {
+				var err error
+				if scope.IsGroup() {
+					mrs, err = api.ListGroupMilestoneMergeRequests(apiClient, scope.Group, milestone.ID)
+				} else {
+					mrs, err = api.ListProjectMilestoneMergeRequests(apiClient, scope.Project, milestone.ID)
+				}
+				return err
+			})
+			if err := errGroup.Wait(); err != nil {
+				return fmt.Errorf("failed to get the issues and merge requests of milestone %q: %w", milestone.Title, err)
+			}
+
+			r := buildReport(milestone, issues, mrs, now())
+
+			if outputFormat == "json" {
+				reportJSON, _ := json.Marshal(r)
+				fmt.Fprintln(f.IO.StdOut, string(reportJSON))
+				return nil
+			}
+
+			fmt.Fprint(f.IO.StdOut, formatReport(f.IO, r, now()))
+			return nil
+		},
+	}
+
+	cmdutils.EnableGroupFlag(milestoneReportCmd, "milestones")
+	milestoneReportCmd.Flags().StringVarP(&outputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return milestoneReportCmd
+}
+
+func buildReport(milestone *gitlab.Milestone, issues []*gitlab.Issue, mrs []*gitlab.MergeRequest, today time.Time) *report {
+	r := &report{Milestone: milestone, Overdue: []overdueItem{}}
+
+	for _, issue := range issues {
+		r.Issues.Total++
+		r.Weight.Total += issue.Weight
+		if issue.State == "closed" {
+			r.Issues.Closed++
+			r.Weight.Closed += issue.Weight
+		} else {
+			r.Issues.Open++
+			if due := dueDate(issue.DueDate, milestone); isOverdue(due, today) {
+				r.Overdue = append(r.Overdue, overdueItem{
+					Type:      "issue",
+					Reference: reference(issue.References, "#", issue.IID),
+					Title:     issue.Title,
+					DueDate:   due.String(),
+					WebURL:    issue.WebURL,
+				})
+			}
+		}
+		if issue.TimeStats != nil {
+			r.Time.Estimate += issue.TimeStats.TimeEstimate
+			r.Time.Spent += issue.TimeStats.TotalTimeSpent
+		}
+	}
+
+	for _, mr := range mrs {
+		r.MergeRequests.Total++
+		switch mr.State {
+		case "merged":
+			r.MergeRequests.Merged++
+		case "closed":
+			r.MergeRequests.Closed++
+		default:
+			r.MergeRequests.Open++
+			if due := dueDate(nil, milestone); isOverdue(due, today) {
+				r.Overdue = append(r.Overdue, overdueItem{
+					Type:      "merge_request",
+					Reference: reference(mr.References, "!", mr.IID),
+					Title:     mr.Title,
+					DueDate:   due.String(),
+					WebURL:    mr.WebURL,
+				})
+			}
+		}
+		if mr.TimeStats != nil {
+			r.Time.Estimate += mr.TimeStats.TimeEstimate
+			r.Time.Spent += mr.TimeStats.TotalTimeSpent
+		}
+	}
+
+	switch {
+	case r.Weight.Total > 0:
+		r.Progress = percent(r.Weight.Closed, r.Weight.Total)
+	case r.Issues.Total > 0:
+		r.Progress = percent(r.Issues.Closed, r.Issues.Total)
+	}
+
+	return r
+}
+
+// dueDate returns the due date of an item, or the due date of its milestone if it has none.
+func dueDate(itemDueDate *gitlab.ISOTime, milestone *gitlab.Milestone) *gitlab.ISOTime {
+	if itemDueDate != nil {
+		return itemDueDate
+	}
+	return milestone.DueDate
+}
+
+func isOverdue(due *gitlab.ISOTime, today time.Time) bool {
+	if due == nil {
+		return false
+	}
+	y, m, d := today.Date()
+	return time.Time(*due).Before(time.Date(y, m, d, 0, 0, 0, 0, time.UTC))
+}
+
+// reference returns the full reference of an item of a group milestone, which
+// can belong to any project of the group, or the short reference otherwise.
+func reference(refs *gitlab.IssueReferences, prefix string, iid int) string {
+	if refs != nil && refs.Full != "" {
+		return refs.Full
+	}
+	return fmt.Sprintf("%s%d", prefix, iid)
+}
+
+func percent(part, total int) float64 {
+	return float64(part*100) / float64(total)
+}
+
+func formatReport(io *iostreams.IOStreams, r *report, today time.Time) string {
+	c := io.Color()
+	m := r.Milestone
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s (%s)\n", c.Bold(m.Title), m.State)
+	if m.StartDate != nil || m.DueDate != nil {
+		fmt.Fprintf(&b, "%s → %s", orNone(m.StartDate), orNone(m.DueDate))
+		if m.DueDate != nil && m.State == "active" {
+			y, mo, d := today.Date()
+			days := int(time.Time(*m.DueDate).Sub(time.Date(y, mo, d, 0, 0, 0, 0, time.UTC)).Hours() / 24)
+			switch {
+			case days > 0:
+				fmt.Fprintf(&b, " (%d days left)", days)
+			case days == 0:
+				fmt.Fprint(&b, " (due today)")
+			default:
+				fmt.Fprint(&b, c.Red(fmt.Sprintf(" (%d days overdue)", -days)))
+			}
+		}
+		fmt.Fprintln(&b)
+	}
+
+	fmt.Fprintf(&b, "\n%s %.0f%%\n\n", progressBar(r.Progress), r.Progress)
+
+	fmt.Fprintf(&b, "Issues:\t\t%d open, %d closed, %d total\n", r.Issues.Open, r.Issues.Closed, r.Issues.Total)
+	fmt.Fprintf(&b, "Merge requests:\t%d open, %d merged, %d closed, %d total\n", r.MergeRequests.Open, r.MergeRequests.Merged, r.MergeRequests.Closed, r.MergeRequests.Total)
+	if r.Weight.Total > 0 {
+		fmt.Fprintf(&b, "Weight:\t\t%d of %d closed\n", r.Weight.Closed, r.Weight.Total)
+	}
+	if r.Time.Estimate > 0 || r.Time.Spent > 0 {
+		fmt.Fprintf(&b, "Time:\t\t%s spent of %s estimated\n", utils.FormatSeconds(r.Time.Spent), utils.FormatSeconds(r.Time.Estimate))
+	}
+
+	if len(r.Overdue) > 0 {
+		fmt.Fprintf(&b, "\n%s\n", c.Red(fmt.Sprintf("Overdue (%d):", len(r.Overdue))))
+		for _, item := range r.Overdue {
+			fmt.Fprintf(&b, "  %s %s (due %s)\n", item.Reference, item.Title, item.DueDate)
+		}
+	}
+
+	return b.String()
+}
+
+func orNone(date *gitlab.ISOTime) string {
+	if date == nil {
+		return "none"
+	}
+	return date.String()
+}
+
+func progressBar(progress float64) string {
+	done := int(progress * progressBarWidth / 100)
+	return "[" + strings.Repeat("#", done) + strings.Repeat("-", progressBarWidth-done) + "]"
+}
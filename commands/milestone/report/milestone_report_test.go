@@ -0,0 +1,118 @@
+package report
+
+import (
+	"testing"
+	"time"
+
+	"github.com/acarl005/stripansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+func isoDate(t *testing.T, s string) *gitlab.ISOTime {
+	t.Helper()
+	date, err := gitlab.ParseISOTime(s)
+	require.NoError(t, err)
+	return &date
+}
+
+func Test_buildReport(t *testing.T) {
+	today := time.Date(2025, 7, 1, 15, 0, 0, 0, time.UTC)
+	milestone := &gitlab.Milestone{ID: 1, Title: "v1.0", State: "active", DueDate: isoDate(t, "2025-06-30")}
+
+	issues := []*gitlab.Issue{
+		{IID: 1, Title: "Done", State: "closed", Weight: 3, TimeStats: &gitlab.TimeStats{TimeEstimate: 7200, TotalTimeSpent: 5400}},
+		{IID: 2, Title: "Late", State: "opened", Weight: 1, TimeStats: &gitlab.TimeStats{TimeEstimate: 3600}},
+		{IID: 3, Title: "Later", State: "opened", Weight: 2, DueDate: isoDate(t, "2025-08-01")},
+	}
+	mrs := []*gitlab.MergeRequest{
+		{IID: 4, Title: "Merged", State: "merged"},
+		{IID: 5, Title: "Open", State: "opened"},
+		{IID: 6, Title: "Dropped", State: "closed"},
+	}
+
+	r := buildReport(milestone, issues, mrs, today)
+
+	assert.Equal(t, issueCounts{Total: 3, Open: 2, Closed: 1}, r.Issues)
+	assert.Equal(t, mrCounts{Total: 3, Open: 1, Merged: 1, Closed: 1}, r.MergeRequests)
+	assert.Equal(t, weightCounts{Total: 6, Closed: 3}, r.Weight)
+	assert.Equal(t, timeCounts{Estimate: 10800, Spent: 5400}, r.Time)
+	assert.Equal(t, 50.0, r.Progress)
+	assert.Equal(t, []overdueItem{
+		{Type: "issue", Reference: "#2", Title: "Late", DueDate: "2025-06-30"},
+		{Type: "merge_request", Reference: "!5", Title: "Open", DueDate: "2025-06-30"},
+	}, r.Overdue)
+}
+
+func Test_buildReport_withoutWeights(t *testing.T) {
+	milestone := &gitlab.Milestone{ID: 1, Title: "v1.0", State: "active"}
+	issues := []*gitlab.Issue{
+		{IID: 1, State: "closed"},
+		{IID: 2, State: "closed"},
+		{IID: 3, State: "opened"},
+		{IID: 4, State: "opened"},
+	}
+
+	r := buildReport(milestone, issues, nil, time.Now())
+
+	assert.Equal(t, 50.0, r.Progress)
+	assert.Empty(t, r.Overdue)
+}
+
+func TestNewCmdReport(t *testing.T) {
+	now = func() time.Time { return time.Date(2025, 6, 20, 12, 0, 0, 0, time.UTC) }
+	t.Cleanup(func() { now = time.Now })
+
+	api.ProjectMilestoneByTitle = func(client *gitlab.Client, projectID interface{}, name string) (*gitlab.Milestone, error) {
+		return &gitlab.Milestone{
+			ID:        7,
+			Title:     name,
+			State:     "active",
+			StartDate: isoDate(t, "2025-06-01"),
+			DueDate:   isoDate(t, "2025-06-30"),
+		}, nil
+	}
+	api.ListProjectMilestoneIssues = func(client *gitlab.Client, projectID interface{}, milestoneID int) ([]*gitlab.Issue, error) {
+		return []*gitlab.Issue{
+			{IID: 1, Title: "Done", State: "closed", Weight: 1, TimeStats: &gitlab.TimeStats{TimeEstimate: 3600, TotalTimeSpent: 1800}},
+			{IID: 2, Title: "Late", State: "opened", Weight: 3, DueDate: isoDate(t, "2025-06-10")},
+		}, nil
+	}
+	api.ListProjectMilestoneMergeRequests = func(client *gitlab.Client, projectID interface{}, milestoneID int) ([]*gitlab.MergeRequest, error) {
+		return []*gitlab.MergeRequest{
+			{IID: 3, Title: "Fix", State: "merged"},
+		}, nil
+	}
+
+	io, _, stdout, _ := iostreams.Test()
+	f := cmdtest.StubFactory("https://gitlab.com/cli-automated-testing/test")
+	f.IO = io
+
+	cmd := NewCmdReport(f)
+	cmdutils.EnableRepoOverride(cmd, f)
+
+	_, err := cmdtest.RunCommand(cmd, "v1.0")
+	require.NoError(t, err)
+
+	out := stripansi.Strip(stdout.String())
+	assert.Contains(t, out, "v1.0 (active)")
+	assert.Contains(t, out, "2025-06-01 → 2025-06-30 (10 days left)")
+	assert.Contains(t, out, "[#######-----------------------] 25%")
+	assert.Contains(t, out, "Issues:\t\t1 open, 1 closed, 2 total")
+	assert.Contains(t, out, "Merge requests:\t0 open, 1 merged, 0 closed, 1 total")
+	assert.Contains(t, out, "Weight:\t\t1 of 4 closed")
+	assert.Contains(t, out, "Time:\t\t30m spent of 1h estimated")
+	assert.Contains(t, out, "Overdue (1):\n  #2 Late (due 2025-06-10)")
+
+	stdout.Reset()
+	_, err = cmdtest.RunCommand(cmd, "v1.0 --output json")
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), `"issues":{"total":2,"open":1,"closed":1}`)
+	assert.Contains(t, stdout.String(), `"progress":25`)
+}
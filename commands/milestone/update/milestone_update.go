@@ -0,0 +1,114 @@
+package update
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/milestone/milestoneutils"
+)
+
+type options struct {
+	title       string
+	description string
+	startDate   string
+	dueDate     string
+	reopen      bool
+}
+
+func NewCmdUpdate(f *cmdutils.Factory) *cobra.Command {
+	opts := &options{}
+
+	milestoneUpdateCmd := &cobra.Command{
+		Use:     "update <milestone> [flags]",
+		Short:   `Update a milestone, by ID or title.`,
+		Aliases: []string{"edit"},
+		Example: heredoc.Doc(`
+			glab milestone update v1.0 --due-date 2025-07-15
+			glab milestone update 42 --title v1.1 --description "Bug fixes"
+			glab milestone update v1.0 --reopen
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			updateOpts, err := opts.updateOptions(cmd)
+			if err != nil {
+				return err
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			scope, err := cmdutils.ScopeFromCmd(cmd, f)
+			if err != nil {
+				return err
+			}
+
+			milestone, err := milestoneutils.Get(apiClient, scope, args[0])
+			if err != nil {
+				return err
+			}
+
+			milestone, err = milestoneutils.Update(apiClient, scope, milestone.ID, updateOpts)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(f.IO.StdOut, "%s Updated milestone %q.\n", f.IO.Color().GreenCheck(), milestone.Title)
+			return nil
+		},
+	}
+
+	cmdutils.EnableGroupFlag(milestoneUpdateCmd, "milestones")
+	milestoneUpdateCmd.Flags().StringVarP(&opts.title, "title", "t", "", "New title of the milestone.")
+	milestoneUpdateCmd.Flags().StringVarP(&opts.description, "description", "d", "", "New description of the milestone.")
+	milestoneUpdateCmd.Flags().StringVar(&opts.startDate, "start-date", "", "New start date of the milestone, in the YYYY-MM-DD format.")
+	milestoneUpdateCmd.Flags().StringVar(&opts.dueDate, "due-date", "", "New due date of the milestone, in the YYYY-MM-DD format.")
+	milestoneUpdateCmd.Flags().BoolVar(&opts.reopen, "reopen", false, "Reopen a closed milestone.")
+
+	return milestoneUpdateCmd
+}
+
+// updateOptions returns the options to update a milestone with the flags set on cmd.
+func (opts *options) updateOptions(cmd *cobra.Command) (*gitlab.UpdateMilestoneOptions, error) {
+	updateOpts := &gitlab.UpdateMilestoneOptions{}
+	changed := false
+
+	if cmd.Flags().Changed("title") {
+		updateOpts.Title = gitlab.Ptr(opts.title)
+		changed = true
+	}
+	if cmd.Flags().Changed("description") {
+		updateOpts.Description = gitlab.Ptr(opts.description)
+		changed = true
+	}
+
+	var err error
+	if cmd.Flags().Changed("start-date") {
+		if updateOpts.StartDate, err = milestoneutils.ParseDate(opts.startDate, "start-date"); err != nil {
+			return nil, err
+		}
+		changed = true
+	}
+	if cmd.Flags().Changed("due-date") {
+		if updateOpts.DueDate, err = milestoneutils.ParseDate(opts.dueDate, "due-date"); err != nil {
+			return nil, err
+		}
+		changed = true
+	}
+	if opts.reopen {
+		updateOpts.StateEvent = gitlab.Ptr("activate")
+		changed = true
+	}
+
+	if !changed {
+		return nil, &cmdutils.FlagError{Err: errors.New("specify at least one of --title, --description, --start-date, --due-date, or --reopen.")}
+	}
+
+	return updateOpts, nil
+}
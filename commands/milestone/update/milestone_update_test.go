@@ -0,0 +1,87 @@
+package update
+
+import (
+	"testing"
+
+	"github.com/acarl005/stripansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+func TestNewCmdUpdate(t *testing.T) {
+	dueDate, _ := gitlab.ParseISOTime("2025-07-15")
+
+	var got *gitlab.UpdateMilestoneOptions
+	api.ProjectMilestoneByTitle = func(client *gitlab.Client, projectID interface{}, name string) (*gitlab.Milestone, error) {
+		return &gitlab.Milestone{ID: 7, Title: name}, nil
+	}
+	api.UpdateProjectMilestone = func(client *gitlab.Client, projectID interface{}, milestoneID int, opts *gitlab.UpdateMilestoneOptions) (*gitlab.Milestone, error) {
+		got = opts
+		title := "v1.0"
+		if opts.Title != nil {
+			title = *opts.Title
+		}
+		return &gitlab.Milestone{ID: milestoneID, Title: title}, nil
+	}
+
+	tests := []struct {
+		name     string
+		arg      string
+		want     string
+		wantOpts *gitlab.UpdateMilestoneOptions
+		wantErr  string
+	}{
+		{
+			name:     "due date",
+			arg:      "v1.0 --due-date 2025-07-15",
+			want:     `Updated milestone "v1.0".`,
+			wantOpts: &gitlab.UpdateMilestoneOptions{DueDate: &dueDate},
+		},
+		{
+			name:     "rename and reopen",
+			arg:      "v1.0 --title v1.1 --reopen",
+			want:     `Updated milestone "v1.1".`,
+			wantOpts: &gitlab.UpdateMilestoneOptions{Title: gitlab.Ptr("v1.1"), StateEvent: gitlab.Ptr("activate")},
+		},
+		{
+			name:    "invalid date",
+			arg:     "v1.0 --due-date 15/07/2025",
+			wantErr: `invalid --due-date "15/07/2025": use the YYYY-MM-DD format.`,
+		},
+		{
+			name:    "no flags",
+			arg:     "v1.0",
+			wantErr: "specify at least one of --title, --description, --start-date, --due-date, or --reopen.",
+		},
+	}
+
+	io, _, stdout, _ := iostreams.Test()
+	f := cmdtest.StubFactory("https://gitlab.com/cli-automated-testing/test")
+	f.IO = io
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stdout.Reset()
+			got = nil
+
+			cmd := NewCmdUpdate(f)
+			cmdutils.EnableRepoOverride(cmd, f)
+
+			_, err := cmdtest.RunCommand(cmd, tc.arg)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Contains(t, stripansi.Strip(stdout.String()), tc.want)
+			assert.Equal(t, tc.wantOpts, got)
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/milestone/milestoneutils"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+type options struct {
+	web          bool
+	outputFormat string
+}
+
+func NewCmdView(f *cmdutils.Factory) *cobra.Command {
+	opts := &options{}
+
+	milestoneViewCmd := &cobra.Command{
+		Use:     "view <milestone> [flags]",
+		Short:   `Display the details of a milestone, by ID or title.`,
+		Aliases: []string{"show"},
+		Example: heredoc.Doc(`
+			glab milestone view v1.0
+			glab milestone view 42 --web
+			glab milestone view v1.0 --group my-group --output json
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			scope, err := cmdutils.ScopeFromCmd(cmd, f)
+			if err != nil {
+				return err
+			}
+
+			milestone, err := milestoneutils.Get(apiClient, scope, args[0])
+			if err != nil {
+				return err
+			}
+
+			cfg, _ := f.Config()
+
+			if opts.web {
+				if milestone.WebURL == "" {
+					return fmt.Errorf("milestone %q has no web URL.", milestone.Title)
+				}
+				if f.IO.IsOutputTTY() {
+					fmt.Fprintf(f.IO.StdErr, "Opening %s in your browser.\n", milestone.WebURL)
+				}
+				browser, _ := cfg.Get(apiClient.BaseURL().Host, "browser")
+				return utils.OpenInBrowser(milestone.WebURL, browser)
+			}
+
+			if opts.outputFormat == "json" {
+				milestoneJSON, _ := json.Marshal(milestone)
+				fmt.Fprintln(f.IO.StdOut, string(milestoneJSON))
+				return nil
+			}
+
+			glamourStyle, _ := cfg.Get(apiClient.BaseURL().Host, "glamour_style")
+			fmt.Fprint(f.IO.StdOut, displayMilestone(f.IO, milestone, glamourStyle))
+			return nil
+		},
+	}
+
+	cmdutils.EnableGroupFlag(milestoneViewCmd, "milestones")
+	milestoneViewCmd.Flags().BoolVarP(&opts.web, "web", "w", false, "Open the milestone in the browser.")
+	milestoneViewCmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return milestoneViewCmd
+}
+
+func displayMilestone(io *iostreams.IOStreams, m *gitlab.Milestone, glamourStyle string) string {
+	c := io.Color()
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s\n", c.Bold(m.Title))
+	fmt.Fprintf(&b, "State:\t\t%s\n", m.State)
+	if m.StartDate != nil {
+		fmt.Fprintf(&b, "Start date:\t%s\n", m.StartDate)
+	}
+	if m.DueDate != nil {
+		due := m.DueDate.String()
+		if m.State == "active" && m.Expired != nil && *m.Expired {
+			due = c.Red(due + " (expired)")
+		}
+		fmt.Fprintf(&b, "Due date:\t%s\n", due)
+	}
+	if m.CreatedAt != nil {
+		fmt.Fprintf(&b, "Created:\t%s\n", utils.TimeToPrettyTimeAgo(*m.CreatedAt))
+	}
+
+	if m.Description != "" {
+		description, err := utils.RenderMarkdown(m.Description, glamourStyle)
+		if err != nil {
+			description = m.Description
+		}
+		fmt.Fprintf(&b, "\n%s\n", strings.TrimRight(description, "\n"))
+	}
+
+	if m.WebURL != "" {
+		fmt.Fprintf(&b, "\n%s\n", c.Gray(m.WebURL))
+	}
+
+	return b.String()
+}
@@ -42,6 +42,9 @@ type CreateOpts struct {
 	MilestoneFlag         string   `json:"milestone_flag,omitempty"`
 	MRCreateTargetProject string   `json:"mr_create_target_project,omitempty"`
 
+	Template        string `json:"-"`
+	RemoteTemplates bool   `json:"-"`
+
 	RelatedIssue    string `json:"related_issue,omitempty"`
 	CopyIssueLabels bool   `json:"copy_issue_labels,omitempty"`
 
@@ -99,6 +102,7 @@ func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
 			glab mr create -f --draft --label RFC
 			glab mr create --fill --web
 			glab mr create --fill --fill-commit-body --yes
+			glab mr create --fill --template Default --yes
 		`),
 		Args: cobra.ExactArgs(0),
 		PreRun: func(cmd *cobra.Command, args []string) {
@@ -120,7 +124,7 @@ func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
 			opts.Lab = f.HttpClient
 
 			hasTitle := cmd.Flags().Changed("title")
-			hasDescription := cmd.Flags().Changed("description")
+			hasDescription := cmd.Flags().Changed("description") || cmd.Flags().Changed("template")
 
 			// disable interactive mode if title and description are explicitly defined
 			opts.IsInteractive = !(hasTitle && hasDescription)
@@ -149,6 +153,13 @@ func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
 				return &cmdutils.FlagError{Err: errors.New("--copy-issue-labels can only be used with --related-issue.")}
 			}
 
+			// with --repo, the templates of the working directory are not the templates of the project
+			opts.RemoteTemplates = cmdutils.IsRepoOverridden(cmd)
+
+			if err := configDefaults(cmd, opts); err != nil {
+				return err
+			}
+
 			if err := createRun(opts); err != nil {
 				// always save options to file
 				recoverErr := createRecoverSaveFile(opts)
@@ -187,6 +198,8 @@ func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
 	mrCreateCmd.Flags().StringVarP(&opts.RelatedIssue, "related-issue", "i", "", "Create a merge request for an issue. If --title is not provided, uses the issue title.")
 	mrCreateCmd.Flags().BoolVar(&opts.Recover, "recover", false, "Save the options to a file if the merge request creation fails. If the file exists, the options are loaded from the recovery file. (EXPERIMENTAL.)")
 	mrCreateCmd.Flags().BoolVar(&opts.Signoff, "signoff", false, "Append a DCO signoff to the merge request description.")
+	mrCreateCmd.Flags().StringVar(&opts.Template, "template", "", "Use this description template, from '.gitlab/merge_request_templates', or from the templates of the project, its group, or the instance. Its quick actions, like '/label', set the metadata of the merge request.")
+	mrCreateCmd.MarkFlagsMutuallyExclusive("template", "description")
 
 	mrCreateCmd.Flags().StringVarP(&opts.MRCreateTargetProject, "target-project", "", "", "Add target project by id, OWNER/REPO, or GROUP/NAMESPACE/REPO.")
 	_ = mrCreateCmd.Flags().MarkHidden("target-project")
@@ -195,6 +208,38 @@ func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
 	return mrCreateCmd
 }
 
+// configDefaults sets the labels and the reviewers from the mr_labels and mr_reviewers
+// settings, unless they are set with flags.
+func configDefaults(cmd *cobra.Command, opts *CreateOpts) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	var hostname string
+	if repo, err := opts.BaseRepo(); err == nil {
+		hostname = repo.RepoHost()
+	}
+
+	for flag, setting := range map[string]struct {
+		key    string
+		values *[]string
+	}{
+		"label":    {"mr_labels", &opts.Labels},
+		"reviewer": {"mr_reviewers", &opts.Reviewers},
+	} {
+		if cmd.Flags().Changed(flag) {
+			continue
+		}
+		value, _ := cfg.Get(hostname, setting.key)
+		for _, v := range strings.Split(value, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				*setting.values = append(*setting.values, v)
+			}
+		}
+	}
+	return nil
+}
+
 func parseIssue(apiClient *gitlab.Client, opts *CreateOpts) (*gitlab.Issue, error) {
 	issue, _, err := issueutils.IssueFromArg(apiClient, opts.BaseRepo, opts.RelatedIssue)
 	if err != nil {
@@ -302,6 +347,17 @@ func createRun(opts *CreateOpts) error {
 		}
 	}
 
+	// set when the description comes from a description template
+	var fromTemplate bool
+	// a recovered description already comes from the template
+	if opts.Template != "" && opts.Description == "" {
+		fromTemplate = true
+		opts.Description, err = cmdutils.LoadTemplate(labClient, baseRepo, cmdutils.MergeRequestTemplate, opts.Template, opts.RemoteTemplates)
+		if err != nil {
+			return err
+		}
+	}
+
 	if opts.CreateSourceBranch && opts.SourceBranch == "" {
 		opts.SourceBranch = utils.ReplaceNonAlphaNumericChars(opts.Title, "-")
 	} else if opts.SourceBranch == "" && opts.RelatedIssue == "" {
@@ -383,7 +439,7 @@ func createRun(opts *CreateOpts) error {
 					templateResponse := struct {
 						Index int
 					}{}
-					templateNames, err := cmdutils.ListGitLabTemplates(cmdutils.MergeRequestTemplate)
+					templateNames, err := cmdutils.ListTemplates(labClient, baseRepo, cmdutils.MergeRequestTemplate, opts.RemoteTemplates)
 					if err != nil {
 						return fmt.Errorf("error getting templates: %w", err)
 					}
@@ -430,10 +486,11 @@ func createRun(opts *CreateOpts) error {
 							templateContents += "Signed-off-by: " + u.Name + "<" + u.Email + ">"
 						}
 					} else {
-						templateContents, err = cmdutils.LoadGitLabTemplate(cmdutils.MergeRequestTemplate, templateName)
+						templateContents, err = cmdutils.LoadTemplate(labClient, baseRepo, cmdutils.MergeRequestTemplate, templateName, opts.RemoteTemplates)
 						if err != nil {
 							return fmt.Errorf("failed to get template contents: %w", err)
 						}
+						fromTemplate = true
 					}
 				}
 			}
@@ -468,6 +525,12 @@ func createRun(opts *CreateOpts) error {
 		return fmt.Errorf("title can't be blank.")
 	}
 
+	if fromTemplate {
+		if err := applyQuickActions(labClient, baseRepo, opts); err != nil {
+			return err
+		}
+	}
+
 	if opts.IsDraft || opts.IsWIP {
 		if opts.IsDraft {
 			opts.Title = "Draft: " + opts.Title
@@ -664,6 +727,38 @@ func mrBody(commits []*git.Commit, fillCommitBody bool) (string, error) {
 	return body.String(), nil
 }
 
+// applyQuickActions removes the quick actions setting metadata from the description, which
+// comes from a template, and adds their metadata to the metadata set with the flags.
+func applyQuickActions(labClient *gitlab.Client, repo glrepo.Interface, opts *CreateOpts) error {
+	var qa cmdutils.QuickActions
+	opts.Description, qa = cmdutils.ParseQuickActions(opts.Description, cmdutils.MergeRequestTemplate)
+
+	for _, label := range qa.Labels {
+		if !utils.PresentInStringSlice(opts.Labels, label) {
+			opts.Labels = append(opts.Labels, label)
+		}
+	}
+	for _, assignee := range qa.Assignees {
+		if !utils.PresentInStringSlice(opts.Assignees, assignee) {
+			opts.Assignees = append(opts.Assignees, assignee)
+		}
+	}
+	for _, reviewer := range qa.Reviewers {
+		if !utils.PresentInStringSlice(opts.Reviewers, reviewer) {
+			opts.Reviewers = append(opts.Reviewers, reviewer)
+		}
+	}
+	if qa.Milestone != "" && opts.Milestone == 0 {
+		milestone, err := cmdutils.ParseMilestone(labClient, repo, qa.Milestone)
+		if err != nil {
+			return err
+		}
+		opts.Milestone = milestone
+	}
+	opts.IsDraft = opts.IsDraft || (qa.Draft && !opts.IsWIP)
+	return nil
+}
+
 func mrBodyAndTitle(opts *CreateOpts) error {
 	// TODO: detect forks
 	commits, err := git.Commits(opts.TargetTrackingBranch, opts.SourceBranch)
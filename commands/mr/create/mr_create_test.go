@@ -16,6 +16,7 @@ import (
 	"github.com/stretchr/testify/require"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
 	"gitlab.com/gitlab-org/cli/internal/glrepo"
 	"gitlab.com/gitlab-org/cli/pkg/git"
 	"gitlab.com/gitlab-org/cli/pkg/httpmock"
@@ -650,3 +651,49 @@ func Test_MRCreate_With_Recover_Integration(t *testing.T) {
 	assert.Contains(t, newOutput.Stderr(), "\nCreating merge request for feat-new-mr into master in OWNER/REPO\n\n")
 	assert.Contains(t, newOutput.String(), "https://gitlab.com/OWNER/REPO/-/merge_requests/12")
 }
+
+func Test_configDefaults(t *testing.T) {
+	cfg := config.NewFromString(heredoc.Doc(`
+		mr_labels: backend, needs-review
+		mr_reviewers: alice,bob
+	`))
+
+	tests := []struct {
+		name          string
+		args          []string
+		wantLabels    []string
+		wantReviewers []string
+	}{
+		{
+			name:          "from settings",
+			wantLabels:    []string{"backend", "needs-review"},
+			wantReviewers: []string{"alice", "bob"},
+		},
+		{
+			name:          "flags override settings",
+			args:          []string{"--label", "bug", "--reviewer", "carol"},
+			wantLabels:    []string{"bug"},
+			wantReviewers: []string{"carol"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := cmdtest.InitIOStreams(false, "")
+			factory := cmdtest.InitFactory(ios, nil)
+			factory.Config = func() (config.Config, error) {
+				return cfg, nil
+			}
+
+			cmd := NewCmdCreate(factory)
+			require.NoError(t, cmd.ParseFlags(tt.args))
+
+			opts := &CreateOpts{Config: factory.Config, BaseRepo: factory.BaseRepo}
+			opts.Labels, _ = cmd.Flags().GetStringSlice("label")
+			opts.Reviewers, _ = cmd.Flags().GetStringSlice("reviewer")
+
+			require.NoError(t, configDefaults(cmd, opts))
+			assert.Equal(t, tt.wantLabels, opts.Labels)
+			assert.Equal(t, tt.wantReviewers, opts.Reviewers)
+		})
+	}
+}
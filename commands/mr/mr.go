@@ -3,6 +3,7 @@ package mr
 import (
 	"github.com/MakeNowJust/heredoc/v2"
 	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/issuable/timetracking"
 	mrApproveCmd "gitlab.com/gitlab-org/cli/commands/mr/approve"
 	mrApproversCmd "gitlab.com/gitlab-org/cli/commands/mr/approvers"
 	mrCheckoutCmd "gitlab.com/gitlab-org/cli/commands/mr/checkout"
@@ -65,6 +66,7 @@ func NewCmdMR(f *cmdutils.Factory) *cobra.Command {
 	mrCmd.AddCommand(mrRevokeCmd.NewCmdRevoke(f))
 	mrCmd.AddCommand(mrSubscribeCmd.NewCmdSubscribe(f))
 	mrCmd.AddCommand(mrUnsubscribeCmd.NewCmdUnsubscribe(f))
+	mrCmd.AddCommand(timetracking.NewCmdTime(f, timetracking.MergeRequest))
 	mrCmd.AddCommand(mrTodoCmd.NewCmdTodo(f))
 	mrCmd.AddCommand(mrUpdateCmd.NewCmdUpdate(f))
 	mrCmd.AddCommand(mrViewCmd.NewCmdView(f))
@@ -45,7 +45,7 @@ func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
 	}
 
 	projectCreateCmd.Flags().StringP("name", "n", "", "Name of the new project.")
-	projectCreateCmd.Flags().StringP("group", "g", "", "Namespace or group for the new project. Defaults to the current user's namespace.")
+	projectCreateCmd.Flags().StringP("group", "g", "", "Namespace or group for the new project. Defaults to the 'group' setting, or to the current user's namespace.")
 	projectCreateCmd.Flags().StringP("description", "d", "", "Description of the new project.")
 	projectCreateCmd.Flags().String("defaultBranch", "", "Default branch of the project. Defaults to `master` if not provided.")
 	projectCreateCmd.Flags().String("remoteName", "origin", "Remote name for the Git repository you're in. Defaults to `origin` if not provided.")
@@ -117,6 +117,14 @@ func runCreateProject(cmd *cobra.Command, args []string, f *cmdutils.Factory) er
 	if err != nil {
 		return fmt.Errorf("could not parse group flag: %v", err)
 	}
+	// the group setting is only the default of the projects created without a namespace
+	if group == "" && (len(args) == 0 || !strings.Contains(args[0], "/")) {
+		cfg, err := f.Config()
+		if err != nil {
+			return err
+		}
+		group, _ = cfg.Get("", "group")
+	}
 	if group != "" {
 		namespace = group
 	}
@@ -16,11 +16,14 @@ import (
 	completionCmd "gitlab.com/gitlab-org/cli/commands/completion"
 	configCmd "gitlab.com/gitlab-org/cli/commands/config"
 	duoCmd "gitlab.com/gitlab-org/cli/commands/duo"
+	epicCmd "gitlab.com/gitlab-org/cli/commands/epic"
 	"gitlab.com/gitlab-org/cli/commands/help"
 	incidentCmd "gitlab.com/gitlab-org/cli/commands/incident"
 	issueCmd "gitlab.com/gitlab-org/cli/commands/issue"
+	iterationCmd "gitlab.com/gitlab-org/cli/commands/iteration"
 	jobCmd "gitlab.com/gitlab-org/cli/commands/job"
 	labelCmd "gitlab.com/gitlab-org/cli/commands/label"
+	milestoneCmd "gitlab.com/gitlab-org/cli/commands/milestone"
 	mrCmd "gitlab.com/gitlab-org/cli/commands/mr"
 	projectCmd "gitlab.com/gitlab-org/cli/commands/project"
 	releaseCmd "gitlab.com/gitlab-org/cli/commands/release"
@@ -28,6 +31,7 @@ import (
 	snippetCmd "gitlab.com/gitlab-org/cli/commands/snippet"
 	sshCmd "gitlab.com/gitlab-org/cli/commands/ssh-key"
 	stackCmd "gitlab.com/gitlab-org/cli/commands/stack"
+	timesheetCmd "gitlab.com/gitlab-org/cli/commands/timesheet"
 	tokenCmd "gitlab.com/gitlab-org/cli/commands/token"
 	updateCmd "gitlab.com/gitlab-org/cli/commands/update"
 	userCmd "gitlab.com/gitlab-org/cli/commands/user"
@@ -51,6 +55,9 @@ func NewCmdRoot(f *cmdutils.Factory, version, buildDate string) *cobra.Command {
 			avoid prompts to authenticate. Overrides any previously-stored credentials.
 			Can be set in the config with 'glab config set token xxxxxx'.
 
+			GLAB_ACCOUNT: The account to use for the GitLab host, instead of the active account.
+			Can be set for a repository with 'glab config set account work'.
+
 			GITLAB_HOST or GL_HOST: Specify the URL of the GitLab server if self-managed.
 			(Example: https://gitlab.example.com) Defaults to https://gitlab.com.
 
@@ -118,10 +125,13 @@ func NewCmdRoot(f *cmdutils.Factory, version, buildDate string) *cobra.Command {
 
 	rootCmd.AddCommand(changelogCmd.NewCmdChangelog(f))
 	rootCmd.AddCommand(clusterCmd.NewCmdCluster(f))
+	rootCmd.AddCommand(epicCmd.NewCmdEpic(f))
 	rootCmd.AddCommand(issueCmd.NewCmdIssue(f))
 	rootCmd.AddCommand(incidentCmd.NewCmdIncident(f))
+	rootCmd.AddCommand(iterationCmd.NewCmdIteration(f))
 	rootCmd.AddCommand(jobCmd.NewCmdJob(f))
 	rootCmd.AddCommand(labelCmd.NewCmdLabel(f))
+	rootCmd.AddCommand(milestoneCmd.NewCmdMilestone(f))
 	rootCmd.AddCommand(mrCmd.NewCmdMR(f))
 	rootCmd.AddCommand(pipelineCmd.NewCmdCI(f))
 	rootCmd.AddCommand(projectCmd.NewCmdRepo(f))
@@ -135,6 +145,7 @@ func NewCmdRoot(f *cmdutils.Factory, version, buildDate string) *cobra.Command {
 	rootCmd.AddCommand(duoCmd.NewCmdDuo(f))
 	rootCmd.AddCommand(tokenCmd.NewTokenCmd(f))
 	rootCmd.AddCommand(stackCmd.NewCmdStack(f))
+	rootCmd.AddCommand(timesheetCmd.NewCmdTimesheet(f))
 
 	rootCmd.Flags().BoolP("version", "v", false, "show glab version information")
 	return rootCmd
@@ -0,0 +1,310 @@
+package adopt
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/stack/stackutils"
+	"gitlab.com/gitlab-org/cli/pkg/git"
+	"gitlab.com/gitlab-org/cli/pkg/text"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+type options struct {
+	title  string
+	branch string
+}
+
+// adoptedBranch is a branch found while walking down an existing chain
+// of branches, together with its open merge request, if any.
+type adoptedBranch struct {
+	branch string
+	mr     *gitlab.MergeRequest
+}
+
+func NewCmdAdoptStack(f *cmdutils.Factory) *cobra.Command {
+	opts := &options{}
+
+	stackAdoptCmd := &cobra.Command{
+		Use:   "adopt [<branch>] [flags]",
+		Short: `Create a stack from existing branches and merge requests. (EXPERIMENTAL.)`,
+		Long: heredoc.Doc(`Create a stack from a chain of existing branches and merge requests.
+
+Starting from the given branch, or the current branch if none is given, this command
+follows the target branch of each open merge request down to the default branch.
+Branches without an open merge request are matched to their closest local ancestor branch.
+Each branch found becomes an entry in a new stack, which can then be managed with
+the other stack commands, like "glab stack sync".
+` + text.ExperimentalString),
+		Example: heredoc.Doc(`
+			glab stack adopt
+			glab stack adopt my-last-branch
+			glab stack adopt my-last-branch --title cool-new-feature
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gr := stackutils.GitRunner(cmd, f)
+
+			if len(args) == 1 {
+				opts.branch = args[0]
+			} else {
+				branch, err := git.CurrentBranchWithRunner(gr)
+				if err != nil {
+					return fmt.Errorf("error getting current branch: %v", err)
+				}
+				opts.branch = branch
+			}
+
+			err := adoptFunc(f, opts, gr)
+			if err != nil {
+				return fmt.Errorf("could not adopt stack: %v", err)
+			}
+
+			return nil
+		},
+	}
+
+	stackAdoptCmd.Flags().StringVarP(&opts.title, "title", "t", "", "Title of the new stack. Defaults to the name of the first branch.")
+
+	return stackAdoptCmd
+}
+
+func adoptFunc(f *cmdutils.Factory, opts *options, gr git.GitRunner) error {
+	client, err := f.HttpClient()
+	if err != nil {
+		return fmt.Errorf("error using API client: %v", err)
+	}
+
+	repo, err := f.BaseRepo()
+	if err != nil {
+		return fmt.Errorf("error determining base repo: %v", err)
+	}
+
+	defaultBranch, err := git.DefaultBranchWithRunner(git.DefaultRemote, gr)
+	if err != nil {
+		return fmt.Errorf("error getting default branch: %v", err)
+	}
+
+	if opts.branch == defaultBranch {
+		return fmt.Errorf("%q is the default branch and cannot be part of a stack.", opts.branch)
+	}
+
+	chain, err := walkChain(client, repo.FullName(), opts.branch, defaultBranch, gr)
+	if err != nil {
+		return err
+	}
+
+	title := opts.title
+	if title == "" {
+		title = chain[0].branch
+	}
+
+	sanitized := utils.ReplaceNonAlphaNumericChars(title, "-")
+	if sanitized != title {
+		color := f.IO.Color()
+		fmt.Fprintf(f.IO.StdErr, "%s warning: invalid characters have been replaced with dashes: %s\n",
+			color.WarnIcon(),
+			color.Blue(sanitized))
+		title = sanitized
+	}
+
+	existing, err := git.GatherStackRefs(title)
+	if err != nil {
+		return fmt.Errorf("error getting refs from file system: %v", err)
+	}
+
+	if !existing.Empty() {
+		return fmt.Errorf("a stack named %q already exists. Use --title to choose a different name.", title)
+	}
+
+	stack, err := buildStack(title, chain, gr)
+	if err != nil {
+		return err
+	}
+
+	for ref := range stack.Iter() {
+		err = git.RunOperation(gr, git.StackRefFileOperation("create", title, ref), func() error {
+			return git.AddStackRefFile(title, ref)
+		})
+		if err != nil {
+			return fmt.Errorf("error creating stack file: %v", err)
+		}
+	}
+
+	err = git.SetCurrentStackTitle(title, gr)
+	if err != nil {
+		return err
+	}
+
+	if !git.IsDryRun(gr) {
+		printStack(f, &stack)
+	}
+
+	return nil
+}
+
+// walkChain follows the chain of branches from branch down to the default branch,
+// and returns it ordered from the bottom of the stack to the top.
+func walkChain(client *gitlab.Client, projectID string, branch, defaultBranch string, gr git.GitRunner) ([]adoptedBranch, error) {
+	var chain []adoptedBranch
+	seen := map[string]bool{}
+
+	for branch != defaultBranch {
+		if seen[branch] {
+			return nil, fmt.Errorf("branch %q appears twice in the chain of merge requests.", branch)
+		}
+		seen[branch] = true
+
+		mrs, err := api.ListMRs(client, projectID, &gitlab.ListProjectMergeRequestsOptions{
+			SourceBranch: gitlab.Ptr(branch),
+			State:        gitlab.Ptr("opened"),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error listing merge requests for branch %q: %v", branch, err)
+		}
+
+		var mr *gitlab.MergeRequest
+		var parent string
+
+		switch len(mrs) {
+		case 0:
+			parent, err = closestAncestorBranch(branch, defaultBranch, gr)
+			if err != nil {
+				return nil, err
+			}
+		case 1:
+			mr = mrs[0]
+			parent = mr.TargetBranch
+		default:
+			return nil, fmt.Errorf("branch %q has more than one open merge request.", branch)
+		}
+
+		if parent != defaultBranch {
+			_, err = gr.Git("merge-base", "--is-ancestor", parent, branch)
+			if err != nil {
+				return nil, fmt.Errorf("branch %q is not based on %q. Rebase it and try again.", branch, parent)
+			}
+		}
+
+		chain = append([]adoptedBranch{{branch: branch, mr: mr}}, chain...)
+		branch = parent
+	}
+
+	return chain, nil
+}
+
+// closestAncestorBranch returns the local branch closest to branch among the
+// ones it contains, including the default branch, which wins ties. It returns
+// the default branch when no other branch is found.
+func closestAncestorBranch(branch, defaultBranch string, gr git.GitRunner) (string, error) {
+	output, err := gr.Git("for-each-ref", "--merged="+branch, "--format=%(refname:short)", "refs/heads")
+	if err != nil {
+		return "", fmt.Errorf("error listing ancestor branches of %q: %v", branch, err)
+	}
+
+	closest := defaultBranch
+	closestDistance := -1
+
+	for _, candidate := range strings.Fields(output) {
+		if candidate == branch {
+			continue
+		}
+
+		count, err := gr.Git("rev-list", "--count", candidate+".."+branch)
+		if err != nil {
+			return "", fmt.Errorf("error counting commits between %q and %q: %v", candidate, branch, err)
+		}
+
+		distance, err := strconv.Atoi(strings.TrimSpace(count))
+		if err != nil {
+			return "", fmt.Errorf("error counting commits between %q and %q: %v", candidate, branch, err)
+		}
+
+		// a branch pointing to the same commit is not an ancestor
+		if distance == 0 {
+			continue
+		}
+
+		if closestDistance == -1 || distance < closestDistance ||
+			(distance == closestDistance && candidate == defaultBranch) {
+			closest = candidate
+			closestDistance = distance
+		}
+	}
+
+	return closest, nil
+}
+
+func buildStack(title string, chain []adoptedBranch, gr git.GitRunner) (git.Stack, error) {
+	if len(chain) == 0 {
+		return git.Stack{}, errors.New("no branches found to adopt.")
+	}
+
+	stack := git.Stack{Title: title, Refs: make(map[string]git.StackRef)}
+
+	var prev string
+	for i, b := range chain {
+		sha, err := gr.Git("rev-parse", "--short=8", b.branch)
+		if err != nil {
+			return git.Stack{}, fmt.Errorf("error resolving branch %q: %v", b.branch, err)
+		}
+		sha = strings.TrimSpace(sha)
+
+		if _, ok := stack.Refs[sha]; ok {
+			return git.Stack{}, fmt.Errorf("branches %q and %q point to the same commit.", stack.Refs[sha].Branch, b.branch)
+		}
+
+		ref := git.StackRef{Prev: prev, SHA: sha, Branch: b.branch}
+
+		if b.mr != nil {
+			ref.MR = b.mr.WebURL
+			ref.Description = b.mr.Title
+		} else {
+			subject, err := gr.Git("log", "-1", "--format=%s", b.branch)
+			if err != nil {
+				return git.Stack{}, fmt.Errorf("error getting commit message of %q: %v", b.branch, err)
+			}
+			ref.Description = strings.TrimSpace(subject)
+		}
+
+		if i > 0 {
+			prevRef := stack.Refs[prev]
+			prevRef.Next = sha
+			stack.Refs[prev] = prevRef
+		}
+
+		stack.Refs[sha] = ref
+		prev = sha
+	}
+
+	return stack, nil
+}
+
+func printStack(f *cmdutils.Factory, stack *git.Stack) {
+	if !f.IO.IsOutputTTY() {
+		return
+	}
+
+	color := f.IO.Color()
+
+	fmt.Fprintf(f.IO.StdOut, "%s Adopted %d branches into stack %s:\n",
+		color.ProgressIcon(),
+		len(stack.Refs),
+		color.Blue(stack.Title))
+
+	for ref := range stack.Iter() {
+		mr := ref.MR
+		if mr == "" {
+			mr = "no merge request"
+		}
+		fmt.Fprintf(f.IO.StdOut, "  %s - %s (%s)\n", color.Bold(ref.Branch), ref.Subject(), mr)
+	}
+}
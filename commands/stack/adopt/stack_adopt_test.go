@@ -0,0 +1,212 @@
+package adopt
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/pkg/git"
+)
+
+func setupTestFactory(rt http.RoundTripper) *cmdutils.Factory {
+	ios, _, _, _ := cmdtest.InitIOStreams(false, "")
+
+	f := cmdtest.InitFactory(ios, rt)
+
+	f.BaseRepo = func() (glrepo.Interface, error) {
+		return glrepo.TestProject("stack_guy", "stackproject"), nil
+	}
+
+	// the API client is shared between tests. Initialize it once here,
+	// so that the client returned to adoptFunc uses this test's transport.
+	_, _ = f.HttpClient()
+
+	return f
+}
+
+func Test_adoptFunc(t *testing.T) {
+	tests := []struct {
+		name      string
+		branch    string
+		title     string
+		httpMocks []git.HttpMock
+		gitMocks  func(m *MockGitRunner)
+		want      []git.StackRef
+		wantErr   string
+	}{
+		{
+			name:   "chain of merge requests",
+			branch: "Branch3",
+			title:  "adopted",
+			httpMocks: []git.HttpMock{
+				git.MockListOpenStackMRsByBranchAndTarget("Branch3", "Branch2", "3"),
+				git.MockListOpenStackMRsByBranchAndTarget("Branch2", "Branch1", "2"),
+				git.MockListOpenStackMRsByBranchAndTarget("Branch1", "main", "1"),
+			},
+			gitMocks: func(m *MockGitRunner) {
+				m.EXPECT().Git([]string{"remote", "show", "origin"}).Return("  HEAD branch: main\n", nil)
+				m.EXPECT().Git([]string{"merge-base", "--is-ancestor", "Branch2", "Branch3"})
+				m.EXPECT().Git([]string{"merge-base", "--is-ancestor", "Branch1", "Branch2"})
+				m.EXPECT().Git([]string{"rev-parse", "--short=8", "Branch1"}).Return("aaaaaaaa\n", nil)
+				m.EXPECT().Git([]string{"rev-parse", "--short=8", "Branch2"}).Return("bbbbbbbb\n", nil)
+				m.EXPECT().Git([]string{"rev-parse", "--short=8", "Branch3"}).Return("cccccccc\n", nil)
+				m.EXPECT().Git([]string{"config", "--local", "glab.currentstack", "adopted"})
+			},
+			want: []git.StackRef{
+				{
+					SHA: "aaaaaaaa", Next: "bbbbbbbb", Branch: "Branch1", Description: "test mr title1",
+					MR: "https://gitlab.com/stack_guy/stackproject/-/merge_requests/1",
+				},
+				{
+					SHA: "bbbbbbbb", Prev: "aaaaaaaa", Next: "cccccccc", Branch: "Branch2", Description: "test mr title2",
+					MR: "https://gitlab.com/stack_guy/stackproject/-/merge_requests/2",
+				},
+				{
+					SHA: "cccccccc", Prev: "bbbbbbbb", Branch: "Branch3", Description: "test mr title3",
+					MR: "https://gitlab.com/stack_guy/stackproject/-/merge_requests/3",
+				},
+			},
+		},
+		{
+			name:   "top branch without merge request uses git ancestry",
+			branch: "Branch2",
+			httpMocks: []git.HttpMock{
+				git.MockListNoOpenStackMRsByBranch("Branch2"),
+				git.MockListOpenStackMRsByBranchAndTarget("Branch1", "main", "1"),
+			},
+			gitMocks: func(m *MockGitRunner) {
+				m.EXPECT().Git([]string{"remote", "show", "origin"}).Return("  HEAD branch: main\n", nil)
+				m.EXPECT().
+					Git([]string{"for-each-ref", "--merged=Branch2", "--format=%(refname:short)", "refs/heads"}).
+					Return("main\nBranch0\nBranch1\nBranch2\n", nil)
+				m.EXPECT().Git([]string{"rev-list", "--count", "main..Branch2"}).Return("5\n", nil)
+				m.EXPECT().Git([]string{"rev-list", "--count", "Branch0..Branch2"}).Return("4\n", nil)
+				m.EXPECT().Git([]string{"rev-list", "--count", "Branch1..Branch2"}).Return("1\n", nil)
+				m.EXPECT().Git([]string{"merge-base", "--is-ancestor", "Branch1", "Branch2"})
+				m.EXPECT().Git([]string{"rev-parse", "--short=8", "Branch1"}).Return("aaaaaaaa\n", nil)
+				m.EXPECT().Git([]string{"rev-parse", "--short=8", "Branch2"}).Return("bbbbbbbb\n", nil)
+				m.EXPECT().Git([]string{"log", "-1", "--format=%s", "Branch2"}).Return("work in progress\n", nil)
+				m.EXPECT().Git([]string{"config", "--local", "glab.currentstack", "Branch1"})
+			},
+			want: []git.StackRef{
+				{
+					SHA: "aaaaaaaa", Next: "bbbbbbbb", Branch: "Branch1", Description: "test mr title1",
+					MR: "https://gitlab.com/stack_guy/stackproject/-/merge_requests/1",
+				},
+				{SHA: "bbbbbbbb", Prev: "aaaaaaaa", Branch: "Branch2", Description: "work in progress"},
+			},
+		},
+		{
+			name:   "branch not based on its target",
+			branch: "Branch2",
+			httpMocks: []git.HttpMock{
+				git.MockListOpenStackMRsByBranchAndTarget("Branch2", "Branch1", "2"),
+			},
+			gitMocks: func(m *MockGitRunner) {
+				m.EXPECT().Git([]string{"remote", "show", "origin"}).Return("  HEAD branch: main\n", nil)
+				m.EXPECT().Git([]string{"merge-base", "--is-ancestor", "Branch1", "Branch2"}).Return("", errors.New("exit status 1"))
+			},
+			wantErr: `branch "Branch2" is not based on "Branch1". Rebase it and try again.`,
+		},
+		{
+			name:   "default branch",
+			branch: "main",
+			gitMocks: func(m *MockGitRunner) {
+				m.EXPECT().Git([]string{"remote", "show", "origin"}).Return("  HEAD branch: main\n", nil)
+			},
+			wantErr: `"main" is the default branch and cannot be part of a stack.`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			git.InitGitRepoWithCommit(t)
+
+			fakeHTTP := git.SetupMocks(tc.httpMocks)
+			defer fakeHTTP.Verify(t)
+
+			ctrl := gomock.NewController(t)
+			mockCmd := NewMockGitRunner(ctrl)
+			tc.gitMocks(mockCmd)
+
+			f := setupTestFactory(fakeHTTP)
+
+			err := adoptFunc(f, &options{branch: tc.branch, title: tc.title}, mockCmd)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			title := tc.title
+			if title == "" {
+				title = tc.want[0].Branch
+			}
+
+			stack, err := git.GatherStackRefs(title)
+			require.NoError(t, err)
+
+			var got []git.StackRef
+			for ref := range stack.Iter() {
+				got = append(got, ref)
+			}
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func Test_closestAncestorBranch(t *testing.T) {
+	tests := []struct {
+		name     string
+		refs     string
+		counts   map[string]string
+		expected string
+	}{
+		{
+			name:     "closer branch than the default branch",
+			refs:     "Branch1\nBranch2\nmain\n",
+			counts:   map[string]string{"Branch1": "1", "main": "3"},
+			expected: "Branch1",
+		},
+		{
+			name:     "stale branch merged into the default branch",
+			refs:     "main\nstale\nBranch2\n",
+			counts:   map[string]string{"main": "2", "stale": "6"},
+			expected: "main",
+		},
+		{
+			name:     "branch at the same commit as the default branch",
+			refs:     "Branch2\nmain\nmerged\n",
+			counts:   map[string]string{"main": "2", "merged": "2"},
+			expected: "main",
+		},
+		{
+			name:     "no other branch",
+			refs:     "Branch2\n",
+			expected: "main",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockCmd := NewMockGitRunner(ctrl)
+			mockCmd.EXPECT().
+				Git([]string{"for-each-ref", "--merged=Branch2", "--format=%(refname:short)", "refs/heads"}).
+				Return(tc.refs, nil)
+			for candidate, count := range tc.counts {
+				mockCmd.EXPECT().Git([]string{"rev-list", "--count", candidate + "..Branch2"}).Return(count+"\n", nil)
+			}
+
+			got, err := closestAncestorBranch("Branch2", "main", mockCmd)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/MakeNowJust/heredoc/v2"
+	"gitlab.com/gitlab-org/cli/commands/stack/stackutils"
 	"gitlab.com/gitlab-org/cli/pkg/git"
 	"gitlab.com/gitlab-org/cli/pkg/prompt"
 	"gitlab.com/gitlab-org/cli/pkg/text"
@@ -54,17 +55,22 @@ func NewCmdCreateStack(f *cmdutils.Factory) *cobra.Command {
 					color.Blue(title))
 			}
 
-			err := git.SetLocalConfig("glab.currentstack", title)
+			gr := stackutils.GitRunner(cmd, f)
+
+			err := git.SetCurrentStackTitle(title, gr)
 			if err != nil {
 				return fmt.Errorf("error setting local Git config: %v", err)
 			}
 
-			_, err = git.AddStackRefDir(title)
+			err = git.RunOperation(gr, "create stack directory "+title, func() error {
+				_, err := git.AddStackRefDir(title)
+				return err
+			})
 			if err != nil {
 				return fmt.Errorf("error adding stack metadata directory: %v", err)
 			}
 
-			if f.IO.IsOutputTTY() {
+			if f.IO.IsOutputTTY() && !gr.DryRun {
 				fmt.Fprintf(f.IO.StdOut, "New stack created with title \"%s\".\n", title)
 			}
 
@@ -78,7 +78,7 @@ func TestCreateNewStack(t *testing.T) {
 				require.Empty(t, output.Stderr())
 			}
 
-			configValue, err := git.GetCurrentStackTitle()
+			configValue, err := git.GetCurrentStackTitle(git.StandardGitCommand{})
 			require.Nil(t, err)
 
 			require.Equal(t, tc.expectedBranch, configValue)
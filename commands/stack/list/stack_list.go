@@ -5,6 +5,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/stack/stackutils"
 	"gitlab.com/gitlab-org/cli/pkg/git"
 	"gitlab.com/gitlab-org/cli/pkg/iostreams"
 	"gitlab.com/gitlab-org/cli/pkg/text"
@@ -18,7 +19,9 @@ func NewCmdStackList(f *cmdutils.Factory) *cobra.Command {
 		Long:    "Lists all entries in the stack. To select a different revision, use a command like 'stack move'.\n" + text.ExperimentalString,
 		Example: "glab stack list",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			title, err := git.GetCurrentStackTitle()
+			gr := stackutils.GitRunner(cmd, f)
+
+			title, err := git.GetCurrentStackTitle(gr)
 			if err != nil {
 				return err
 			}
@@ -28,7 +31,7 @@ func NewCmdStackList(f *cmdutils.Factory) *cobra.Command {
 				return err
 			}
 
-			currentBranch, err := git.CurrentBranch()
+			currentBranch, err := git.CurrentBranchWithRunner(gr)
 			if err != nil {
 				return err
 			}
@@ -0,0 +1,339 @@
+package merge
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/avast/retry-go/v4"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/mr/mrutils"
+	"gitlab.com/gitlab-org/cli/commands/stack/stackutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/pkg/git"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/pkg/text"
+)
+
+type options struct {
+	autoMerge bool
+	squash    bool
+	timeout   time.Duration
+
+	io     *iostreams.IOStreams
+	client *gitlab.Client
+	repo   glrepo.Interface
+	stack  git.Stack
+
+	defaultBranch string
+}
+
+const (
+	mergedStatus = "merged"
+	closedStatus = "closed"
+)
+
+// pollInterval is the time to wait between two checks of a merge request
+// that is not merged yet.
+var pollInterval = 10 * time.Second
+
+func NewCmdMergeStack(f *cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io: f.IO,
+	}
+
+	stackMergeCmd := &cobra.Command{
+		Use:   "merge",
+		Short: `Merge a stacked diff, from the first diff to the last. (EXPERIMENTAL.)`,
+		Long: heredoc.Doc(`Merge the merge requests of a stacked diff, from the first diff to the last.
+This command runs these steps for each diff in the stack:
+
+1. Retargets the merge request to the default branch, if needed.
+1. Merges the merge request, or sets it to auto-merge with --auto-merge,
+   and waits for it to be merged.
+1. Rebases the rest of the stack on the default branch, and pushes it.
+1. Removes the merged diff from the stack.
+
+If a pipeline fails, a merge request is closed, a merge request does not merge
+within --timeout, or a rebase has conflicts, the command stops.
+Fix the issue, and run "glab stack merge" again to continue where it stopped.
+` + text.ExperimentalString),
+		Example: heredoc.Doc(`
+			glab stack merge
+			glab stack merge --auto-merge --squash
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := mergeFunc(f, opts, stackutils.GitRunner(cmd, f))
+			f.IO.StopSpinner("")
+			if err != nil {
+				return fmt.Errorf("could not merge stack: %v", err)
+			}
+
+			return nil
+		},
+	}
+
+	stackMergeCmd.Flags().BoolVarP(&opts.autoMerge, "auto-merge", "", false, "Set each merge request to auto-merge, and wait for its pipeline to succeed.")
+	stackMergeCmd.Flags().BoolVarP(&opts.squash, "squash", "s", false, "Squash commits on merge.")
+	stackMergeCmd.Flags().DurationVar(&opts.timeout, "timeout", time.Hour, "Maximum time to wait for each merge request to merge.")
+
+	return stackMergeCmd
+}
+
+func mergeFunc(f *cmdutils.Factory, opts *options, gr git.GitRunner) error {
+	var err error
+
+	opts.client, err = f.HttpClient()
+	if err != nil {
+		return fmt.Errorf("error using API client: %v", err)
+	}
+
+	opts.repo, err = f.BaseRepo()
+	if err != nil {
+		return fmt.Errorf("error determining base repo: %v", err)
+	}
+
+	title, err := git.GetCurrentStackTitle(gr)
+	if err != nil {
+		return fmt.Errorf("error getting current stack: %v", err)
+	}
+
+	opts.stack, err = git.GatherStackRefs(title)
+	if err != nil {
+		return fmt.Errorf("error getting current stack references: %v", err)
+	}
+
+	if opts.stack.Empty() {
+		return errors.New("the stack is empty. There is nothing to merge.")
+	}
+
+	opts.defaultBranch, err = git.DefaultBranchWithRunner(git.DefaultRemote, gr)
+	if err != nil {
+		return fmt.Errorf("error getting default branch: %v", err)
+	}
+
+	for !opts.stack.Empty() {
+		ref := opts.stack.First()
+
+		if ref.MR == "" {
+			return fmt.Errorf("%s has no merge request. Run `glab stack sync` to create it.", ref.Branch)
+		}
+
+		mr, _, err := mrutils.MRFromArgsWithOpts(f, []string{ref.Branch}, nil, "any")
+		if err != nil {
+			return fmt.Errorf("error getting merge request from branch: %v. Does it still exist?", err)
+		}
+
+		if mr.State == closedStatus {
+			return fmt.Errorf("merge request !%d is closed. Reopen it, or remove it from the stack.", mr.IID)
+		}
+
+		if mr.State != mergedStatus {
+			if git.IsDryRun(gr) {
+				// nothing is merged in dry-run mode, so the next merge requests
+				// would be retargeted to the default branch after this one.
+				return mergeRefDryRun(opts, mr, gr)
+			}
+
+			mr, err = mergeRef(opts, mr)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = landRef(opts, ref, gr)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprint(opts.io.StdOut, stackutils.ProgressString(opts.io, fmt.Sprintf("Merge request !%d has merged.", mr.IID)))
+	}
+
+	fmt.Fprint(opts.io.StdOut, stackutils.ProgressString(opts.io, "Stack merged!"))
+
+	return nil
+}
+
+// mergeRef retargets the merge request to the default branch when needed,
+// merges it, and waits until it is merged.
+func mergeRef(opts *options, mr *gitlab.MergeRequest) (*gitlab.MergeRequest, error) {
+	var err error
+	mrIID := mr.IID
+
+	if mr.TargetBranch != opts.defaultBranch {
+		fmt.Fprint(opts.io.StdOut, stackutils.ProgressString(opts.io, fmt.Sprintf("Retargeting merge request !%d to %s.", mr.IID, opts.defaultBranch)))
+
+		mr, err = api.UpdateMR(opts.client, opts.repo.FullName(), mrIID, &gitlab.UpdateMergeRequestOptions{
+			TargetBranch: gitlab.Ptr(opts.defaultBranch),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error retargeting merge request: %v", err)
+		}
+	}
+
+	err = checkPipeline(mr)
+	if err != nil {
+		return nil, err
+	}
+
+	mergeOpts := &gitlab.AcceptMergeRequestOptions{}
+	if opts.squash {
+		mergeOpts.Squash = gitlab.Ptr(true)
+	}
+	if opts.autoMerge && mr.Pipeline != nil {
+		mergeOpts.MergeWhenPipelineSucceeds = gitlab.Ptr(true)
+	}
+
+	opts.io.StartSpinner("Merging merge request !%d.", mrIID)
+	defer opts.io.StopSpinner("")
+
+	// GitLab might not consider the merge request mergeable right after
+	// the rebase of the previous diff was pushed, so retry like `mr merge` does.
+	err = retry.Do(func() error {
+		var resp *gitlab.Response
+		mr, resp, err = api.MergeMR(opts.client, opts.repo.FullName(), mrIID, mergeOpts)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotAcceptable {
+				return err
+			}
+
+			return retry.Unrecoverable(err)
+		}
+		return nil
+	}, retry.Attempts(3), retry.Delay(pollInterval))
+	if err != nil {
+		return nil, fmt.Errorf("error merging merge request !%d: %v", mrIID, err)
+	}
+
+	deadline := time.Now().Add(opts.timeout)
+	for mr.State != mergedStatus {
+		if mr.State == closedStatus {
+			return nil, fmt.Errorf("merge request !%d was closed before it merged.", mrIID)
+		}
+
+		err = checkPipeline(mr)
+		if err != nil {
+			return nil, err
+		}
+
+		if !time.Now().Before(deadline) {
+			return nil, fmt.Errorf("merge request !%d did not merge within %s. Run `glab stack merge` again to keep waiting.", mrIID, opts.timeout)
+		}
+
+		time.Sleep(pollInterval)
+
+		mr, err = api.GetMR(opts.client, opts.repo.FullName(), mrIID, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error getting merge request !%d: %v", mrIID, err)
+		}
+	}
+
+	return mr, nil
+}
+
+// mergeRefDryRun prints the operations that mergeRef and landRef would run
+// for mr, and for the rest of the stack after it.
+func mergeRefDryRun(opts *options, mr *gitlab.MergeRequest, gr git.GitRunner) error {
+	project := opts.repo.FullName()
+
+	for !opts.stack.Empty() {
+		ref := opts.stack.First()
+
+		mrRef := path.Base(ref.MR)
+		if ref.Branch == mr.SourceBranch {
+			mrRef = strconv.Itoa(mr.IID)
+		}
+
+		if ref.Branch != mr.SourceBranch || mr.TargetBranch != opts.defaultBranch {
+			operation := fmt.Sprintf("PUT projects/%s/merge_requests/%s target_branch=%s", project, mrRef, opts.defaultBranch)
+			_ = git.RunOperation(gr, operation, func() error { return nil })
+		}
+
+		operation := fmt.Sprintf("PUT projects/%s/merge_requests/%s/merge", project, mrRef)
+		if opts.squash {
+			operation += " squash=true"
+		}
+		if opts.autoMerge {
+			operation += " merge_when_pipeline_succeeds=true"
+		}
+		_ = git.RunOperation(gr, operation, func() error { return nil })
+
+		err := landRef(opts, ref, gr)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// landRef rebases the rest of the stack on the default branch,
+// now that ref has merged, and removes ref from the stack.
+func landRef(opts *options, ref git.StackRef, gr git.GitRunner) error {
+	if !ref.IsLast() {
+		_, err := gr.Git("fetch", git.DefaultRemote)
+		if err != nil {
+			return fmt.Errorf("error fetching from remote: %v", err)
+		}
+
+		_, err = gr.Git("checkout", opts.stack.Last().Branch)
+		if err != nil {
+			return fmt.Errorf("error checking out branch: %v", err)
+		}
+
+		_, err = gr.Git("rebase", "--update-refs", "--onto", git.DefaultRemote+"/"+opts.defaultBranch, ref.Branch)
+		if err != nil {
+			return errors.New(stackutils.ErrorString(opts.io,
+				"could not rebase, likely due to a merge conflict.",
+				"Fix the issues with Git and run `glab stack merge` again.",
+			))
+		}
+
+		var branches []string
+		for r := range opts.stack.Iter() {
+			if r.SHA != ref.SHA {
+				branches = append(branches, r.Branch)
+			}
+		}
+
+		_, err = gr.Git(append([]string{"push", git.DefaultRemote, "--force-with-lease"}, branches...)...)
+		if err != nil {
+			return fmt.Errorf("error pushing branches to remote: %v", err)
+		}
+	}
+
+	err := opts.stack.RemoveRef(ref, gr)
+	if err != nil {
+		return fmt.Errorf("error removing merged merge request: %v", err)
+	}
+
+	return nil
+}
+
+func checkPipeline(mr *gitlab.MergeRequest) error {
+	var status string
+	switch {
+	case mr.HeadPipeline != nil:
+		status = mr.HeadPipeline.Status
+	case mr.Pipeline != nil:
+		status = mr.Pipeline.Status
+	default:
+		return nil
+	}
+
+	switch status {
+	case "failed", "canceled":
+		return fmt.Errorf("the pipeline of merge request !%d has status %q. Fix it, and run `glab stack merge` again.", mr.IID, status)
+	}
+
+	return nil
+}
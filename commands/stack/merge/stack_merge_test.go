@@ -0,0 +1,234 @@
+package merge
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/pkg/git"
+)
+
+func setupTestFactory(rt http.RoundTripper) *cmdutils.Factory {
+	ios, _, _, _ := cmdtest.InitIOStreams(false, "")
+
+	f := cmdtest.InitFactory(ios, rt)
+
+	f.BaseRepo = func() (glrepo.Interface, error) {
+		return glrepo.TestProject("stack_guy", "stackproject"), nil
+	}
+
+	// the API client is shared between tests. Initialize it once here,
+	// so that the client returned to mergeFunc uses this test's transport.
+	_, _ = f.HttpClient()
+
+	return f
+}
+
+func Test_mergeFunc(t *testing.T) {
+	pollInterval = 0
+
+	tests := []struct {
+		name      string
+		refs      []git.StackRef
+		httpMocks []git.HttpMock
+		gitMocks  func(m *MockGitRunner)
+		wantRefs  int
+		wantErr   string
+	}{
+		{
+			name: "single diff",
+			refs: []git.StackRef{
+				{SHA: "1", Branch: "Branch1", MR: "https://gitlab.com/stack_guy/stackproject/-/merge_requests/1"},
+			},
+			httpMocks: []git.HttpMock{
+				git.MockListStackMRsByBranch("Branch1", "1"),
+				git.MockGetStackMRWithState("Branch1", "main", "1", "opened", "success"),
+				git.MockMergeStackMR("Branch1", "1", "merged"),
+			},
+			gitMocks: func(m *MockGitRunner) {
+				m.EXPECT().Git([]string{"config", "glab.currentstack"}).Return("my-stack\n", nil)
+				m.EXPECT().Git([]string{"remote", "show", "origin"}).Return("  HEAD branch: main\n", nil)
+			},
+		},
+		{
+			name: "first diff already merged, second diff is retargeted",
+			refs: []git.StackRef{
+				{SHA: "1", Next: "2", Branch: "Branch1", MR: "https://gitlab.com/stack_guy/stackproject/-/merge_requests/1"},
+				{SHA: "2", Prev: "1", Branch: "Branch2", MR: "https://gitlab.com/stack_guy/stackproject/-/merge_requests/2"},
+			},
+			httpMocks: []git.HttpMock{
+				git.MockListStackMRsByBranch("Branch1", "1"),
+				git.MockGetStackMRWithState("Branch1", "main", "1", "merged", "success"),
+				git.MockListStackMRsByBranch("Branch2", "2"),
+				git.MockGetStackMRWithState("Branch2", "Branch1", "2", "opened", ""),
+				git.MockPutStackMR("main", "2", "stack_guy%2Fstackproject"),
+				git.MockMergeStackMR("Branch2", "2", "merged"),
+			},
+			gitMocks: func(m *MockGitRunner) {
+				m.EXPECT().Git([]string{"config", "glab.currentstack"}).Return("my-stack\n", nil)
+				m.EXPECT().Git([]string{"remote", "show", "origin"}).Return("  HEAD branch: main\n", nil)
+				m.EXPECT().Git([]string{"fetch", "origin"})
+				m.EXPECT().Git([]string{"checkout", "Branch2"})
+				m.EXPECT().Git([]string{"rebase", "--update-refs", "--onto", "origin/main", "Branch1"})
+				m.EXPECT().Git([]string{"push", "origin", "--force-with-lease", "Branch2"})
+				m.EXPECT().Git([]string{"remote", "show", "origin"}).Return("  HEAD branch: main\n", nil)
+				m.EXPECT().Git([]string{"checkout", "main"})
+				m.EXPECT().Git([]string{"branch", "-D", "Branch1"})
+			},
+		},
+		{
+			name: "failed pipeline stops the merge",
+			refs: []git.StackRef{
+				{SHA: "1", Branch: "Branch1", MR: "https://gitlab.com/stack_guy/stackproject/-/merge_requests/1"},
+			},
+			httpMocks: []git.HttpMock{
+				git.MockListStackMRsByBranch("Branch1", "1"),
+				git.MockGetStackMRWithState("Branch1", "main", "1", "opened", "failed"),
+			},
+			gitMocks: func(m *MockGitRunner) {
+				m.EXPECT().Git([]string{"config", "glab.currentstack"}).Return("my-stack\n", nil)
+				m.EXPECT().Git([]string{"remote", "show", "origin"}).Return("  HEAD branch: main\n", nil)
+			},
+			wantRefs: 1,
+			wantErr:  "the pipeline of merge request !1 has status \"failed\". Fix it, and run `glab stack merge` again.",
+		},
+		{
+			name: "merge request that does not merge in time",
+			refs: []git.StackRef{
+				{SHA: "1", Branch: "Branch1", MR: "https://gitlab.com/stack_guy/stackproject/-/merge_requests/1"},
+			},
+			httpMocks: []git.HttpMock{
+				git.MockListStackMRsByBranch("Branch1", "1"),
+				git.MockGetStackMRWithState("Branch1", "main", "1", "opened", "running"),
+				git.MockMergeStackMR("Branch1", "1", "opened"),
+			},
+			gitMocks: func(m *MockGitRunner) {
+				m.EXPECT().Git([]string{"config", "glab.currentstack"}).Return("my-stack\n", nil)
+				m.EXPECT().Git([]string{"remote", "show", "origin"}).Return("  HEAD branch: main\n", nil)
+			},
+			wantRefs: 1,
+			wantErr:  "merge request !1 did not merge within 0s. Run `glab stack merge` again to keep waiting.",
+		},
+		{
+			name: "merge request closed while waiting",
+			refs: []git.StackRef{
+				{SHA: "1", Branch: "Branch1", MR: "https://gitlab.com/stack_guy/stackproject/-/merge_requests/1"},
+			},
+			httpMocks: []git.HttpMock{
+				git.MockListStackMRsByBranch("Branch1", "1"),
+				git.MockGetStackMRWithState("Branch1", "main", "1", "opened", "success"),
+				git.MockMergeStackMR("Branch1", "1", "closed"),
+			},
+			gitMocks: func(m *MockGitRunner) {
+				m.EXPECT().Git([]string{"config", "glab.currentstack"}).Return("my-stack\n", nil)
+				m.EXPECT().Git([]string{"remote", "show", "origin"}).Return("  HEAD branch: main\n", nil)
+			},
+			wantRefs: 1,
+			wantErr:  "merge request !1 was closed before it merged.",
+		},
+		{
+			name: "diff without merge request",
+			refs: []git.StackRef{
+				{SHA: "1", Branch: "Branch1"},
+			},
+			gitMocks: func(m *MockGitRunner) {
+				m.EXPECT().Git([]string{"config", "glab.currentstack"}).Return("my-stack\n", nil)
+				m.EXPECT().Git([]string{"remote", "show", "origin"}).Return("  HEAD branch: main\n", nil)
+			},
+			wantRefs: 1,
+			wantErr:  "Branch1 has no merge request. Run `glab stack sync` to create it.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			git.InitFakeGitRepo(t)
+
+			title := "my-stack"
+			for _, ref := range tc.refs {
+				require.NoError(t, git.AddStackRefFile(title, ref))
+			}
+
+			fakeHTTP := git.SetupMocks(tc.httpMocks)
+			defer fakeHTTP.Verify(t)
+
+			ctrl := gomock.NewController(t)
+			mockCmd := NewMockGitRunner(ctrl)
+			tc.gitMocks(mockCmd)
+
+			f := setupTestFactory(fakeHTTP)
+
+			err := mergeFunc(f, &options{io: f.IO}, mockCmd)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			stack, err := git.GatherStackRefs(title)
+			require.NoError(t, err)
+			require.Len(t, stack.Refs, tc.wantRefs)
+		})
+	}
+}
+
+func Test_mergeFunc_dryRun(t *testing.T) {
+	git.InitFakeGitRepo(t)
+
+	title := "my-stack"
+	refs := []git.StackRef{
+		{SHA: "1", Next: "2", Branch: "Branch1", MR: "https://gitlab.com/stack_guy/stackproject/-/merge_requests/1"},
+		{SHA: "2", Prev: "1", Branch: "Branch2", MR: "https://gitlab.com/stack_guy/stackproject/-/merge_requests/2"},
+	}
+	for _, ref := range refs {
+		require.NoError(t, git.AddStackRefFile(title, ref))
+	}
+
+	fakeHTTP := git.SetupMocks([]git.HttpMock{
+		git.MockListStackMRsByBranch("Branch1", "1"),
+		git.MockGetStackMRWithState("Branch1", "main", "1", "opened", "success"),
+	})
+	defer fakeHTTP.Verify(t)
+
+	f := setupTestFactory(fakeHTTP)
+
+	out := &bytes.Buffer{}
+	gr := &git.RecordingGitRunner{
+		Runner: &git.FakeGitRunner{Outputs: map[string]string{
+			"config glab.currentstack": title,
+			"remote show origin":       "  HEAD branch: main\n",
+		}},
+		Out:    out,
+		DryRun: true,
+	}
+
+	err := mergeFunc(f, &options{io: f.IO, squash: true}, gr)
+	require.NoError(t, err)
+
+	require.Equal(t, heredoc.Doc(`
+		PUT projects/stack_guy/stackproject/merge_requests/1/merge squash=true
+		git fetch origin
+		git checkout Branch2
+		git rebase --update-refs --onto origin/main Branch1
+		git push origin --force-with-lease Branch2
+		update stack ref file .git/stacked/my-stack/2.json
+		delete stack ref file .git/stacked/my-stack/1.json
+		git checkout main
+		git branch -D Branch1
+		PUT projects/stack_guy/stackproject/merge_requests/2 target_branch=main
+		PUT projects/stack_guy/stackproject/merge_requests/2/merge squash=true
+		delete stack ref file .git/stacked/my-stack/2.json
+	`), out.String())
+
+	// nothing was removed from the stack
+	stack, err := git.GatherStackRefs(title)
+	require.NoError(t, err)
+	require.Len(t, stack.Refs, 2)
+}
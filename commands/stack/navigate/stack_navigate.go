@@ -8,12 +8,13 @@ import (
 
 	"github.com/spf13/cobra"
 	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/stack/stackutils"
 	"gitlab.com/gitlab-org/cli/pkg/git"
 	"gitlab.com/gitlab-org/cli/pkg/text"
 )
 
-func baseCommand() (git.Stack, error) {
-	title, err := git.GetCurrentStackTitle()
+func baseCommand(gr git.GitRunner) (git.Stack, error) {
+	title, err := git.GetCurrentStackTitle(gr)
 	if err != nil {
 		return git.Stack{}, err
 	}
@@ -33,26 +34,23 @@ func NewCmdStackFirst(f *cmdutils.Factory) *cobra.Command {
 		Long:    "Moves to the first diff in the stack, and checks out that branch.\n" + text.ExperimentalString,
 		Example: "glab stack first",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			stack, err := baseCommand()
-			if err != nil {
-				return err
-			}
-
-			if stack.Empty() {
-				return errors.New("you are on an empty stack. To use a stack, first save a diff.")
-			}
-
-			ref := stack.First()
-			err = git.CheckoutBranch(ref.Branch)
-			if err != nil {
-				return err
-			}
+			return firstFunc(f, stackutils.GitRunner(cmd, f))
+		},
+	}
+}
 
-			switchMessage(f, &ref)
+func firstFunc(f *cmdutils.Factory, gr git.GitRunner) error {
+	stack, err := baseCommand(gr)
+	if err != nil {
+		return err
+	}
 
-			return nil
-		},
+	if stack.Empty() {
+		return errors.New("you are on an empty stack. To use a stack, first save a diff.")
 	}
+
+	ref := stack.First()
+	return checkoutRef(f, gr, &ref)
 }
 
 func NewCmdStackNext(f *cmdutils.Factory) *cobra.Command {
@@ -62,31 +60,28 @@ func NewCmdStackNext(f *cmdutils.Factory) *cobra.Command {
 		Long:    "Moves to the next diff in the stack, and checks out that branch.\n" + text.ExperimentalString,
 		Example: "glab stack next",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			stack, err := baseCommand()
-			if err != nil {
-				return err
-			}
-
-			ref, err := git.CurrentStackRefFromCurrentBranch(stack.Title)
-			if err != nil {
-				return err
-			}
-
-			if ref.IsLast() {
-				return fmt.Errorf("you are already at the last diff. Use `glab stack list` to see the complete list.")
-			}
+			return nextFunc(f, stackutils.GitRunner(cmd, f))
+		},
+	}
+}
 
-			err = git.CheckoutBranch(stack.Refs[ref.Next].Branch)
-			if err != nil {
-				return err
-			}
+func nextFunc(f *cmdutils.Factory, gr git.GitRunner) error {
+	stack, err := baseCommand(gr)
+	if err != nil {
+		return err
+	}
 
-			next := stack.Refs[ref.Next]
-			switchMessage(f, &next)
+	ref, err := git.CurrentStackRefFromCurrentBranch(stack.Title, gr)
+	if err != nil {
+		return err
+	}
 
-			return nil
-		},
+	if ref.IsLast() {
+		return fmt.Errorf("you are already at the last diff. Use `glab stack list` to see the complete list.")
 	}
+
+	next := stack.Refs[ref.Next]
+	return checkoutRef(f, gr, &next)
 }
 
 func NewCmdStackPrev(f *cmdutils.Factory) *cobra.Command {
@@ -96,31 +91,28 @@ func NewCmdStackPrev(f *cmdutils.Factory) *cobra.Command {
 		Long:    "Moves to the previous diff in the stack, and checks out that branch.\n" + text.ExperimentalString,
 		Example: "glab stack prev",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			stack, err := baseCommand()
-			if err != nil {
-				return err
-			}
-
-			ref, err := git.CurrentStackRefFromCurrentBranch(stack.Title)
-			if err != nil {
-				return err
-			}
-
-			if ref.IsFirst() {
-				return fmt.Errorf("you are already at the first diff. Use `glab stack list` to see the complete list.")
-			}
+			return prevFunc(f, stackutils.GitRunner(cmd, f))
+		},
+	}
+}
 
-			err = git.CheckoutBranch(stack.Refs[ref.Prev].Branch)
-			if err != nil {
-				return err
-			}
+func prevFunc(f *cmdutils.Factory, gr git.GitRunner) error {
+	stack, err := baseCommand(gr)
+	if err != nil {
+		return err
+	}
 
-			prev := stack.Refs[ref.Prev]
-			switchMessage(f, &prev)
+	ref, err := git.CurrentStackRefFromCurrentBranch(stack.Title, gr)
+	if err != nil {
+		return err
+	}
 
-			return nil
-		},
+	if ref.IsFirst() {
+		return fmt.Errorf("you are already at the first diff. Use `glab stack list` to see the complete list.")
 	}
+
+	prev := stack.Refs[ref.Prev]
+	return checkoutRef(f, gr, &prev)
 }
 
 func NewCmdStackLast(f *cmdutils.Factory) *cobra.Command {
@@ -130,27 +122,23 @@ func NewCmdStackLast(f *cmdutils.Factory) *cobra.Command {
 		Long:    "Moves to the last diff in the stack, and checks out that branch.\n" + text.ExperimentalString,
 		Example: "glab stack last",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			stack, err := baseCommand()
-			if err != nil {
-				return err
-			}
-
-			if stack.Empty() {
-				return errors.New("stack is empty until you save a diff.")
-			}
-
-			ref := stack.Last()
-
-			err = git.CheckoutBranch(ref.Branch)
-			if err != nil {
-				return err
-			}
+			return lastFunc(f, stackutils.GitRunner(cmd, f))
+		},
+	}
+}
 
-			switchMessage(f, &ref)
+func lastFunc(f *cmdutils.Factory, gr git.GitRunner) error {
+	stack, err := baseCommand(gr)
+	if err != nil {
+		return err
+	}
 
-			return nil
-		},
+	if stack.Empty() {
+		return errors.New("stack is empty until you save a diff.")
 	}
+
+	ref := stack.Last()
+	return checkoutRef(f, gr, &ref)
 }
 
 func NewCmdStackMove(f *cmdutils.Factory) *cobra.Command {
@@ -160,7 +148,9 @@ func NewCmdStackMove(f *cmdutils.Factory) *cobra.Command {
 		Long:    "Shows a menu with a fuzzy finder to select a stack.\n" + text.ExperimentalString,
 		Example: "glab stack move",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			stack, err := baseCommand()
+			gr := stackutils.GitRunner(cmd, f)
+
+			stack, err := baseCommand(gr)
 			if err != nil {
 				return err
 			}
@@ -190,7 +180,7 @@ func NewCmdStackMove(f *cmdutils.Factory) *cobra.Command {
 				return err
 			}
 
-			err = git.CheckoutBranch(branch)
+			_, err = gr.Git("checkout", branch)
 			if err != nil {
 				return err
 			}
@@ -200,9 +190,23 @@ func NewCmdStackMove(f *cmdutils.Factory) *cobra.Command {
 	}
 }
 
+func checkoutRef(f *cmdutils.Factory, gr git.GitRunner, ref *git.StackRef) error {
+	_, err := gr.Git("checkout", ref.Branch)
+	if err != nil {
+		return err
+	}
+
+	if !git.IsDryRun(gr) {
+		switchMessage(f, ref)
+	}
+
+	return nil
+}
+
 func switchMessage(f *cmdutils.Factory, ref *git.StackRef) {
 	color := f.IO.Color()
-	fmt.Printf(
+	fmt.Fprintf(
+		f.IO.StdOut,
 		"%v Switched to branch: %v - %v\n",
 		color.ProgressIcon(),
 		color.Blue(ref.Branch),
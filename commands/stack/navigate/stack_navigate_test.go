@@ -0,0 +1,93 @@
+package navigate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/git"
+)
+
+func setupStack(t *testing.T) {
+	t.Helper()
+
+	git.InitFakeGitRepo(t)
+
+	refs := []git.StackRef{
+		{SHA: "1", Next: "2", Branch: "Branch1", Description: "first diff"},
+		{SHA: "2", Prev: "1", Next: "3", Branch: "Branch2", Description: "second diff"},
+		{SHA: "3", Prev: "2", Branch: "Branch3", Description: "third diff"},
+	}
+	for _, ref := range refs {
+		require.NoError(t, git.AddStackRefFile("my-stack", ref))
+	}
+}
+
+func Test_navigate(t *testing.T) {
+	tests := []struct {
+		name       string
+		run        func(f *cmdutils.Factory, gr git.GitRunner) error
+		dryRun     bool
+		wantCmd    string
+		wantOutput string
+	}{
+		{
+			name:       "first",
+			run:        firstFunc,
+			wantCmd:    "checkout Branch1",
+			wantOutput: "• Switched to branch: Branch1 - first diff\n",
+		},
+		{
+			name:       "last",
+			run:        lastFunc,
+			wantCmd:    "checkout Branch3",
+			wantOutput: "• Switched to branch: Branch3 - third diff\n",
+		},
+		{
+			name:       "next",
+			run:        nextFunc,
+			wantCmd:    "checkout Branch3",
+			wantOutput: "• Switched to branch: Branch3 - third diff\n",
+		},
+		{
+			name:       "prev",
+			run:        prevFunc,
+			wantCmd:    "checkout Branch1",
+			wantOutput: "• Switched to branch: Branch1 - first diff\n",
+		},
+		{
+			name:       "next in dry run",
+			run:        nextFunc,
+			dryRun:     true,
+			wantOutput: "git checkout Branch3\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			setupStack(t)
+
+			ios, _, stdout, _ := cmdtest.InitIOStreams(true, "")
+			f := cmdtest.InitFactory(ios, nil)
+
+			fake := &git.FakeGitRunner{Outputs: map[string]string{
+				"config glab.currentstack":          "my-stack\n",
+				"symbolic-ref --quiet --short HEAD": "Branch2\n",
+			}}
+			gr := git.NewRecordingGitRunner(stdout, tc.dryRun)
+			gr.Runner = fake
+
+			err := tc.run(f, gr)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.wantOutput, stdout.String())
+			if tc.wantCmd != "" {
+				require.Contains(t, fake.Commands, tc.wantCmd)
+			} else {
+				require.NotContains(t, fake.Commands, "checkout Branch3")
+			}
+		})
+	}
+}
@@ -7,13 +7,13 @@ import (
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/briandowns/spinner"
 
-	"gitlab.com/gitlab-org/cli/internal/run"
 	"gitlab.com/gitlab-org/cli/pkg/git"
 	"gitlab.com/gitlab-org/cli/pkg/text"
 
 	"github.com/spf13/cobra"
 
 	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/stack/stackutils"
 )
 
 func NewCmdAmendStack(f *cmdutils.Factory, getText cmdutils.GetTextUsingEditor) *cobra.Command {
@@ -26,7 +26,7 @@ func NewCmdAmendStack(f *cmdutils.Factory, getText cmdutils.GetTextUsingEditor)
 			glab stack amend . -m "fixed a function"
 			glab stack amend newfile -d "forgot to add this"`),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			output, err := amendFunc(f, args, getText, description)
+			output, err := amendFunc(f, args, getText, description, stackutils.GitRunner(cmd, f))
 			if err != nil {
 				return fmt.Errorf("could not run stack amend: %v", err)
 			}
@@ -45,20 +45,20 @@ func NewCmdAmendStack(f *cmdutils.Factory, getText cmdutils.GetTextUsingEditor)
 	return stackSaveCmd
 }
 
-func amendFunc(f *cmdutils.Factory, args []string, getText cmdutils.GetTextUsingEditor, description string) (string, error) {
+func amendFunc(f *cmdutils.Factory, args []string, getText cmdutils.GetTextUsingEditor, description string, gr git.GitRunner) (string, error) {
 	// check if there are even any changes before we start
-	err := checkForChanges()
+	err := checkForChanges(gr)
 	if err != nil {
 		return "", fmt.Errorf("could not save: %v", err)
 	}
 
 	// get stack title
-	title, err := git.GetCurrentStackTitle()
+	title, err := git.GetCurrentStackTitle(gr)
 	if err != nil {
 		return "", fmt.Errorf("error running Git command: %v", err)
 	}
 
-	ref, err := git.CurrentStackRefFromCurrentBranch(title)
+	ref, err := git.CurrentStackRefFromCurrentBranch(title, gr)
 	if err != nil {
 		return "", fmt.Errorf("error checking for stack: %v", err)
 	}
@@ -78,19 +78,19 @@ func amendFunc(f *cmdutils.Factory, args []string, getText cmdutils.GetTextUsing
 	s := spinner.New(spinner.CharSets[11], 100*time.Millisecond)
 
 	// git add files
-	_, err = addFiles(args[0:])
+	_, err = addFiles(args[0:], gr)
 	if err != nil {
 		return "", fmt.Errorf("error adding files: %v", err)
 	}
 
 	// run the amend commit
-	err = gitAmend(description)
+	err = gitAmend(description, gr)
 	if err != nil {
 		return "", fmt.Errorf("error amending commit with Git: %v", err)
 	}
 
 	var output string
-	if f.IO.IsOutputTTY() {
+	if f.IO.IsOutputTTY() && !git.IsDryRun(gr) {
 		output = fmt.Sprintf("Amended stack item with description: %q.\n", description)
 	}
 
@@ -99,14 +99,15 @@ func amendFunc(f *cmdutils.Factory, args []string, getText cmdutils.GetTextUsing
 	return output, nil
 }
 
-func gitAmend(description string) error {
-	amendCmd := git.GitCommand("commit", "--amend", "-m", description)
-	output, err := run.PrepareCmd(amendCmd).Output()
+func gitAmend(description string, gr git.GitRunner) error {
+	output, err := gr.Git("commit", "--amend", "-m", description)
 	if err != nil {
 		return fmt.Errorf("error running Git command: %v", err)
 	}
 
-	fmt.Println("Amend commit: ", string(output))
+	if !git.IsDryRun(gr) {
+		fmt.Println("Amend commit: ", output)
+	}
 
 	return nil
 }
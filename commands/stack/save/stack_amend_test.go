@@ -6,7 +6,6 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"gitlab.com/gitlab-org/cli/commands/cmdtest"
-	"gitlab.com/gitlab-org/cli/internal/run"
 	"gitlab.com/gitlab-org/cli/pkg/git"
 )
 
@@ -63,36 +62,46 @@ func Test_stackAmendCmd(t *testing.T) {
 			ios, _, _, _ := cmdtest.InitIOStreams(true, "")
 			f := cmdtest.InitFactory(ios, nil)
 
-			dir := git.InitGitRepoWithCommit(t)
-			err := git.SetLocalConfig("glab.currentstack", "cool-test-feature")
-			require.Nil(t, err)
-
+			dir := initFakeGitRepo(t)
 			createTemporaryFiles(t, dir, tc.files)
+			createTemporaryFiles(t, dir, tc.amendedFiles)
 
-			var saveArgs []string
-			saveArgs = append(saveArgs, "-m")
-			saveArgs = append(saveArgs, "\"original save message\"")
-			saveArgs = append(saveArgs, tc.args...)
-
-			getText := getMockEditor(tc.editorMessage, &[]string{})
-			_, err = runSaveCommand(nil, getText, true, strings.Join(saveArgs, " "))
+			err := git.AddStackRefFile("cool-test-feature", git.StackRef{
+				SHA: "1a2b3c4d", Branch: "cool-test-feature-1a2b3c4d", Description: "original save message",
+			})
 			require.Nil(t, err)
 
-			createTemporaryFiles(t, dir, tc.amendedFiles)
+			branch := "cool-test-feature-1a2b3c4d"
 			if tc.desc == "not on a stack branch" {
-				checkout := git.GitCommand("checkout", "-b", "randobranch")
-				_, err := run.PrepareCmd(checkout).Output()
+				branch = "randobranch"
+			}
 
-				require.Nil(t, err)
+			var status strings.Builder
+			for _, file := range tc.amendedFiles {
+				status.WriteString("?? " + file + "\n")
+			}
+
+			description := tc.description
+			if description == "" {
+				description = tc.editorMessage
 			}
 
-			output, err := amendFunc(f, tc.args, getText, tc.description)
+			fake := &git.FakeGitRunner{Outputs: map[string]string{
+				"status --porcelain":                status.String(),
+				"config glab.currentstack":          "cool-test-feature\n",
+				"symbolic-ref --quiet --short HEAD": branch + "\n",
+			}}
+
+			getText := getMockEditor(tc.editorMessage, &[]string{})
+			output, err := amendFunc(f, tc.args, getText, tc.description, fake)
 
 			if tc.wantErr {
 				require.ErrorContains(t, err, tc.expected)
 			} else {
 				require.Nil(t, err)
 				require.Equal(t, tc.expected, output)
+				require.Contains(t, fake.Commands, "add "+strings.Join(tc.args, " "))
+				require.Contains(t, fake.Commands, "commit --amend -m "+description)
 			}
 		})
 	}
@@ -10,13 +10,13 @@ import (
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/briandowns/spinner"
-	"gitlab.com/gitlab-org/cli/internal/run"
 	"gitlab.com/gitlab-org/cli/pkg/git"
 	"gitlab.com/gitlab-org/cli/pkg/text"
 	"golang.org/x/crypto/sha3"
 
 	"github.com/spf13/cobra"
 	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/stack/stackutils"
 )
 
 var description string
@@ -36,8 +36,10 @@ func NewCmdSaveStack(f *cmdutils.Factory, getText cmdutils.GetTextUsingEditor) *
 				return &cmdutils.FlagError{Err: errors.New("specify either of --message or --description.")}
 			}
 
+			gr := stackutils.GitRunner(cmd, f)
+
 			// check if there are even any changes before we start
-			err := checkForChanges()
+			err := checkForChanges(gr)
 			if err != nil {
 				return fmt.Errorf("could not save: %v", err)
 			}
@@ -53,24 +55,24 @@ func NewCmdSaveStack(f *cmdutils.Factory, getText cmdutils.GetTextUsingEditor) *
 			s := spinner.New(spinner.CharSets[11], 100*time.Millisecond)
 
 			// git add files
-			_, err = addFiles(args[0:])
+			_, err = addFiles(args[0:], gr)
 			if err != nil {
 				return fmt.Errorf("error adding files: %v", err)
 			}
 
 			// get stack title
-			title, err := git.GetCurrentStackTitle()
+			title, err := git.GetCurrentStackTitle(gr)
 			if err != nil {
 				return fmt.Errorf("error running Git command: %v", err)
 			}
 
-			author, err := git.GitUserName()
+			author, err := gr.Git("config", "user.name")
 			if err != nil {
 				return fmt.Errorf("error getting Git author: %v", err)
 			}
 
 			// generate a SHA based on: commit message, stack title, Git author name
-			sha, err := generateStackSha(description, title, string(author), time.Now())
+			sha, err := generateStackSha(description, title, strings.TrimSpace(author), time.Now())
 			if err != nil {
 				return fmt.Errorf("error generating hash for stack branch name: %v", err)
 			}
@@ -82,13 +84,13 @@ func NewCmdSaveStack(f *cmdutils.Factory, getText cmdutils.GetTextUsingEditor) *
 			}
 
 			// create the branch prefix-stack_title-SHA
-			err = git.CheckoutNewBranch(branch)
+			_, err = gr.Git("checkout", "-b", branch)
 			if err != nil {
 				return fmt.Errorf("error running branch checkout: %v", err)
 			}
 
 			// commit files to branch
-			_, err = commitFiles(description)
+			_, err = commitFiles(description, gr)
 			if err != nil {
 				return fmt.Errorf("error committing files: %v", err)
 			}
@@ -103,13 +105,9 @@ func NewCmdSaveStack(f *cmdutils.Factory, getText cmdutils.GetTextUsingEditor) *
 				lastRef := stack.Last()
 
 				// update the ref before it (the current last ref)
-				err = git.UpdateStackRefFile(title, git.StackRef{
-					Prev:        lastRef.Prev,
-					MR:          lastRef.MR,
-					Description: lastRef.Description,
-					SHA:         lastRef.SHA,
-					Branch:      lastRef.Branch,
-					Next:        sha,
+				lastRef.Next = sha
+				err = git.RunOperation(gr, git.StackRefFileOperation("update", title, lastRef), func() error {
+					return git.UpdateStackRefFile(title, lastRef)
 				})
 				if err != nil {
 					return fmt.Errorf("error updating old ref: %v", err)
@@ -120,12 +118,14 @@ func NewCmdSaveStack(f *cmdutils.Factory, getText cmdutils.GetTextUsingEditor) *
 				stackRef = git.StackRef{SHA: sha, Branch: branch, Description: description}
 			}
 
-			err = git.AddStackRefFile(title, stackRef)
+			err = git.RunOperation(gr, git.StackRefFileOperation("create", title, stackRef), func() error {
+				return git.AddStackRefFile(title, stackRef)
+			})
 			if err != nil {
 				return fmt.Errorf("error creating stack file: %v", err)
 			}
 
-			if f.IO.IsOutputTTY() {
+			if f.IO.IsOutputTTY() && !git.IsDryRun(gr) {
 				color := f.IO.Color()
 
 				fmt.Fprintf(
@@ -148,21 +148,20 @@ func NewCmdSaveStack(f *cmdutils.Factory, getText cmdutils.GetTextUsingEditor) *
 	return stackSaveCmd
 }
 
-func checkForChanges() error {
-	gitCmd := git.GitCommand("status", "--porcelain")
-	output, err := run.PrepareCmd(gitCmd).Output()
+func checkForChanges(gr git.GitRunner) error {
+	output, err := gr.Git("status", "--porcelain")
 	if err != nil {
 		return fmt.Errorf("error running Git status: %v", err)
 	}
 
-	if string(output) == "" {
+	if output == "" {
 		return fmt.Errorf("no changes to save.")
 	}
 
 	return nil
 }
 
-func addFiles(args []string) (files []string, err error) {
+func addFiles(args []string, gr git.GitRunner) (files []string, err error) {
 	if len(args) == 0 {
 		args = []string{"."}
 	}
@@ -176,10 +175,7 @@ func addFiles(args []string) (files []string, err error) {
 		files = append(files, file)
 	}
 
-	cmdargs := append([]string{"add"}, args...)
-	gitCmd := git.GitCommand(cmdargs...)
-
-	_, err = run.PrepareCmd(gitCmd).Output()
+	_, err = gr.Git(append([]string{"add"}, args...)...)
 	if err != nil {
 		return []string{}, fmt.Errorf("error running Git add: %v", err)
 	}
@@ -187,14 +183,13 @@ func addFiles(args []string) (files []string, err error) {
 	return files, err
 }
 
-func commitFiles(message string) (string, error) {
-	commitCmd := git.GitCommand("commit", "-m", message)
-	output, err := run.PrepareCmd(commitCmd).Output()
+func commitFiles(message string, gr git.GitRunner) (string, error) {
+	output, err := gr.Git("commit", "-m", message)
 	if err != nil {
 		return "", fmt.Errorf("error running Git command: %v", err)
 	}
 
-	return string(output), nil
+	return output, nil
 }
 
 func generateStackSha(message string, title string, author string, timestamp time.Time) (string, error) {
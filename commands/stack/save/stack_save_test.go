@@ -2,6 +2,7 @@ package save
 
 import (
 	"bytes"
+	"errors"
 	"io"
 	"net/http"
 	"os"
@@ -15,7 +16,6 @@ import (
 	"gitlab.com/gitlab-org/cli/commands/cmdtest"
 	"gitlab.com/gitlab-org/cli/commands/cmdutils"
 	"gitlab.com/gitlab-org/cli/internal/config"
-	"gitlab.com/gitlab-org/cli/internal/run"
 	"gitlab.com/gitlab-org/cli/pkg/git"
 	"gitlab.com/gitlab-org/cli/pkg/iostreams"
 	"gitlab.com/gitlab-org/cli/test"
@@ -122,50 +122,57 @@ func TestSaveNewStack(t *testing.T) {
 
 func Test_addFiles(t *testing.T) {
 	tests := []struct {
-		desc     string
-		args     []string
-		expected []string
+		desc        string
+		args        []string
+		files       []string
+		wantFiles   []string
+		wantCommand string
+		wantErr     bool
 	}{
 		{
-			desc:     "adding regular files",
-			args:     []string{"file1", "file2"},
-			expected: []string{"file1", "file2"},
+			desc:        "adding regular files",
+			args:        []string{"file1", "file2"},
+			files:       []string{"file1", "file2"},
+			wantFiles:   []string{"file1", "file2"},
+			wantCommand: "add file1 file2",
 		},
 		{
-			desc:     "adding files with a dot argument",
-			args:     []string{"."},
-			expected: []string{"file1", "file2"},
+			desc:        "adding files with a dot argument",
+			args:        []string{"."},
+			files:       []string{"file1", "file2"},
+			wantFiles:   []string{"."},
+			wantCommand: "add .",
 		},
 		{
-			desc:     "adding files with no argument",
-			expected: []string{"file1", "file2"},
+			desc:        "adding files with no argument",
+			files:       []string{"file1", "file2"},
+			wantFiles:   []string{"."},
+			wantCommand: "add .",
+		},
+		{
+			desc:    "adding a missing file",
+			args:    []string{"file1", "missing"},
+			files:   []string{"file1"},
+			wantErr: true,
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
-			dir := git.InitGitRepoWithCommit(t)
-			err := git.SetLocalConfig("glab.currentstack", "cool-test-feature")
-			require.Nil(t, err)
-
-			createTemporaryFiles(t, dir, tc.expected)
-
-			_, err = addFiles(tc.args)
-			require.Nil(t, err)
-
-			gitCmd := git.GitCommand("status", "--short", "-u")
-			output, err := run.PrepareCmd(gitCmd).Output()
-			require.Nil(t, err)
-
-			normalizedFiles := []string{}
-			for _, file := range tc.expected {
-				file = "A  " + file
+			dir := initFakeGitRepo(t)
+			createTemporaryFiles(t, dir, tc.files)
 
-				normalizedFiles = append(normalizedFiles, file)
+			fake := &git.FakeGitRunner{}
+			files, err := addFiles(tc.args, fake)
+			if tc.wantErr {
+				require.Error(t, err)
+				require.Empty(t, fake.Commands)
+				return
 			}
+			require.Nil(t, err)
 
-			formattedOutput := strings.Replace(string(output), "\n", "", -1)
-			require.Equal(t, formattedOutput, strings.Join(normalizedFiles, ""))
+			require.Equal(t, tc.wantFiles, files)
+			require.Equal(t, []string{tc.wantCommand}, fake.Commands)
 		})
 	}
 }
@@ -173,35 +180,32 @@ func Test_addFiles(t *testing.T) {
 func Test_checkForChanges(t *testing.T) {
 	tests := []struct {
 		desc     string
-		args     []string
+		status   string
 		expected bool
 	}{
 		{
 			desc:     "check for changes with modified files",
-			args:     []string{"file1", "file2"},
+			status:   "?? file1\n?? file2\n",
 			expected: true,
 		},
 		{
 			desc:     "check for changes without anything",
-			args:     []string{},
+			status:   "",
 			expected: false,
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
-			dir := git.InitGitRepoWithCommit(t)
-			err := git.SetLocalConfig("glab.currentstack", "cool-test-feature")
-			require.Nil(t, err)
-
-			createTemporaryFiles(t, dir, tc.args)
+			fake := &git.FakeGitRunner{Outputs: map[string]string{"status --porcelain": tc.status}}
 
-			err = checkForChanges()
+			err := checkForChanges(fake)
 			if tc.expected {
 				require.Nil(t, err)
 			} else {
 				require.Error(t, err)
 			}
+			require.Equal(t, []string{"status --porcelain"}, fake.Commands)
 		})
 	}
 }
@@ -216,7 +220,7 @@ func Test_commitFiles(t *testing.T) {
 		{
 			name:    "a regular commit message",
 			message: "i am a test message",
-			want:    "i am a test message\n 2 files changed, 0 insertions(+), 0 deletions(-)\n create mode 100644 test\n create mode 100644 yo\n",
+			want:    "i am a test message\n 2 files changed, 0 insertions(+), 0 deletions(-)\n",
 		},
 		{
 			name:    "no message",
@@ -225,13 +229,16 @@ func Test_commitFiles(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			dir := git.InitGitRepoWithCommit(t)
-
-			createTemporaryFiles(t, dir, []string{"yo", "test"})
-			_, err := addFiles([]string{"."})
-			require.Nil(t, err)
+			fake := &git.FakeGitRunner{
+				Outputs: map[string]string{
+					"commit -m i am a test message": "[main 1234567] i am a test message\n 2 files changed, 0 insertions(+), 0 deletions(-)\n",
+				},
+				Errors: map[string]error{
+					"commit -m ": errors.New("Aborting commit due to empty commit message."),
+				},
+			}
 
-			got, err := commitFiles(tt.message)
+			got, err := commitFiles(tt.message, fake)
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -239,6 +246,7 @@ func Test_commitFiles(t *testing.T) {
 				require.Nil(t, err)
 				require.Contains(t, got, tt.want)
 			}
+			require.Equal(t, []string{"commit -m " + tt.message}, fake.Commands)
 		})
 	}
 }
@@ -331,6 +339,17 @@ func Test_createShaBranch(t *testing.T) {
 	}
 }
 
+// initFakeGitRepo creates a directory for the stack ref files of tests that run
+// Git with a FakeGitRunner, and changes to it.
+func initFakeGitRepo(t *testing.T) string {
+	t.Helper()
+
+	dir := git.InitFakeGitRepo(t)
+	require.NoError(t, os.Chdir(dir))
+
+	return dir
+}
+
 func createTemporaryFiles(t *testing.T, dir string, files []string) {
 	for _, file := range files {
 		file = path.Join(dir, file)
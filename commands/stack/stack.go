@@ -2,10 +2,13 @@ package stack
 
 import (
 	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	stackAdoptCmd "gitlab.com/gitlab-org/cli/commands/stack/adopt"
 	stackCreateCmd "gitlab.com/gitlab-org/cli/commands/stack/create"
 	stackListCmd "gitlab.com/gitlab-org/cli/commands/stack/list"
+	stackMergeCmd "gitlab.com/gitlab-org/cli/commands/stack/merge"
 	stackMoveCmd "gitlab.com/gitlab-org/cli/commands/stack/navigate"
 	stackSaveCmd "gitlab.com/gitlab-org/cli/commands/stack/save"
+	"gitlab.com/gitlab-org/cli/commands/stack/stackutils"
 	stackSwitchCmd "gitlab.com/gitlab-org/cli/commands/stack/switch"
 	stackSyncCmd "gitlab.com/gitlab-org/cli/commands/stack/sync"
 	"gitlab.com/gitlab-org/cli/pkg/surveyext"
@@ -34,6 +37,7 @@ func NewCmdStack(f *cmdutils.Factory) *cobra.Command {
 	}
 
 	cmdutils.EnableRepoOverride(stackCmd, f)
+	stackutils.EnableDryRun(stackCmd)
 	getTextFromEditor := wrappedEdit(f)
 
 	stackCmd.AddCommand(stackCreateCmd.NewCmdCreateStack(f))
@@ -47,6 +51,8 @@ func NewCmdStack(f *cmdutils.Factory) *cobra.Command {
 	stackCmd.AddCommand(stackMoveCmd.NewCmdStackMove(f))
 	stackCmd.AddCommand(stackListCmd.NewCmdStackList(f))
 	stackCmd.AddCommand(stackSwitchCmd.NewCmdStackSwitch(f))
+	stackCmd.AddCommand(stackAdoptCmd.NewCmdAdoptStack(f))
+	stackCmd.AddCommand(stackMergeCmd.NewCmdMergeStack(f))
 
 	return stackCmd
 }
@@ -0,0 +1,53 @@
+package stackutils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/git"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+const FlagDryRun = "dry-run"
+
+// EnableDryRun adds the --dry-run flag to the stack command and its subcommands.
+func EnableDryRun(cmd *cobra.Command) {
+	cmd.PersistentFlags().Bool(FlagDryRun, false, "Print the Git commands and API operations that would run, without running them.")
+}
+
+// GitRunner returns the GitRunner that a stack command uses for its
+// Git commands and other operations. It is in dry-run mode with --dry-run.
+func GitRunner(cmd *cobra.Command, f *cmdutils.Factory) *git.RecordingGitRunner {
+	dryRun, _ := cmd.Flags().GetBool(FlagDryRun)
+
+	return git.NewRecordingGitRunner(f.IO.StdOut, dryRun)
+}
+
+// ErrorString formats an error of a stack command: a title, and lines that
+// explain how to fix it.
+func ErrorString(io *iostreams.IOStreams, lines ...string) string {
+	redCheck := io.Color().Red("✘")
+
+	title := lines[0]
+	body := strings.Join(lines[1:], "\n  ")
+
+	return fmt.Sprintf("\n%s %s \n  %s", redCheck, title, body)
+}
+
+// ProgressString formats a step of a stack command: a title, and optional
+// lines with details.
+func ProgressString(io *iostreams.IOStreams, lines ...string) string {
+	blueDot := io.Color().ProgressIcon()
+	title := lines[0]
+
+	var body string
+
+	if len(lines) > 1 {
+		body = strings.Join(lines[1:], "\n  ")
+		return fmt.Sprintf("\n%s %s \n  %s", blueDot, title, body)
+	}
+	return fmt.Sprintf("\n%s %s\n", blueDot, title)
+}
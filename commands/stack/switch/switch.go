@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/stack/stackutils"
 	"gitlab.com/gitlab-org/cli/pkg/git"
 	"gitlab.com/gitlab-org/cli/pkg/text"
 )
@@ -22,7 +23,7 @@ func NewCmdStackSwitch(f *cmdutils.Factory) *cobra.Command {
 		),
 		Example: "glab stack switch <stack-name>",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if err := switchFunc(f, args[0]); err != nil {
+			if err := switchFunc(f, args[0], stackutils.GitRunner(cmd, f)); err != nil {
 				return fmt.Errorf("switching stacks failed: %w", err)
 			}
 			return nil
@@ -32,8 +33,8 @@ func NewCmdStackSwitch(f *cmdutils.Factory) *cobra.Command {
 	return stackSwitchCmd
 }
 
-func switchFunc(f *cmdutils.Factory, name string) error {
-	currentStackTitle, err := git.GetCurrentStackTitle()
+func switchFunc(f *cmdutils.Factory, name string, gr git.GitRunner) error {
+	currentStackTitle, err := git.GetCurrentStackTitle(gr)
 	if err != nil {
 		return fmt.Errorf("error getting current stack: %v", err)
 	}
@@ -57,11 +58,15 @@ func switchFunc(f *cmdutils.Factory, name string) error {
 		return fmt.Errorf("no stack named %q found", name)
 	}
 
-	err = git.SetLocalConfig("glab.currentstack", name)
+	err = git.SetCurrentStackTitle(name, gr)
 	if err != nil {
 		return fmt.Errorf("error setting local Git config: %w", err)
 	}
 
+	if git.IsDryRun(gr) {
+		return nil
+	}
+
 	fmt.Fprintf(f.IO.StdOut, "Switched to stack %s.\n", name)
 	return nil
 }
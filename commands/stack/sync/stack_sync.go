@@ -16,6 +16,7 @@ import (
 	"gitlab.com/gitlab-org/cli/commands/cmdutils"
 	"gitlab.com/gitlab-org/cli/commands/mr/create"
 	"gitlab.com/gitlab-org/cli/commands/mr/mrutils"
+	"gitlab.com/gitlab-org/cli/commands/stack/stackutils"
 	"gitlab.com/gitlab-org/cli/internal/config"
 	"gitlab.com/gitlab-org/cli/internal/glrepo"
 	"gitlab.com/gitlab-org/cli/pkg/git"
@@ -74,9 +75,7 @@ func NewCmdSyncStack(f *cmdutils.Factory) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			iostream.StartSpinner("Syncing")
 
-			var gr git.StandardGitCommand
-
-			err := stackSync(f, iostream, opts, gr)
+			err := stackSync(f, iostream, opts, stackutils.GitRunner(cmd, f))
 			iostream.StopSpinner("")
 			if err != nil {
 				return fmt.Errorf("could not run sync: %v", err)
@@ -111,7 +110,7 @@ func stackSync(f *cmdutils.Factory, iostream *iostreams.IOStreams, opts *Options
 
 	iostream.StartSpinner("Syncing")
 
-	stack, err := getStack()
+	stack, err := getStack(gr)
 	if err != nil {
 		return fmt.Errorf("error getting current stack: %v", err)
 	}
@@ -175,7 +174,7 @@ func stackSync(f *cmdutils.Factory, iostream *iostreams.IOStreams, opts *Options
 			// remove the MR from the stack if it's merged
 			// do not remove the MR from the stack if it is closed,
 			// but alert the user
-			err = removeOldMrs(&ref, mr, &stack)
+			err = removeOldMrs(&ref, mr, &stack, gr)
 			if err != nil {
 				return fmt.Errorf("error removing merged merge request: %v", err)
 			}
@@ -189,12 +188,12 @@ func stackSync(f *cmdutils.Factory, iostream *iostreams.IOStreams, opts *Options
 		}
 	}
 
-	fmt.Print(progressString("Sync finished!"))
+	fmt.Print(stackutils.ProgressString(iostream, "Sync finished!"))
 	return nil
 }
 
-func getStack() (git.Stack, error) {
-	title, err := git.GetCurrentStackTitle()
+func getStack(gr git.GitRunner) (git.Stack, error) {
+	title, err := git.GetCurrentStackTitle(gr)
 	if err != nil {
 		return git.Stack{}, fmt.Errorf("error getting current stack: %v", err)
 	}
@@ -262,7 +261,7 @@ func rebaseWithUpdateRefs(ref *git.StackRef, stack *git.Stack, gr git.GitRunner)
 }
 
 func forcePushAllWithLease(stack *git.Stack, gr git.GitRunner) error {
-	fmt.Print(progressString(
+	fmt.Print(stackutils.ProgressString(iostream,
 		"Updating branches:",
 		strings.Join(stack.Branches(), ", "),
 	))
@@ -275,7 +274,7 @@ func forcePushAllWithLease(stack *git.Stack, gr git.GitRunner) error {
 		return err
 	}
 
-	fmt.Print(progressString("Push succeeded: " + output))
+	fmt.Print(stackutils.ProgressString(iostream, "Push succeeded: "+output))
 	return nil
 }
 
@@ -293,7 +292,7 @@ func createMR(client *gitlab.Client, opts *Options, ref *git.StackRef, gr git.Gi
 	var previousBranch string
 	if ref.IsFirst() {
 		// Point to the default one
-		previousBranch, err = getDefaultBranch(git.DefaultRemote, gr)
+		previousBranch, err = git.DefaultBranchWithRunner(git.DefaultRemote, gr)
 		if err != nil {
 			return &gitlab.MergeRequest{}, fmt.Errorf("error getting default branch: %v", err)
 		}
@@ -318,7 +317,12 @@ func createMR(client *gitlab.Client, opts *Options, ref *git.StackRef, gr git.Gi
 		TargetProjectID:    gitlab.Ptr(targetProject.ID),
 	}
 
-	mr, err := api.CreateMR(client, opts.source.FullName(), l)
+	var mr *gitlab.MergeRequest
+	operation := fmt.Sprintf("POST projects/%s/merge_requests source_branch=%s target_branch=%s", opts.source.FullName(), ref.Branch, previousBranch)
+	err = git.RunOperation(gr, operation, func() error {
+		mr, err = api.CreateMR(client, opts.source.FullName(), l)
+		return err
+	})
 	if err != nil {
 		return &gitlab.MergeRequest{}, fmt.Errorf("error creating merge request with the API: %v", err)
 	}
@@ -326,18 +330,18 @@ func createMR(client *gitlab.Client, opts *Options, ref *git.StackRef, gr git.Gi
 	return mr, nil
 }
 
-func removeOldMrs(ref *git.StackRef, mr *gitlab.MergeRequest, stack *git.Stack) error {
+func removeOldMrs(ref *git.StackRef, mr *gitlab.MergeRequest, stack *git.Stack, gr git.GitRunner) error {
 	if mr.State == mergedStatus {
 		progress := fmt.Sprintf("Merge request !%v has merged. Removing reference...", mr.IID)
-		fmt.Println(progressString(progress))
+		fmt.Println(stackutils.ProgressString(iostream, progress))
 
-		err := stack.RemoveRef(*ref)
+		err := stack.RemoveRef(*ref, gr)
 		if err != nil {
 			return err
 		}
 	} else if mr.State == closedStatus {
 		progress := fmt.Sprintf("MR !%v has closed", mr.IID)
-		fmt.Println(progressString(progress))
+		fmt.Println(stackutils.ProgressString(iostream, progress))
 	}
 	return nil
 }
@@ -361,28 +365,6 @@ func authWithGitlab(f *cmdutils.Factory, opts *Options) (*gitlab.Client, error)
 	return opts.LabClient, nil
 }
 
-func errorString(lines ...string) string {
-	redCheck := iostream.Color().Red("✘")
-
-	title := lines[0]
-	body := strings.Join(lines[1:], "\n  ")
-
-	return fmt.Sprintf("\n%s %s \n  %s", redCheck, title, body)
-}
-
-func progressString(lines ...string) string {
-	blueDot := iostream.Color().ProgressIcon()
-	title := lines[0]
-
-	var body string
-
-	if len(lines) > 1 {
-		body = strings.Join(lines[1:], "\n  ")
-		return fmt.Sprintf("\n%s %s \n  %s", blueDot, title, body)
-	}
-	return fmt.Sprintf("\n%s %s\n", blueDot, title)
-}
-
 func debug(output ...string) {
 	if os.Getenv("DEBUG") != "" {
 		log.Print(output)
@@ -390,11 +372,11 @@ func debug(output ...string) {
 }
 
 func branchDiverged(ref *git.StackRef, stack *git.Stack, gr git.GitRunner) (bool, error) {
-	fmt.Println(progressString(ref.Branch + " has diverged. Rebasing..."))
+	fmt.Println(stackutils.ProgressString(iostream, ref.Branch+" has diverged. Rebasing..."))
 
 	err := rebaseWithUpdateRefs(ref, stack, gr)
 	if err != nil {
-		return false, errors.New(errorString(
+		return false, errors.New(stackutils.ErrorString(iostream,
 			"could not rebase, likely due to a merge conflict.",
 			"Fix the issues with Git and run `glab stack sync` again.",
 		))
@@ -406,7 +388,7 @@ func branchDiverged(ref *git.StackRef, stack *git.Stack, gr git.GitRunner) (bool
 func branchBehind(ref *git.StackRef, gr git.GitRunner) error {
 	// possibly someone applied suggestions or someone else added a
 	// different commit
-	fmt.Println(progressString(ref.Branch + " is behind - pulling updates."))
+	fmt.Println(stackutils.ProgressString(iostream, ref.Branch+" is behind - pulling updates."))
 
 	_, err := gitPull(gr)
 	if err != nil {
@@ -418,36 +400,28 @@ func branchBehind(ref *git.StackRef, gr git.GitRunner) error {
 
 func populateMR(ref *git.StackRef, opts *Options, client *gitlab.Client, gr git.GitRunner) error {
 	// no MR - lets create one!
-	fmt.Println(progressString(ref.Branch + " needs a merge request. Creating it now."))
+	fmt.Println(stackutils.ProgressString(iostream, ref.Branch+" needs a merge request. Creating it now."))
 
 	mr, err := createMR(client, opts, ref, gr)
 	if err != nil {
 		return fmt.Errorf("error updating stack ref files: %v", err)
 	}
 
-	fmt.Println(progressString("Merge request created!"))
+	if git.IsDryRun(gr) {
+		return nil
+	}
+
+	fmt.Println(stackutils.ProgressString(iostream, "Merge request created!"))
 	fmt.Println(mrutils.DisplayMR(iostream.Color(), mr, true))
 
 	// update the ref
 	ref.MR = mr.WebURL
-	err = git.UpdateStackRefFile(opts.stack.Title, *ref)
+	err = git.RunOperation(gr, git.StackRefFileOperation("update", opts.stack.Title, *ref), func() error {
+		return git.UpdateStackRefFile(opts.stack.Title, *ref)
+	})
 	if err != nil {
 		return fmt.Errorf("error updating stack ref files: %v", err)
 	}
 
 	return nil
 }
-
-func getDefaultBranch(remote string, gr git.GitRunner) (string, error) {
-	defBranchOutput, err := gr.Git("remote", "show", remote)
-	if err != nil {
-		return "", err
-	}
-
-	branch, err := git.ParseDefaultBranch([]byte(defBranchOutput))
-	if err != nil {
-		return "", err
-	}
-
-	return branch, nil
-}
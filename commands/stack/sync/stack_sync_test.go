@@ -193,6 +193,7 @@ func Test_stackSync(t *testing.T) {
 			stack, err := git.GatherStackRefs(tc.args.stack.title)
 			require.NoError(t, err)
 
+			mockCmd.EXPECT().Git([]string{"config", "glab.currentstack"}).Return(tc.args.stack.title, nil)
 			mockCmd.EXPECT().Git([]string{"fetch", "origin"})
 
 			for ref := range stack.Iter() {
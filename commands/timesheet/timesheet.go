@@ -0,0 +1,298 @@
+package timesheet
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/pkg/tableprinter"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+const dateFormat = "2006-01-02"
+
+// now is replaced in tests.
+var now = time.Now
+
+type options struct {
+	since        string
+	until        string
+	user         string
+	group        string
+	by           string
+	outputFormat string
+}
+
+// row is the time spent on an issue or merge request, a project, a day, or by a user,
+// or a single timelog.
+type row struct {
+	Date      string `json:"date,omitempty"`
+	User      string `json:"user,omitempty"`
+	Project   string `json:"project,omitempty"`
+	Reference string `json:"reference,omitempty"`
+	Title     string `json:"title,omitempty"`
+	WebURL    string `json:"web_url,omitempty"`
+	Summary   string `json:"summary,omitempty"`
+	Seconds   int    `json:"seconds"`
+}
+
+type timesheet struct {
+	Since   string `json:"since"`
+	Until   string `json:"until"`
+	By      string `json:"by"`
+	Seconds int    `json:"seconds"`
+	Rows    []*row `json:"rows"`
+}
+
+// column is a column of the table and of the CSV export.
+type column struct {
+	header string
+	value  func(r *row) string
+}
+
+var (
+	dateColumn      = column{"date", func(r *row) string { return r.Date }}
+	userColumn      = column{"user", func(r *row) string { return r.User }}
+	projectColumn   = column{"project", func(r *row) string { return r.Project }}
+	referenceColumn = column{"reference", func(r *row) string { return r.Reference }}
+	titleColumn     = column{"title", func(r *row) string { return r.Title }}
+	summaryColumn   = column{"summary", func(r *row) string { return r.Summary }}
+)
+
+// columns are the columns of each type of grouping, before the time spent.
+var columns = map[string][]column{
+	"issue":   {referenceColumn, titleColumn},
+	"project": {projectColumn},
+	"day":     {dateColumn},
+	"user":    {userColumn},
+	"entry":   {dateColumn, userColumn, projectColumn, referenceColumn, titleColumn, summaryColumn},
+}
+
+func NewCmdTimesheet(f *cmdutils.Factory) *cobra.Command {
+	opts := &options{}
+
+	timesheetCmd := &cobra.Command{
+		Use:   "timesheet [flags]",
+		Short: `Report the time spent on issues and merge requests.`,
+		Long: heredoc.Doc(`
+			Report the time spent on the issues and merge requests of a project or of a
+			group, from the time logged with 'glab issue time add', 'glab mr time add', or
+			the /spend quick action.
+
+			The time spent is grouped by issue or merge request, project, day, or user.
+			Use --by entry to list each logged time. By default, the report covers the
+			current month.
+
+			Export the report with --output csv, for example for billing.
+		`),
+		Example: heredoc.Doc(`
+			glab timesheet
+			glab timesheet --since 2025-06-01 --until 2025-06-30 --user @me
+			glab timesheet --group my-group --by project
+			glab timesheet --by entry --output csv > timesheet.csv
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if _, ok := columns[opts.by]; !ok {
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid --by %q: use issue, project, day, user, or entry.", opts.by)}
+			}
+			switch opts.outputFormat {
+			case "text", "csv", "json":
+			default:
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid --output %q: use text, csv, or json.", opts.outputFormat)}
+			}
+
+			today := now()
+			if opts.since == "" {
+				opts.since = time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, today.Location()).Format(dateFormat)
+			}
+			if opts.until == "" {
+				opts.until = today.Format(dateFormat)
+			}
+			since, err := time.Parse(dateFormat, opts.since)
+			if err != nil {
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid --since %q: use the YYYY-MM-DD format.", opts.since)}
+			}
+			until, err := time.Parse(dateFormat, opts.until)
+			if err != nil {
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid --until %q: use the YYYY-MM-DD format.", opts.until)}
+			}
+			if until.Before(since) {
+				return &cmdutils.FlagError{Err: errors.New("--until must not be before --since.")}
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			listOpts := &api.ListTimelogsOptions{
+				Group:     opts.group,
+				Username:  opts.user,
+				StartDate: opts.since,
+				EndDate:   opts.until,
+			}
+			scope := opts.group
+			if opts.group == "" {
+				repo, err := f.BaseRepo()
+				if err != nil {
+					return err
+				}
+				listOpts.Project = repo.FullName()
+				scope = repo.FullName()
+			}
+			if opts.user == "@me" {
+				user, err := api.CurrentUser(apiClient)
+				if err != nil {
+					return err
+				}
+				listOpts.Username = user.Username
+			}
+
+			timelogs, err := api.ListTimelogs(apiClient, listOpts)
+			if err != nil {
+				return fmt.Errorf("failed to get the time spent in %s: %w", scope, err)
+			}
+
+			t := build(timelogs, opts)
+
+			switch opts.outputFormat {
+			case "json":
+				timesheetJSON, _ := json.Marshal(t)
+				fmt.Fprintln(f.IO.StdOut, string(timesheetJSON))
+			case "csv":
+				return writeCSV(f.IO, t)
+			default:
+				fmt.Fprint(f.IO.StdOut, formatText(f.IO, t, scope))
+			}
+			return nil
+		},
+	}
+
+	cmdutils.EnableRepoOverride(timesheetCmd, f)
+	timesheetCmd.Flags().StringVar(&opts.since, "since", "", "First day of the report, in the YYYY-MM-DD format. Defaults to the first day of the month.")
+	timesheetCmd.Flags().StringVar(&opts.until, "until", "", "Last day of the report, in the YYYY-MM-DD format. Defaults to today.")
+	timesheetCmd.Flags().StringVarP(&opts.user, "user", "u", "", "Only report the time spent by a user. Use @me for yourself.")
+	timesheetCmd.Flags().StringVarP(&opts.group, "group", "g", "", "Report the time spent in a group and its subgroups, instead of a project.")
+	timesheetCmd.Flags().StringVar(&opts.by, "by", "issue", "Group the time spent by: issue, project, day, user, entry.")
+	timesheetCmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, csv, json.")
+
+	return timesheetCmd
+}
+
+// build groups the timelogs into the rows of the timesheet.
+func build(timelogs []*api.Timelog, opts *options) *timesheet {
+	t := &timesheet{Since: opts.since, Until: opts.until, By: opts.by, Rows: []*row{}}
+
+	rows := map[string]*row{}
+	for _, tl := range timelogs {
+		issuable := tl.Issuable()
+		r := &row{
+			Date:      tl.SpentAt.Format(dateFormat),
+			User:      tl.User.Username,
+			Project:   tl.Project.FullPath,
+			Reference: issuable.Reference,
+			Title:     issuable.Title,
+			WebURL:    issuable.WebURL,
+			Summary:   tl.Summary,
+			Seconds:   tl.TimeSpent,
+		}
+		t.Seconds += tl.TimeSpent
+
+		var key string
+		switch opts.by {
+		case "issue":
+			key = r.Reference
+			r = &row{Reference: r.Reference, Title: r.Title, WebURL: r.WebURL, Project: r.Project, Seconds: r.Seconds}
+		case "project":
+			key = r.Project
+			r = &row{Project: r.Project, Seconds: r.Seconds}
+		case "day":
+			key = r.Date
+			r = &row{Date: r.Date, Seconds: r.Seconds}
+		case "user":
+			key = r.User
+			r = &row{User: r.User, Seconds: r.Seconds}
+		default:
+			t.Rows = append(t.Rows, r)
+			continue
+		}
+
+		if existing, ok := rows[key]; ok {
+			existing.Seconds += r.Seconds
+			continue
+		}
+		rows[key] = r
+		t.Rows = append(t.Rows, r)
+	}
+
+	sort.SliceStable(t.Rows, func(i, j int) bool {
+		a, b := t.Rows[i], t.Rows[j]
+		switch opts.by {
+		case "day", "entry":
+			return a.Date < b.Date
+		}
+		// the rows with the most time spent first
+		return a.Seconds > b.Seconds
+	})
+	return t
+}
+
+func formatText(io *iostreams.IOStreams, t *timesheet, scope string) string {
+	c := io.Color()
+
+	title := fmt.Sprintf("Time spent in %s from %s to %s", scope, t.Since, t.Until)
+	if len(t.Rows) == 0 {
+		return fmt.Sprintf("%s: none.\n", title)
+	}
+
+	table := tableprinter.NewTablePrinter()
+	for _, col := range columns[t.By] {
+		table.AddCell(strings.ToUpper(col.header))
+	}
+	table.AddCell("TIME")
+	table.EndRow()
+	for _, r := range t.Rows {
+		for _, col := range columns[t.By] {
+			table.AddCell(col.value(r))
+		}
+		table.AddCell(utils.FormatSeconds(r.Seconds))
+		table.EndRow()
+	}
+
+	return fmt.Sprintf("%s: %s\n\n%s", title, c.Bold(utils.FormatSeconds(t.Seconds)), table.String())
+}
+
+// writeCSV writes the rows of the timesheet, with the time spent in seconds and in hours.
+func writeCSV(io *iostreams.IOStreams, t *timesheet) error {
+	w := csv.NewWriter(io.StdOut)
+
+	var header []string
+	for _, col := range columns[t.By] {
+		header = append(header, col.header)
+	}
+	_ = w.Write(append(header, "seconds", "hours"))
+
+	for _, r := range t.Rows {
+		var record []string
+		for _, col := range columns[t.By] {
+			record = append(record, col.value(r))
+		}
+		record = append(record, strconv.Itoa(r.Seconds), fmt.Sprintf("%.2f", float64(r.Seconds)/3600))
+		_ = w.Write(record)
+	}
+
+	w.Flush()
+	return w.Error()
+}
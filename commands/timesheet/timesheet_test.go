@@ -0,0 +1,117 @@
+package timesheet
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+func TestMain(m *testing.M) {
+	cmdtest.InitTest(m, "timesheet_test")
+}
+
+func timelog(day int, username, reference string, seconds int, summary string) *api.Timelog {
+	tl := &api.Timelog{
+		SpentAt:   time.Date(2025, 6, day, 10, 0, 0, 0, time.UTC),
+		TimeSpent: seconds,
+		Summary:   summary,
+		Issue:     &api.TimelogIssuable{Title: "Title of " + reference, Reference: reference},
+	}
+	tl.User.Username = username
+	tl.Project.FullPath = "OWNER/REPO"
+	return tl
+}
+
+func runCommand(t *testing.T, args string) (string, *api.ListTimelogsOptions, error) {
+	t.Helper()
+
+	now = func() time.Time { return time.Date(2025, 6, 20, 12, 0, 0, 0, time.UTC) }
+	t.Cleanup(func() { now = time.Now })
+
+	var listOpts *api.ListTimelogsOptions
+	api.ListTimelogs = func(client *gitlab.Client, opts *api.ListTimelogsOptions) ([]*api.Timelog, error) {
+		listOpts = opts
+		return []*api.Timelog{
+			timelog(2, "alice", "OWNER/REPO#1", 3600, "Design"),
+			timelog(2, "bob", "OWNER/REPO#2", 1800, ""),
+			timelog(3, "alice", "OWNER/REPO#2", 5400, "Fix, then review"),
+		}, nil
+	}
+
+	io, _, stdout, _ := iostreams.Test()
+	f := cmdtest.StubFactory("https://gitlab.com/OWNER/REPO")
+	f.IO = io
+
+	_, err := cmdtest.RunCommand(NewCmdTimesheet(f), args)
+	return stdout.String(), listOpts, err
+}
+
+func TestTimesheet(t *testing.T) {
+	output, listOpts, err := runCommand(t, "")
+	require.NoError(t, err)
+
+	assert.Equal(t, &api.ListTimelogsOptions{Project: "OWNER/REPO", StartDate: "2025-06-01", EndDate: "2025-06-20"}, listOpts)
+	assert.Contains(t, output, "Time spent in OWNER/REPO from 2025-06-01 to 2025-06-20: 3h")
+	assert.Regexp(t, `OWNER/REPO#2\s+Title of OWNER/REPO#2\s+2h\n`, output)
+	assert.Regexp(t, `OWNER/REPO#1\s+Title of OWNER/REPO#1\s+1h\n`, output)
+	assert.Less(t, strings.Index(output, "\nOWNER/REPO#2"), strings.Index(output, "\nOWNER/REPO#1"))
+}
+
+func TestTimesheet_CSV(t *testing.T) {
+	output, listOpts, err := runCommand(t, "--group my-group --user alice --since 2025-06-02 --until 2025-06-03 --by day --output csv")
+	require.NoError(t, err)
+
+	assert.Equal(t, &api.ListTimelogsOptions{Group: "my-group", Username: "alice", StartDate: "2025-06-02", EndDate: "2025-06-03"}, listOpts)
+	assert.Equal(t, "date,seconds,hours\n2025-06-02,5400,1.50\n2025-06-03,5400,1.50\n", output)
+}
+
+func TestTimesheet_Entries(t *testing.T) {
+	output, _, err := runCommand(t, "--by entry --output csv")
+	require.NoError(t, err)
+
+	assert.Equal(t, `date,user,project,reference,title,summary,seconds,hours
+2025-06-02,alice,OWNER/REPO,OWNER/REPO#1,Title of OWNER/REPO#1,Design,3600,1.00
+2025-06-02,bob,OWNER/REPO,OWNER/REPO#2,Title of OWNER/REPO#2,,1800,0.50
+2025-06-03,alice,OWNER/REPO,OWNER/REPO#2,Title of OWNER/REPO#2,"Fix, then review",5400,1.50
+`, output)
+}
+
+func TestTimesheet_JSON(t *testing.T) {
+	output, _, err := runCommand(t, "--by user --output json")
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"since": "2025-06-01",
+		"until": "2025-06-20",
+		"by": "user",
+		"seconds": 10800,
+		"rows": [{"user": "alice", "seconds": 9000}, {"user": "bob", "seconds": 1800}]
+	}`, output)
+}
+
+func TestTimesheet_InvalidFlags(t *testing.T) {
+	tests := []struct {
+		args    string
+		wantErr string
+	}{
+		{"--by week", `invalid --by "week": use issue, project, day, user, or entry.`},
+		{"--output xml", `invalid --output "xml": use text, csv, or json.`},
+		{"--since 06/01/2025", `invalid --since "06/01/2025": use the YYYY-MM-DD format.`},
+		{"--since 2025-06-10 --until 2025-06-01", "--until must not be before --since."},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.args, func(t *testing.T) {
+			_, _, err := runCommand(t, tc.args)
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,350 @@
+package audit
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/token/accesslevel"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+// findingsExitCode is the exit code when the audit finds issues of the --fail-on severity or higher.
+const findingsExitCode = 4
+
+type AuditOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+
+	Group         string
+	ExpiresWithin int
+	InactiveDays  int
+	OutputFormat  string
+	FailOn        string
+
+	now time.Time
+}
+
+func NewCmdAudit(f *cmdutils.Factory, runE func(opts *AuditOpts) error) *cobra.Command {
+	opts := &AuditOpts{
+		IO: f.IO,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Audit the personal, group, and project access tokens you can see.",
+		Args:  cobra.ExactArgs(0),
+		Long: heredoc.Docf(`
+			Audit the personal access tokens you can see, and the access tokens of the groups you own
+			and the projects you maintain. Administrators audit the personal access tokens of all users.
+
+			The audit reports active tokens that:
+
+			- expire within the number of days of %[1]s--expires-within%[1]s (warning).
+			- were never used (warning).
+			- have the %[1]sapi%[1]s scope. It's a warning for group and project tokens with a role
+			  below Developer, which need no more than %[1]sread_api%[1]s, and a note otherwise.
+			- belong to a blocked or deactivated user (error), or a user inactive for the number
+			  of days of %[1]s--inactive-days%[1]s (warning).
+
+			The report is a table, JSON, or SARIF, to upload to code scanning tools. The command exits
+			with code 0 when no issue is as severe as %[1]s--fail-on%[1]s, with code %[2]d otherwise, and with
+			code 1 when the audit fails.
+		`, "`", findingsExitCode),
+		Example: heredoc.Doc(`
+			# Audit all the tokens you can see
+			glab token audit
+
+			# Audit the tokens of a group, its subgroups, and its projects
+			glab token audit --group group/sub-group
+
+			# In a scheduled CI job, fail on expiring tokens and save a SARIF report
+			glab token audit --expires-within 14 --fail-on warning --output sarif > gl-token-audit.sarif
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+
+			if !slices.Contains([]string{"text", "json", "sarif"}, opts.OutputFormat) {
+				return &cmdutils.FlagError{Err: fmt.Errorf("--output must be text, json, or sarif, not %q.", opts.OutputFormat)}
+			}
+			if opts.FailOn != "none" && severityRank[Severity(opts.FailOn)] == 0 {
+				return &cmdutils.FlagError{Err: fmt.Errorf("--fail-on must be error, warning, note, or none, not %q.", opts.FailOn)}
+			}
+			if opts.ExpiresWithin < 0 || opts.InactiveDays < 1 {
+				return &cmdutils.FlagError{Err: errors.New("--expires-within can't be negative, and --inactive-days must be at least 1.")}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+			return auditRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Group, "group", "g", "", "Audit the tokens of a group, its subgroups, and its projects, instead of all the tokens you can see.")
+	cmd.Flags().IntVarP(&opts.ExpiresWithin, "expires-within", "e", 30, "Report tokens that expire within this number of days.")
+	cmd.Flags().IntVar(&opts.InactiveDays, "inactive-days", 90, "Report personal access tokens of users inactive for this number of days.")
+	cmd.Flags().StringVarP(&opts.OutputFormat, "output", "F", "text", "Format output as: text, json, sarif.")
+	cmd.Flags().StringVar(&opts.FailOn, "fail-on", "error", "Exit with an error code for issues of this severity or higher: error, warning, note, none.")
+
+	return cmd
+}
+
+// Token is an active token of a user, group, or project.
+type Token struct {
+	ID          int             `json:"id"`
+	Name        string          `json:"name"`
+	Type        string          `json:"type"`
+	Owner       string          `json:"owner"`
+	Scopes      []string        `json:"scopes"`
+	AccessLevel string          `json:"access_level,omitempty"`
+	CreatedAt   *time.Time      `json:"created_at"`
+	ExpiresAt   *gitlab.ISOTime `json:"expires_at"`
+	LastUsedAt  *time.Time      `json:"last_used_at"`
+
+	accessLevel gitlab.AccessLevelValue
+	user        *gitlab.User
+}
+
+// Finding is an issue with a token.
+type Finding struct {
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	Message  string   `json:"message"`
+	Token    Token    `json:"token"`
+}
+
+func auditRun(opts *AuditOpts) error {
+	client, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+	if opts.now.IsZero() {
+		opts.now = time.Now()
+	}
+
+	tokens, err := collectTokens(client, opts)
+	if err != nil {
+		return err
+	}
+
+	var findings []Finding
+	for _, token := range tokens {
+		findings = append(findings, auditToken(token, opts)...)
+	}
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].Severity != findings[j].Severity {
+			return severityRank[findings[i].Severity] > severityRank[findings[j].Severity]
+		}
+		if findings[i].Token.Owner != findings[j].Token.Owner {
+			return findings[i].Token.Owner < findings[j].Token.Owner
+		}
+		return findings[i].Token.Name < findings[j].Token.Name
+	})
+
+	switch opts.OutputFormat {
+	case "json":
+		err = printJSON(opts, tokens, findings)
+	case "sarif":
+		err = printSARIF(opts, findings)
+	default:
+		printTable(opts, tokens, findings)
+	}
+	if err != nil {
+		return err
+	}
+
+	failing := 0
+	for _, finding := range findings {
+		if opts.FailOn != "none" && severityRank[finding.Severity] >= severityRank[Severity(opts.FailOn)] {
+			failing++
+		}
+	}
+	if failing > 0 {
+		return cmdutils.WrapErrorWithCode(
+			fmt.Errorf("%s with severity %s or higher.", utils.Pluralize(failing, "issue"), opts.FailOn),
+			findingsExitCode, "Token audit failed.")
+	}
+	return nil
+}
+
+// collectTokens lists the active tokens of the users, groups, and projects the audit covers.
+// Groups and projects whose tokens can't be listed are skipped with a warning.
+func collectTokens(client *gitlab.Client, opts *AuditOpts) ([]Token, error) {
+	var tokens []Token
+	var groups []*gitlab.Group
+	var projects []*gitlab.Project
+
+	if opts.Group != "" {
+		group, err := api.GetGroup(client, opts.Group)
+		if err != nil {
+			return nil, err
+		}
+		descendants, err := api.ListAllDescendantGroups(client, group.ID, &gitlab.ListDescendantGroupsOptions{
+			MinAccessLevel: gitlab.Ptr(gitlab.OwnerPermissions),
+		})
+		if err != nil {
+			return nil, err
+		}
+		groups = append([]*gitlab.Group{group}, descendants...)
+		projects, err = api.ListAllGroupProjects(client, group.ID, &gitlab.ListGroupProjectsOptions{
+			IncludeSubGroups: gitlab.Ptr(true),
+			MinAccessLevel:   gitlab.Ptr(gitlab.MaintainerPermissions),
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		personal, err := api.ListPersonalAccessTokens(client, &gitlab.ListPersonalAccessTokensOptions{
+			State: gitlab.Ptr("active"),
+		})
+		if err != nil {
+			return nil, err
+		}
+		users := map[int]*gitlab.User{}
+		for _, t := range personal {
+			if !t.Active {
+				continue
+			}
+			user, ok := users[t.UserID]
+			if !ok {
+				if user, err = api.UserByID(client, t.UserID); err != nil {
+					return nil, err
+				}
+				users[t.UserID] = user
+			}
+			tokens = append(tokens, Token{
+				ID:         t.ID,
+				Name:       t.Name,
+				Type:       "personal",
+				Owner:      user.Username,
+				Scopes:     t.Scopes,
+				CreatedAt:  t.CreatedAt,
+				ExpiresAt:  t.ExpiresAt,
+				LastUsedAt: t.LastUsedAt,
+				user:       user,
+			})
+		}
+
+		groups, err = api.ListAllGroups(client, &gitlab.ListGroupsOptions{
+			MinAccessLevel: gitlab.Ptr(gitlab.OwnerPermissions),
+		})
+		if err != nil {
+			return nil, err
+		}
+		projects, err = api.ListAllProjects(client, &gitlab.ListProjectsOptions{
+			Membership:     gitlab.Ptr(true),
+			MinAccessLevel: gitlab.Ptr(gitlab.MaintainerPermissions),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	c := opts.IO.Color()
+	for _, group := range groups {
+		groupTokens, err := api.ListGroupAccessTokens(client, group.ID, &gitlab.ListGroupAccessTokensOptions{})
+		if err != nil {
+			fmt.Fprintf(opts.IO.StdErr, "%s Skipped group %s: %s\n", c.WarnIcon(), group.FullPath, err)
+			continue
+		}
+		for _, t := range groupTokens {
+			if t.Active {
+				tokens = append(tokens, resourceToken(t.ID, t.Name, "group", group.FullPath, t.Scopes, t.AccessLevel, t.CreatedAt, t.ExpiresAt, t.LastUsedAt))
+			}
+		}
+	}
+	for _, project := range projects {
+		projectTokens, err := api.ListProjectAccessTokens(client, project.ID, &gitlab.ListProjectAccessTokensOptions{})
+		if err != nil {
+			fmt.Fprintf(opts.IO.StdErr, "%s Skipped project %s: %s\n", c.WarnIcon(), project.PathWithNamespace, err)
+			continue
+		}
+		for _, t := range projectTokens {
+			if t.Active {
+				tokens = append(tokens, resourceToken(t.ID, t.Name, "project", project.PathWithNamespace, t.Scopes, t.AccessLevel, t.CreatedAt, t.ExpiresAt, t.LastUsedAt))
+			}
+		}
+	}
+
+	return tokens, nil
+}
+
+func resourceToken(id int, name, tokenType, owner string, scopes []string, level gitlab.AccessLevelValue, createdAt *time.Time, expiresAt *gitlab.ISOTime, lastUsedAt *time.Time) Token {
+	accessLevel := accesslevel.AccessLevel{Value: level}
+	return Token{
+		ID:          id,
+		Name:        name,
+		Type:        tokenType,
+		Owner:       owner,
+		Scopes:      scopes,
+		AccessLevel: accessLevel.String(),
+		CreatedAt:   createdAt,
+		ExpiresAt:   expiresAt,
+		LastUsedAt:  lastUsedAt,
+		accessLevel: level,
+	}
+}
+
+// auditToken returns the findings of the rules for a token.
+func auditToken(token Token, opts *AuditOpts) []Finding {
+	var findings []Finding
+	add := func(rule Rule, severity Severity, format string, a ...interface{}) {
+		findings = append(findings, Finding{
+			Rule:     rule.ID,
+			Severity: severity,
+			Message:  fmt.Sprintf("%s access token %q of %s ", strings.ToUpper(token.Type[:1])+token.Type[1:], token.Name, token.Owner) + fmt.Sprintf(format, a...),
+			Token:    token,
+		})
+	}
+	today := opts.now.UTC().Truncate(24 * time.Hour)
+
+	if token.ExpiresAt != nil {
+		expiresAt := time.Time(*token.ExpiresAt)
+		days := int(expiresAt.Sub(today).Hours() / 24)
+		if days == 0 {
+			add(ruleExpiring, ruleExpiring.Severity, "expires today.")
+		} else if days <= opts.ExpiresWithin {
+			add(ruleExpiring, ruleExpiring.Severity, "expires in %s, on %s.", utils.Pluralize(days, "day"), token.ExpiresAt)
+		}
+	}
+
+	if token.LastUsedAt == nil {
+		if token.CreatedAt != nil {
+			add(ruleUnused, ruleUnused.Severity, "was never used since it was created on %s.", token.CreatedAt.Format(time.DateOnly))
+		} else {
+			add(ruleUnused, ruleUnused.Severity, "was never used.")
+		}
+	}
+
+	if slices.Contains(token.Scopes, "api") {
+		if token.Type != "personal" && token.accessLevel < gitlab.DeveloperPermissions {
+			add(ruleBroadScope, SeverityWarning, "has the api scope with the %s role, which can't write with it. Use read_api instead.", token.AccessLevel)
+		} else {
+			add(ruleBroadScope, ruleBroadScope.Severity, "has the api scope. Use read_api, read_repository, or write_repository if it doesn't need to write with the API.")
+		}
+	}
+
+	if user := token.user; user != nil {
+		if user.State != "active" {
+			add(ruleInactiveUser, ruleInactiveUser.Severity, "belongs to a user who is %s.", user.State)
+		} else if user.LastActivityOn != nil {
+			lastActivity := time.Time(*user.LastActivityOn)
+			if today.Sub(lastActivity) > time.Duration(opts.InactiveDays)*24*time.Hour {
+				add(ruleInactiveUser, SeverityWarning, "belongs to a user inactive since %s.", strings.TrimSpace(user.LastActivityOn.String()))
+			}
+		}
+	}
+
+	return findings
+}
@@ -0,0 +1,195 @@
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+var auditTime = time.Date(2024, time.July, 20, 12, 0, 0, 0, time.UTC)
+
+func runCommand(rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	ios, _, stdout, stderr := cmdtest.InitIOStreams(false, "")
+	factory := cmdtest.InitFactory(ios, rt)
+
+	// TODO: shouldn't be there but the stub doesn't work without it
+	_, _ = factory.HttpClient()
+
+	cmd := NewCmdAudit(factory, func(opts *AuditOpts) error {
+		opts.now = auditTime
+		return auditRun(opts)
+	})
+
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func registerTokens(fakeHTTP *httpmock.Mocker) {
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/personal_access_tokens",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 1, "name": "laptop", "user_id": 42, "scopes": ["read_api"], "active": true,
+			 "created_at": "2024-01-01T00:00:00Z", "last_used_at": "2024-07-19T00:00:00Z", "expires_at": "2024-12-31"}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/users/42",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 42, "username": "alice", "state": "active", "last_activity_on": "2024-07-19"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 5, "full_path": "acme"}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 7, "path_with_namespace": "acme/cli"}, {"id": 8, "path_with_namespace": "acme/legacy"}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/5/access_tokens",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 10, "name": "deploy", "scopes": ["read_registry"], "access_level": 20, "active": true,
+			 "created_at": "2024-06-01T00:00:00Z", "last_used_at": null, "expires_at": "2025-06-01"},
+			{"id": 11, "name": "old", "scopes": ["api"], "access_level": 20, "active": false,
+			 "created_at": "2023-06-01T00:00:00Z", "last_used_at": null, "expires_at": "2024-06-01"}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/7/access_tokens",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 20, "name": "ci", "scopes": ["api"], "access_level": 40, "active": true,
+			 "created_at": "2024-01-01T00:00:00Z", "last_used_at": "2024-07-19T00:00:00Z", "expires_at": "2024-07-30"}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/8/access_tokens",
+		httpmock.NewStringResponse(http.StatusForbidden, `{"message": "403 Forbidden"}`))
+}
+
+func TestAuditAsText(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+	registerTokens(fakeHTTP)
+
+	output, err := runCommand(fakeHTTP, "")
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		SEVERITY	RULE	TYPE	OWNER	TOKEN	MESSAGE
+		warning	unused-token	group	acme	deploy (10)	Group access token "deploy" of acme was never used since it was created on 2024-06-01.
+		warning	expiring-token	project	acme/cli	ci (20)	Project access token "ci" of acme/cli expires in 10 days, on 2024-07-30.
+		note	broad-scope	project	acme/cli	ci (20)	Project access token "ci" of acme/cli has the api scope. Use read_api, read_repository, or write_repository if it doesn't need to write with the API.
+
+		3 tokens audited: 0 errors, 2 warnings, 1 note.
+	`), output.String())
+	assert.Contains(t, output.Stderr(), "Skipped project acme/legacy: GET")
+}
+
+func TestAuditFailOn(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+	registerTokens(fakeHTTP)
+
+	_, err := runCommand(fakeHTTP, "--fail-on warning")
+
+	var exitErr *cmdutils.ExitError
+	require.True(t, errors.As(err, &exitErr))
+	assert.Equal(t, findingsExitCode, exitErr.Code)
+	assert.EqualError(t, err, "2 issues with severity warning or higher.")
+}
+
+func TestAuditAsJSON(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+	registerTokens(fakeHTTP)
+
+	output, err := runCommand(fakeHTTP, "--output json --expires-within 5")
+	require.NoError(t, err)
+
+	var report struct {
+		AuditedTokens int       `json:"audited_tokens"`
+		Findings      []Finding `json:"findings"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(output.String()), &report))
+	assert.Equal(t, 3, report.AuditedTokens)
+	require.Len(t, report.Findings, 2)
+	assert.Equal(t, "unused-token", report.Findings[0].Rule)
+	assert.Equal(t, "deploy", report.Findings[0].Token.Name)
+	assert.Equal(t, "reporter", report.Findings[0].Token.AccessLevel)
+	assert.Equal(t, "broad-scope", report.Findings[1].Rule)
+	assert.Equal(t, SeverityNote, report.Findings[1].Severity)
+}
+
+func TestAuditAsSARIF(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+	registerTokens(fakeHTTP)
+
+	output, err := runCommand(fakeHTTP, "--output sarif")
+	require.NoError(t, err)
+
+	var log sarifLog
+	require.NoError(t, json.Unmarshal([]byte(output.String()), &log))
+	assert.Equal(t, "2.1.0", log.Version)
+	require.Len(t, log.Runs, 1)
+	assert.Len(t, log.Runs[0].Tool.Driver.Rules, len(rules))
+	require.Len(t, log.Runs[0].Results, 3)
+	result := log.Runs[0].Results[1]
+	assert.Equal(t, "expiring-token", result.RuleID)
+	assert.Equal(t, SeverityWarning, result.Level)
+	assert.Equal(t, "project/acme/cli/tokens/20", result.Locations[0].LogicalLocations[0].FullyQualifiedName)
+}
+
+func TestAuditFlags(t *testing.T) {
+	for _, cli := range []string{"--output yaml", "--fail-on critical", "--inactive-days 0"} {
+		t.Run(cli, func(t *testing.T) {
+			_, err := runCommand(&httpmock.Mocker{}, cli)
+			var flagErr *cmdutils.FlagError
+			assert.True(t, errors.As(err, &flagErr), err)
+		})
+	}
+}
+
+func Test_auditToken(t *testing.T) {
+	lastUsed := auditTime.Add(-time.Hour)
+	expiresAt := gitlab.ISOTime(time.Date(2024, time.July, 20, 0, 0, 0, 0, time.UTC))
+	inactiveSince := gitlab.ISOTime(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name  string
+		token Token
+		want  []Finding
+	}{
+		{
+			name:  "expires today",
+			token: Token{Name: "t", Type: "personal", Owner: "alice", ExpiresAt: &expiresAt, LastUsedAt: &lastUsed},
+			want:  []Finding{{Rule: "expiring-token", Severity: SeverityWarning, Message: `Personal access token "t" of alice expires today.`}},
+		},
+		{
+			name:  "never used, no creation date",
+			token: Token{Name: "t", Type: "personal", Owner: "alice"},
+			want:  []Finding{{Rule: "unused-token", Severity: SeverityWarning, Message: `Personal access token "t" of alice was never used.`}},
+		},
+		{
+			name:  "api scope with a read-only role",
+			token: Token{Name: "t", Type: "group", Owner: "acme", Scopes: []string{"api"}, AccessLevel: "guest", accessLevel: gitlab.GuestPermissions, LastUsedAt: &lastUsed},
+			want:  []Finding{{Rule: "broad-scope", Severity: SeverityWarning, Message: `Group access token "t" of acme has the api scope with the guest role, which can't write with it. Use read_api instead.`}},
+		},
+		{
+			name:  "blocked user",
+			token: Token{Name: "t", Type: "personal", Owner: "bob", LastUsedAt: &lastUsed, user: &gitlab.User{State: "blocked"}},
+			want:  []Finding{{Rule: "inactive-user", Severity: SeverityError, Message: `Personal access token "t" of bob belongs to a user who is blocked.`}},
+		},
+		{
+			name:  "inactive user",
+			token: Token{Name: "t", Type: "personal", Owner: "bob", LastUsedAt: &lastUsed, user: &gitlab.User{State: "active", LastActivityOn: &inactiveSince}},
+			want:  []Finding{{Rule: "inactive-user", Severity: SeverityWarning, Message: `Personal access token "t" of bob belongs to a user inactive since 2024-01-01.`}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := range tt.want {
+				tt.want[i].Token = tt.token
+			}
+			got := auditToken(tt.token, &AuditOpts{ExpiresWithin: 30, InactiveDays: 90, now: auditTime})
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
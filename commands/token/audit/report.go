@@ -0,0 +1,176 @@
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"gitlab.com/gitlab-org/cli/pkg/tableprinter"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+// Severity is the severity of a finding. The values are the levels of SARIF results.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityNote    Severity = "note"
+)
+
+var severityRank = map[Severity]int{
+	SeverityNote:    1,
+	SeverityWarning: 2,
+	SeverityError:   3,
+}
+
+// Rule is a check of the audit, with the default severity of its findings.
+type Rule struct {
+	ID          string
+	Description string
+	Severity    Severity
+}
+
+var (
+	ruleExpiring     = Rule{ID: "expiring-token", Description: "The token expires soon.", Severity: SeverityWarning}
+	ruleUnused       = Rule{ID: "unused-token", Description: "The token was never used.", Severity: SeverityWarning}
+	ruleBroadScope   = Rule{ID: "broad-scope", Description: "The token has the api scope, which grants full read and write access to the API.", Severity: SeverityNote}
+	ruleInactiveUser = Rule{ID: "inactive-user", Description: "The token belongs to a blocked, deactivated, or inactive user.", Severity: SeverityError}
+
+	rules = []Rule{ruleExpiring, ruleUnused, ruleBroadScope, ruleInactiveUser}
+)
+
+func printTable(opts *AuditOpts, tokens []Token, findings []Finding) {
+	c := opts.IO.Color()
+	if len(findings) == 0 {
+		fmt.Fprintf(opts.IO.StdOut, "%s No issues found in %s.\n", c.GreenCheck(), utils.Pluralize(len(tokens), "token"))
+		return
+	}
+
+	colors := map[Severity]func(string) string{
+		SeverityError:   c.Red,
+		SeverityWarning: c.Yellow,
+		SeverityNote:    c.Gray,
+	}
+	counts := map[Severity]int{}
+
+	table := tableprinter.NewTablePrinter()
+	table.AddRow("SEVERITY", "RULE", "TYPE", "OWNER", "TOKEN", "MESSAGE")
+	for _, finding := range findings {
+		counts[finding.Severity]++
+		token := finding.Token
+		table.AddRow(colors[finding.Severity](string(finding.Severity)), finding.Rule, token.Type, token.Owner,
+			token.Name+" ("+strconv.Itoa(token.ID)+")", finding.Message)
+	}
+	fmt.Fprint(opts.IO.StdOut, table.String())
+	fmt.Fprintf(opts.IO.StdOut, "\n%s audited: %s, %s, %s.\n", utils.Pluralize(len(tokens), "token"),
+		utils.Pluralize(counts[SeverityError], "error"), utils.Pluralize(counts[SeverityWarning], "warning"),
+		utils.Pluralize(counts[SeverityNote], "note"))
+}
+
+func printJSON(opts *AuditOpts, tokens []Token, findings []Finding) error {
+	if findings == nil {
+		findings = []Finding{}
+	}
+	report := struct {
+		AuditedTokens int       `json:"audited_tokens"`
+		Findings      []Finding `json:"findings"`
+	}{len(tokens), findings}
+
+	encoder := json.NewEncoder(opts.IO.StdOut)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+// The SARIF 2.1.0 log, with the properties the audit uses.
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string             `json:"id"`
+	ShortDescription     sarifMessage       `json:"shortDescription"`
+	DefaultConfiguration sarifConfiguration `json:"defaultConfiguration"`
+}
+
+type sarifConfiguration struct {
+	Level Severity `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     Severity        `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+}
+
+type sarifLogicalLocation struct {
+	Name               string `json:"name"`
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	Kind               string `json:"kind"`
+}
+
+func printSARIF(opts *AuditOpts, findings []Finding) error {
+	driver := sarifDriver{
+		Name:           "glab token audit",
+		InformationURI: "https://gitlab.com/gitlab-org/cli",
+	}
+	for _, rule := range rules {
+		driver.Rules = append(driver.Rules, sarifRule{
+			ID:                   rule.ID,
+			ShortDescription:     sarifMessage{Text: rule.Description},
+			DefaultConfiguration: sarifConfiguration{Level: rule.Severity},
+		})
+	}
+
+	results := make([]sarifResult, 0, len(findings))
+	for _, finding := range findings {
+		token := finding.Token
+		results = append(results, sarifResult{
+			RuleID:  finding.Rule,
+			Level:   finding.Severity,
+			Message: sarifMessage{Text: finding.Message},
+			Locations: []sarifLocation{{
+				LogicalLocations: []sarifLogicalLocation{{
+					Name:               token.Name,
+					FullyQualifiedName: fmt.Sprintf("%s/%s/tokens/%d", token.Type, token.Owner, token.ID),
+					Kind:               "resource",
+				}},
+			}},
+		})
+	}
+
+	log := sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{{Tool: sarifTool{Driver: driver}, Results: results}},
+	}
+	encoder := json.NewEncoder(opts.IO.StdOut)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(log)
+}
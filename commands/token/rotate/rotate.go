@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"gitlab.com/gitlab-org/cli/commands/token/expirationdate"
@@ -32,6 +33,9 @@ type RotateOptions struct {
 	Duration     time.Duration
 	ExpireAt     expirationdate.ExpirationDate
 	OutputFormat string
+	Sinks        []string
+
+	sinks []sink
 }
 
 func NewCmdRotate(f *cmdutils.Factory, runE func(opts *RotateOptions) error) *cobra.Command {
@@ -54,6 +58,16 @@ func NewCmdRotate(f *cmdutils.Factory, runE func(opts *RotateOptions) error) *co
 			The output format can be either "JSON" or "text". The JSON output will show the meta information of the
 			rotated token.
 
+			With --sink, the new token is written to each sink instead of stdout, so rotation can run as a
+			scheduled job. The token is printed only if it can't be written to any sink. The sinks are:
+
+			- variable:<project>/<KEY>: the CI/CD variable KEY of a project, for all environments. A new
+			  variable is masked; an existing variable keeps its settings.
+			- group-variable:<group>/<KEY>: the CI/CD variable KEY of a group.
+			- file:<path>: a file, replaced atomically, readable only by its owner.
+			- exec:<command>: a command that reads the token from its standard input. GLAB_TOKEN_NAME and
+			  GLAB_TOKEN_EXPIRES_AT are set for the command.
+
 			Administrators can rotate personal access tokens belonging to other users.
 		`),
 		Example: heredoc.Doc(`
@@ -71,6 +85,9 @@ func NewCmdRotate(f *cmdutils.Factory, runE func(opts *RotateOptions) error) *co
 
 			# Rotate a personal access token of another user (administrator only)
 			glab token rotate --user johndoe johns-personal-token
+
+			# Rotate a service account token, and save it in a CI/CD variable and a file
+			glab token rotate --user service-bot deploy-token --sink variable:group/deployer/DEPLOY_TOKEN --sink file:/etc/deploy/token
 		`),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			// Supports repo override
@@ -100,6 +117,14 @@ func NewCmdRotate(f *cmdutils.Factory, runE func(opts *RotateOptions) error) *co
 				return cmdutils.FlagError{Err: errors.New("'--expires-at' and '--duration' are mutually exclusive.")}
 			}
 
+			for _, value := range opts.Sinks {
+				s, err := parseSink(value)
+				if err != nil {
+					return cmdutils.FlagError{Err: err}
+				}
+				opts.sinks = append(opts.sinks, s)
+			}
+
 			if time.Time(opts.ExpireAt).IsZero() {
 				opts.ExpireAt = expirationdate.ExpirationDate(time.Now().Add(opts.Duration).Truncate(time.Hour * 24))
 			}
@@ -117,6 +142,7 @@ func NewCmdRotate(f *cmdutils.Factory, runE func(opts *RotateOptions) error) *co
 	cmd.Flags().DurationVarP(&opts.Duration, "duration", "D", time.Duration(30*24*time.Hour), "Sets the token duration, in hours. Maximum of 8760. Examples: 24h, 168h, 504h.")
 	cmd.Flags().VarP(&opts.ExpireAt, "expires-at", "E", "Sets the token's expiration date and time, in YYYY-MM-DD format. If not specified, --duration is used.")
 	cmd.Flags().StringVarP(&opts.OutputFormat, "output", "F", "text", "Format output as: text, json. 'text' provides the new token value; 'json' outputs the token with metadata.")
+	cmd.Flags().StringArrayVar(&opts.Sinks, "sink", nil, "Write the new token to a sink: variable:<project>/<KEY>, group-variable:<group>/<KEY>, file:<path>, or exec:<command>. Can be repeated.")
 	return cmd
 }
 
@@ -129,6 +155,7 @@ func rotateTokenRun(opts *RotateOptions) error {
 
 	var outputToken interface{}
 	var outputTokenValue string
+	var outputTokenName string
 
 	if opts.User != "" {
 		user, err := api.UserByName(httpClient, opts.User)
@@ -164,6 +191,7 @@ func rotateTokenRun(opts *RotateOptions) error {
 		}
 		outputToken = token
 		outputTokenValue = token.Token
+		outputTokenName = token.Name
 	} else {
 		if opts.Group != "" {
 			options := &gitlab.ListGroupAccessTokensOptions{PerPage: 100}
@@ -192,6 +220,7 @@ func rotateTokenRun(opts *RotateOptions) error {
 			}
 			outputToken = token
 			outputTokenValue = token.Token
+			outputTokenName = token.Name
 		} else {
 			repo, err := opts.BaseRepo()
 			if err != nil {
@@ -223,19 +252,58 @@ func rotateTokenRun(opts *RotateOptions) error {
 			}
 			outputToken = token
 			outputTokenValue = token.Token
+			outputTokenName = token.Name
 		}
 	}
 
+	rotated := rotatedToken{Name: outputTokenName, Value: outputTokenValue, ExpiresAt: time.Time(expirationDate)}
+	failed := writeSinks(opts, httpClient, rotated)
+
+	// With sinks, the token is only printed when no sink has it, so it isn't lost.
+	printValue := len(opts.sinks) == 0 || len(failed) == len(opts.sinks)
 	if opts.OutputFormat == "json" {
+		if !printValue {
+			redactToken(outputToken)
+		}
 		encoder := json.NewEncoder(opts.IO.StdOut)
 		if err := encoder.Encode(outputToken); err != nil {
 			return err
 		}
-	} else {
+	} else if printValue {
 		if _, err := fmt.Fprintf(opts.IO.StdOut, "%s\n", outputTokenValue); err != nil {
 			return err
 		}
 	}
 
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to write the rotated token to %s.", strings.Join(failed, ", "))
+	}
 	return nil
 }
+
+// writeSinks writes the rotated token to the sinks, and returns the sinks that failed.
+func writeSinks(opts *RotateOptions, client *gitlab.Client, token rotatedToken) []string {
+	c := opts.IO.Color()
+	var failed []string
+	for _, s := range opts.sinks {
+		if err := s.write(client, token); err != nil {
+			fmt.Fprintf(opts.IO.StdErr, "%s Failed to write the token to %s: %s\n", c.FailedIcon(), s, err)
+			failed = append(failed, s.String())
+			continue
+		}
+		fmt.Fprintf(opts.IO.StdErr, "%s Wrote the token to %s\n", c.GreenCheck(), s)
+	}
+	return failed
+}
+
+// redactToken removes the value of a rotated token from its JSON output.
+func redactToken(token interface{}) {
+	switch t := token.(type) {
+	case *gitlab.PersonalAccessToken:
+		t.Token = ""
+	case *gitlab.GroupAccessToken:
+		t.Token = ""
+	case *gitlab.ProjectAccessToken:
+		t.Token = ""
+	}
+}
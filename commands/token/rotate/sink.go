@@ -0,0 +1,144 @@
+package rotate
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/google/shlex"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/variable/variableutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/run"
+)
+
+// rotatedToken is the new value of a rotated token, written to the sinks.
+type rotatedToken struct {
+	Name      string
+	Value     string
+	ExpiresAt time.Time
+}
+
+// sink is a destination of a rotated token.
+type sink interface {
+	write(client *gitlab.Client, token rotatedToken) error
+	String() string
+}
+
+var errInvalidSink = errors.New("use variable:<project>/<KEY>, group-variable:<group>/<KEY>, file:<path>, or exec:<command>.")
+
+// parseSink parses the value of a --sink flag.
+func parseSink(value string) (sink, error) {
+	kind, target, _ := strings.Cut(value, ":")
+	if target == "" {
+		return nil, fmt.Errorf("invalid sink %q: %w", value, errInvalidSink)
+	}
+
+	switch kind {
+	case "variable", "group-variable":
+		i := strings.LastIndex(target, "/")
+		if i <= 0 {
+			return nil, fmt.Errorf("invalid sink %q: %w", value, errInvalidSink)
+		}
+		path, key := target[:i], target[i+1:]
+		if !variableutils.IsValidKey(key) {
+			return nil, fmt.Errorf("invalid sink %q: %s.", value, variableutils.ValidKeyMsg)
+		}
+		return &variableSink{group: kind == "group-variable", path: path, key: key}, nil
+	case "file":
+		return &fileSink{path: target}, nil
+	case "exec":
+		args, err := shlex.Split(target)
+		if err != nil || len(args) == 0 {
+			return nil, fmt.Errorf("invalid sink %q: the command can't be parsed.", value)
+		}
+		return &execSink{args: args}, nil
+	}
+	return nil, fmt.Errorf("invalid sink %q: %w", value, errInvalidSink)
+}
+
+// variableSink sets the value of a CI/CD variable of a project or a group, for all environments.
+// It creates a masked variable if it doesn't exist, and keeps the settings of an existing one.
+type variableSink struct {
+	group bool
+	path  string
+	key   string
+}
+
+func (s *variableSink) String() string {
+	if s.group {
+		return "group-variable:" + s.path + "/" + s.key
+	}
+	return "variable:" + s.path + "/" + s.key
+}
+
+func (s *variableSink) write(client *gitlab.Client, token rotatedToken) error {
+	var err error
+	if s.group {
+		if _, err = api.GetGroupVariable(client, s.path, s.key, "*"); err == nil {
+			_, err = api.UpdateGroupVariable(client, s.path, s.key, &gitlab.UpdateGroupVariableOptions{
+				Value:            gitlab.Ptr(token.Value),
+				EnvironmentScope: gitlab.Ptr("*"),
+			})
+		} else if errors.Is(err, gitlab.ErrNotFound) {
+			_, err = api.CreateGroupVariable(client, s.path, &gitlab.CreateGroupVariableOptions{
+				Key:    gitlab.Ptr(s.key),
+				Value:  gitlab.Ptr(token.Value),
+				Masked: gitlab.Ptr(true),
+			})
+		}
+		return err
+	}
+
+	if _, err = api.GetProjectVariable(client, s.path, s.key, "*"); err == nil {
+		_, err = api.UpdateProjectVariable(client, s.path, s.key, &gitlab.UpdateProjectVariableOptions{
+			Value:            gitlab.Ptr(token.Value),
+			EnvironmentScope: gitlab.Ptr("*"),
+		})
+	} else if errors.Is(err, gitlab.ErrNotFound) {
+		_, err = api.CreateProjectVariable(client, s.path, &gitlab.CreateProjectVariableOptions{
+			Key:    gitlab.Ptr(s.key),
+			Value:  gitlab.Ptr(token.Value),
+			Masked: gitlab.Ptr(true),
+		})
+	}
+	return err
+}
+
+// fileSink replaces a file with the token. The file is written to a temporary file first, and
+// renamed, so readers never see a partial token.
+type fileSink struct {
+	path string
+}
+
+func (s *fileSink) String() string {
+	return "file:" + s.path
+}
+
+func (s *fileSink) write(_ *gitlab.Client, token rotatedToken) error {
+	return config.WriteFile(s.path, []byte(token.Value+"\n"), 0o600)
+}
+
+// execSink runs a command with the token on its standard input. The name and the expiration date
+// of the token are in the GLAB_TOKEN_NAME and GLAB_TOKEN_EXPIRES_AT environment variables.
+type execSink struct {
+	args []string
+}
+
+func (s *execSink) String() string {
+	return "exec:" + strings.Join(s.args, " ")
+}
+
+func (s *execSink) write(_ *gitlab.Client, token rotatedToken) error {
+	cmd := exec.Command(s.args[0], s.args[1:]...)
+	cmd.Env = append(os.Environ(),
+		"GLAB_TOKEN_NAME="+token.Name,
+		"GLAB_TOKEN_EXPIRES_AT="+token.ExpiresAt.Format(time.DateOnly))
+	cmd.Stdin = strings.NewReader(token.Value + "\n")
+	return run.PrepareCmd(cmd).Run()
+}
@@ -0,0 +1,137 @@
+package rotate
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/run"
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+)
+
+func Test_parseSink(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    string
+		wantErr string
+	}{
+		{value: "variable:group/project/DEPLOY_TOKEN", want: "variable:group/project/DEPLOY_TOKEN"},
+		{value: "group-variable:group/sub/TOKEN", want: "group-variable:group/sub/TOKEN"},
+		{value: "file:/etc/token", want: "file:/etc/token"},
+		{value: "exec:vault kv put secret/token value=-", want: "exec:vault kv put secret/token value=-"},
+		{value: "variable:TOKEN", wantErr: `invalid sink "variable:TOKEN": use variable:<project>/<KEY>`},
+		{value: "variable:group/project/MY-TOKEN", wantErr: `invalid sink "variable:group/project/MY-TOKEN": A valid key`},
+		{value: "file:", wantErr: `invalid sink "file:"`},
+		{value: "exec:'unterminated", wantErr: "the command can't be parsed."},
+		{value: "s3:bucket/token", wantErr: `invalid sink "s3:bucket/token"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseSink(tt.value)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
+
+func TestRotateProjectAccessTokenWithSinks(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/access_tokens",
+		httpmock.NewStringResponse(http.StatusOK, fmt.Sprintf("[%s]", projectAccessTokenResponse)))
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/projects/OWNER/REPO/access_tokens/10191548/rotate",
+		httpmock.NewStringResponse(http.StatusOK, projectAccessTokenResponse))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/group/deployer/variables/DEPLOY_TOKEN",
+		httpmock.NewStringResponse(http.StatusOK, `{"key": "DEPLOY_TOKEN", "value": "old", "masked": true}`))
+	fakeHTTP.RegisterResponderWithBody(http.MethodPut, "/api/v4/projects/group/deployer/variables/DEPLOY_TOKEN?filter%5Benvironment_scope%5D=%2A",
+		`{"environment_scope": "*", "value": "glpat-dfsdfjksjdfslkdfjsd"}`,
+		httpmock.NewStringResponse(http.StatusOK, `{"key": "DEPLOY_TOKEN"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/group/variables/DEPLOY_TOKEN",
+		httpmock.NewStringResponse(http.StatusNotFound, `{"message": "404 Variable Not Found"}`))
+	fakeHTTP.RegisterResponderWithBody(http.MethodPost, "/api/v4/groups/group/variables",
+		`{"key": "DEPLOY_TOKEN", "value": "glpat-dfsdfjksjdfslkdfjsd", "masked": true}`,
+		httpmock.NewStringResponse(http.StatusCreated, `{"key": "DEPLOY_TOKEN"}`))
+
+	var execInput string
+	var execEnv []string
+	restore := run.SetPrepareCmd(func(cmd *exec.Cmd) run.Runnable {
+		return &stubRunnable{run: func() error {
+			input, err := io.ReadAll(cmd.Stdin)
+			execInput, execEnv = string(input), cmd.Env
+			return err
+		}}
+	})
+	defer restore()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+
+	ios, _, stdout, stderr := cmdtest.InitIOStreams(false, "")
+	factory := cmdtest.InitFactory(ios, fakeHTTP)
+	_, _ = factory.HttpClient()
+
+	cli := "my-project-token --sink variable:group/deployer/DEPLOY_TOKEN --sink group-variable:group/DEPLOY_TOKEN " +
+		"--sink file:" + tokenFile + " --sink 'exec:vault kv put secret/token value=-'"
+	_, err := cmdtest.ExecuteCommand(NewCmdRotate(factory, nil), cli, stdout, stderr)
+	require.NoError(t, err)
+
+	assert.Empty(t, stdout.String())
+	assert.Equal(t, 4, strings.Count(stderr.String(), "Wrote the token to"))
+
+	content, err := os.ReadFile(tokenFile)
+	require.NoError(t, err)
+	assert.Equal(t, "glpat-dfsdfjksjdfslkdfjsd\n", string(content))
+	info, err := os.Stat(tokenFile)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	assert.Equal(t, "glpat-dfsdfjksjdfslkdfjsd\n", execInput)
+	assert.Contains(t, execEnv, "GLAB_TOKEN_NAME=my-project-token")
+}
+
+func TestRotateProjectAccessTokenWithFailedSink(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/access_tokens",
+		httpmock.NewStringResponse(http.StatusOK, fmt.Sprintf("[%s]", projectAccessTokenResponse)))
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/projects/OWNER/REPO/access_tokens/10191548/rotate",
+		httpmock.NewStringResponse(http.StatusOK, projectAccessTokenResponse))
+
+	ios, _, stdout, stderr := cmdtest.InitIOStreams(false, "")
+	factory := cmdtest.InitFactory(ios, fakeHTTP)
+	_, _ = factory.HttpClient()
+
+	missing := filepath.Join(t.TempDir(), "missing", "token")
+	_, err := cmdtest.ExecuteCommand(NewCmdRotate(factory, nil), "my-project-token --sink file:"+missing, stdout, stderr)
+
+	assert.EqualError(t, err, "failed to write the rotated token to file:"+missing+".")
+	assert.Contains(t, stderr.String(), "Failed to write the token to file:"+missing)
+	// No sink has the token, so it's printed.
+	assert.Equal(t, "glpat-dfsdfjksjdfslkdfjsd\n", stdout.String())
+}
+
+type stubRunnable struct {
+	run func() error
+}
+
+func (s *stubRunnable) Output() ([]byte, error) {
+	return nil, s.run()
+}
+
+func (s *stubRunnable) Run() error {
+	return s.run()
+}
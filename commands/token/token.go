@@ -3,6 +3,7 @@ package token
 import (
 	"github.com/spf13/cobra"
 	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/token/audit"
 	"gitlab.com/gitlab-org/cli/commands/token/create"
 	"gitlab.com/gitlab-org/cli/commands/token/list"
 	"gitlab.com/gitlab-org/cli/commands/token/revoke"
@@ -21,5 +22,6 @@ func NewTokenCmd(f *cmdutils.Factory) *cobra.Command {
 	cmd.AddCommand(revoke.NewCmdRevoke(f, nil))
 	cmd.AddCommand(rotate.NewCmdRotate(f, nil))
 	cmd.AddCommand(list.NewCmdList(f, nil))
+	cmd.AddCommand(audit.NewCmdAudit(f, nil))
 	return cmd
 }
@@ -20,9 +20,10 @@ type DeleteOpts struct {
 	IO         *iostreams.IOStreams
 	BaseRepo   func() (glrepo.Interface, error)
 
-	Key   string
-	Scope string
-	Group string
+	Key      string
+	Scope    string
+	Group    string
+	Instance bool
 }
 
 func NewCmdSet(f *cmdutils.Factory, runE func(opts *DeleteOpts) error) *cobra.Command {
@@ -32,13 +33,14 @@ func NewCmdSet(f *cmdutils.Factory, runE func(opts *DeleteOpts) error) *cobra.Co
 
 	cmd := &cobra.Command{
 		Use:     "delete <key>",
-		Short:   "Delete a variable for a project or group.",
+		Short:   "Delete a variable for a project, group, or instance.",
 		Aliases: []string{"remove"},
 		Args:    cobra.ExactArgs(1),
 		Example: heredoc.Doc(`
 			glab variable delete VAR_NAME
 			glab variable delete VAR_NAME --scope=prod
 			glab variable delete VARNAME -g mygroup
+			glab variable delete VARNAME --instance
 		`),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			opts.HTTPClient = f.HttpClient
@@ -53,6 +55,12 @@ func NewCmdSet(f *cmdutils.Factory, runE func(opts *DeleteOpts) error) *cobra.Co
 				return err
 			}
 
+			if opts.Instance {
+				if err = variableutils.ValidateInstanceFlags(cmd, opts.Group); err != nil {
+					return err
+				}
+			}
+
 			if cmd.Flags().Changed("scope") && opts.Group != "" {
 				err = cmdutils.FlagError{Err: errors.New("scope is not required for group variables.")}
 				return err
@@ -69,6 +77,7 @@ func NewCmdSet(f *cmdutils.Factory, runE func(opts *DeleteOpts) error) *cobra.Co
 
 	cmd.Flags().StringVarP(&opts.Scope, "scope", "s", "*", "The 'environment_scope' of the variable. Options: all (*), or specific environments.")
 	cmd.Flags().StringVarP(&opts.Group, "group", "g", "", "Delete variable from a group.")
+	cmd.Flags().BoolVar(&opts.Instance, "instance", false, "Delete variable from the instance. Requires administrator access.")
 
 	return cmd
 }
@@ -80,6 +89,17 @@ func deleteRun(opts *DeleteOpts) error {
 		return err
 	}
 
+	if opts.Instance {
+		// Delete instance-level variable
+		err = api.DeleteInstanceVariable(httpClient, opts.Key)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(opts.IO.StdOut, "%s Deleted variable %s from the instance.\n", c.GreenCheck(), opts.Key)
+		return nil
+	}
+
 	baseRepo, err := opts.BaseRepo()
 	if err != nil {
 		return err
@@ -95,7 +115,7 @@ func deleteRun(opts *DeleteOpts) error {
 		fmt.Fprintf(opts.IO.StdOut, "%s Deleted variable %s with scope %s for %s.\n", c.GreenCheck(), opts.Key, opts.Scope, baseRepo.FullName())
 	} else {
 		// Delete group-level variable
-		err = api.DeleteGroupVariable(httpClient, opts.Group, opts.Key)
+		err = api.DeleteGroupVariable(httpClient, opts.Group, opts.Key, "")
 		if err != nil {
 			return err
 		}
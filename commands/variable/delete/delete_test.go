@@ -44,6 +44,21 @@ func Test_NewCmdSet(t *testing.T) {
 			cli:      "cool_secret -g mygroup --scope prod",
 			wantsErr: true,
 		},
+		{
+			name:     "delete instance var",
+			cli:      "cool_secret --instance",
+			wantsErr: false,
+		},
+		{
+			name:     "delete scoped instance var",
+			cli:      "cool_secret --instance --scope prod",
+			wantsErr: true,
+		},
+		{
+			name:     "delete instance and group var",
+			cli:      "cool_secret --instance -g mygroup",
+			wantsErr: true,
+		},
 		{
 			name:     "no name",
 			cli:      "",
@@ -105,6 +120,10 @@ func Test_deleteRun(t *testing.T) {
 		httpmock.NewStringResponse(http.StatusNoContent, " "),
 	)
 
+	reg.RegisterResponder(http.MethodDelete, "/api/v4/admin/ci/variables/TEST_VAR",
+		httpmock.NewStringResponse(http.StatusNoContent, " "),
+	)
+
 	httpClient := func() (*gitlab.Client, error) {
 		a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
 		return a.Lab(), nil
@@ -153,6 +172,18 @@ func Test_deleteRun(t *testing.T) {
 			wantsErr:    false,
 			wantsOutput: "✓ Deleted variable TEST_VAR for group testGroup.\n",
 		},
+		{
+			name: "delete instance variable",
+			opts: DeleteOpts{
+				HTTPClient: httpClient,
+				BaseRepo:   baseRepo,
+				Key:        "TEST_VAR",
+				Scope:      "*",
+				Instance:   true,
+			},
+			wantsErr:    false,
+			wantsOutput: "✓ Deleted variable TEST_VAR from the instance.\n",
+		},
 	}
 
 	for _, tt := range tests {
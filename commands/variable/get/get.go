@@ -22,6 +22,7 @@ type GetOps struct {
 	Scope        string
 	Key          string
 	Group        string
+	Instance     bool
 	OutputFormat string
 	JSONOutput   bool
 }
@@ -33,12 +34,13 @@ func NewCmdSet(f *cmdutils.Factory, runE func(opts *GetOps) error) *cobra.Comman
 
 	cmd := &cobra.Command{
 		Use:   "get <key>",
-		Short: "Get a variable for a project or group.",
+		Short: "Get a variable for a project, group, or instance.",
 		Args:  cobra.RangeArgs(1, 1),
 		Example: heredoc.Doc(`
 			glab variable get VAR_KEY
 			glab variable get -g GROUP VAR_KEY
 			glab variable get -s SCOPE VAR_KEY
+			glab variable get --instance VAR_KEY
 		`),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			opts.HTTPClient = f.HttpClient
@@ -51,6 +53,12 @@ func NewCmdSet(f *cmdutils.Factory, runE func(opts *GetOps) error) *cobra.Comman
 				return
 			}
 
+			if opts.Instance {
+				if err = variableutils.ValidateInstanceFlags(cmd, opts.Group); err != nil {
+					return
+				}
+			}
+
 			if runE != nil {
 				err = runE(opts)
 				return
@@ -62,6 +70,7 @@ func NewCmdSet(f *cmdutils.Factory, runE func(opts *GetOps) error) *cobra.Comman
 
 	cmd.Flags().StringVarP(&opts.Scope, "scope", "s", "*", "The environment_scope of the variable. Values: all (*), or specific environments.")
 	cmd.Flags().StringVarP(&opts.Group, "group", "g", "", "Get variable for a group.")
+	cmd.Flags().BoolVar(&opts.Instance, "instance", false, "Get variable for the instance. Requires administrator access.")
 	cmd.Flags().StringVarP(&opts.OutputFormat, "output", "F", "text", "Format output as: text, json.")
 	return cmd
 }
@@ -74,7 +83,17 @@ func getRun(opts *GetOps) error {
 
 	var variableValue string
 
-	if opts.Group != "" {
+	if opts.Instance {
+		variable, err := api.GetInstanceVariable(httpClient, opts.Key)
+		if err != nil {
+			return err
+		}
+		if opts.OutputFormat == "json" {
+			varJSON, _ := json.Marshal(variable)
+			fmt.Fprintln(opts.IO.StdOut, string(varJSON))
+		}
+		variableValue = variable.Value
+	} else if opts.Group != "" {
 		variable, err := api.GetGroupVariable(httpClient, opts.Group, opts.Key, opts.Scope)
 		if err != nil {
 			return err
@@ -0,0 +1,394 @@
+package importvar
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/flag"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/pkg/prompt"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+type ImportOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	File   string
+	Format string
+	Group  string
+	Scope  string
+	Prune  bool
+	DryRun bool
+	Yes    bool
+}
+
+func NewCmdImport(f *cmdutils.Factory, runE func(opts *ImportOpts) error) *cobra.Command {
+	opts := &ImportOpts{
+		IO: f.IO,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "import [<file>]",
+		Short:   "Import variables to a project or group.",
+		Aliases: []string{"im"},
+		Args:    cobra.MaximumNArgs(1),
+		Long: heredoc.Docf(`
+			Import variables to a project or group from a file, or from standard input.
+
+			The file can be in the json, export, or env formats of %[1]sglab variable export%[1]s, or a YAML
+			manifest. The format is detected from the extension of the file: .json, .yml or .yaml, .env,
+			and .sh for export. In a manifest, a variable is a value, a mapping with its attributes, or a
+			list of mappings for multiple environment scopes:
+
+			%[1]s%[1]s%[1]syaml
+			variables:
+			  LOG_LEVEL: info
+			  DEPLOY_KEY:
+			    value: ...
+			    type: file
+			    protected: true
+			    masked: true
+			    raw: false
+			  API_URL:
+			    - value: https://staging.example.com
+			      environment_scope: staging
+			    - value: https://example.com
+			      environment_scope: production
+			%[1]s%[1]s%[1]s
+
+			Variables are matched by key and environment scope. The import shows the variables to create,
+			update, and delete, then applies the changes after confirmation. The env and export formats
+			have no attributes, so only the values of existing variables are updated.
+
+			With %[1]s--prune%[1]s, the variables that aren't in the file are deleted. For the env and export
+			formats, only the variables of the %[1]s--scope%[1]s environment scope are deleted.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Preview the changes of a manifest
+			glab variable import variables.yml --dry-run
+
+			# Apply a manifest, and delete the variables that aren't in it
+			glab variable import variables.yml --prune --yes
+
+			# Copy the variables of a project to a group
+			glab variable export --repo group/project | glab variable import --group group --format json
+		`),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// Supports repo override
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.Group, err = flag.GroupOverride(cmd); err != nil {
+				return err
+			}
+
+			opts.File = "-"
+			if len(args) == 1 {
+				opts.File = args[0]
+			}
+			if opts.Format == "" {
+				if opts.Format = detectFormat(opts.File); opts.Format == "" {
+					return &cmdutils.FlagError{Err: fmt.Errorf("can't detect the format of %s. Use --format.", opts.File)}
+				}
+			}
+			if !slices.Contains(Formats, opts.Format) {
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid format %q. Use %s.", opts.Format, strings.Join(Formats, ", "))}
+			}
+			if opts.DryRun && opts.Yes {
+				return &cmdutils.FlagError{Err: errors.New("--dry-run and --yes are mutually exclusive.")}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+			return importRun(opts)
+		},
+	}
+
+	cmdutils.EnableRepoOverride(cmd, f)
+	cmd.PersistentFlags().StringP("group", "g", "", "Select a group or subgroup. Ignored if a repository argument is set.")
+	cmd.Flags().StringVarP(&opts.Format, "format", "F", "", "Format of the file: json, export, env, yaml. Detected from the extension of the file by default.")
+	cmd.Flags().StringVarP(&opts.Scope, "scope", "s", "*", "The environment_scope of the variables without one.")
+	cmd.Flags().BoolVar(&opts.Prune, "prune", false, "Delete the variables that aren't in the file.")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show the changes without applying them.")
+	cmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Apply the changes without confirmation.")
+
+	return cmd
+}
+
+// change is a change to the live variables: create, update, or delete.
+type change struct {
+	action   string
+	variable Variable
+	fields   []string
+}
+
+func importRun(opts *ImportOpts) error {
+	var data []byte
+	var err error
+	if opts.File == "-" {
+		data, err = io.ReadAll(opts.IO.In)
+	} else {
+		data, err = os.ReadFile(opts.File)
+	}
+	if err != nil {
+		return err
+	}
+
+	desired, err := parseVariables(data, opts.Format, opts.Scope)
+	if err != nil {
+		return fmt.Errorf("failed to parse %s: %w", opts.File, err)
+	}
+
+	client, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	target := "group " + opts.Group
+	var project string
+	if opts.Group == "" {
+		repo, err := opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+		project = repo.FullName()
+		target = "project " + project
+	}
+
+	live, err := liveVariables(client, opts.Group, project)
+	if err != nil {
+		return err
+	}
+
+	// The env and export formats only have values, and the variables of a single scope.
+	attributes := opts.Format == "json" || opts.Format == "yaml"
+	pruneScope := ""
+	if !attributes {
+		pruneScope = opts.Scope
+	}
+	changes := diffVariables(desired, live, attributes, opts.Prune, pruneScope)
+
+	c := opts.IO.Color()
+	if len(changes) == 0 {
+		fmt.Fprintf(opts.IO.StdOut, "%s The variables of %s are up to date.\n", c.GreenCheck(), target)
+		return nil
+	}
+	printChanges(opts, target, changes)
+
+	if opts.DryRun {
+		return nil
+	}
+	if !opts.Yes {
+		if !opts.IO.PromptEnabled() {
+			return &cmdutils.FlagError{Err: errors.New("--yes is required to apply the changes when not running interactively.")}
+		}
+		if err := prompt.Confirm(&opts.Yes, "Apply these changes?", false); err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+		if !opts.Yes {
+			return cmdutils.CancelError()
+		}
+	}
+
+	for _, ch := range changes {
+		if err := applyChange(client, opts.Group, project, ch); err != nil {
+			return fmt.Errorf("failed to %s variable %s with scope %s: %w", ch.action, ch.variable.Key, ch.variable.Scope, err)
+		}
+	}
+	fmt.Fprintf(opts.IO.StdOut, "%s Imported the variables of %s.\n", c.GreenCheck(), target)
+	return nil
+}
+
+// liveVariables lists the variables of a group, or of a project.
+func liveVariables(client *gitlab.Client, group, project string) ([]Variable, error) {
+	var variables []Variable
+	const perPage = 100
+	for page := 1; ; page++ {
+		var count int
+		if group != "" {
+			vars, err := api.ListGroupVariables(client, group, &gitlab.ListGroupVariablesOptions{Page: page, PerPage: perPage})
+			if err != nil {
+				return nil, err
+			}
+			for _, v := range vars {
+				variables = append(variables, Variable{
+					Key: v.Key, Value: v.Value, Type: string(v.VariableType), Scope: v.EnvironmentScope,
+					Protected: v.Protected, Masked: v.Masked, Raw: v.Raw, Description: v.Description, hidden: v.Hidden,
+				})
+			}
+			count = len(vars)
+		} else {
+			vars, err := api.ListProjectVariables(client, project, &gitlab.ListProjectVariablesOptions{Page: page, PerPage: perPage})
+			if err != nil {
+				return nil, err
+			}
+			for _, v := range vars {
+				variables = append(variables, Variable{
+					Key: v.Key, Value: v.Value, Type: string(v.VariableType), Scope: v.EnvironmentScope,
+					Protected: v.Protected, Masked: v.Masked, Raw: v.Raw, Description: v.Description, hidden: v.Hidden,
+				})
+			}
+			count = len(vars)
+		}
+		if count < perPage {
+			return variables, nil
+		}
+	}
+}
+
+// diffVariables returns the changes that make the live variables match the desired ones. Without
+// attributes, only the values are compared. With prune, the live variables that aren't desired
+// are deleted, only in pruneScope if it's set.
+func diffVariables(desired, live []Variable, attributes, prune bool, pruneScope string) []change {
+	liveByID := map[string]Variable{}
+	for _, v := range live {
+		liveByID[v.id()] = v
+	}
+
+	var changes []change
+	desiredIDs := map[string]bool{}
+	for _, v := range desired {
+		desiredIDs[v.id()] = true
+		current, ok := liveByID[v.id()]
+		if !ok {
+			changes = append(changes, change{action: "create", variable: v})
+			continue
+		}
+
+		var fields []string
+		// The API doesn't return the values of hidden variables, so they can't be compared.
+		if v.Value != current.Value && !current.hidden {
+			fields = append(fields, "value")
+		}
+		if attributes {
+			if v.Type != current.Type {
+				fields = append(fields, "type")
+			}
+			if v.Protected != current.Protected {
+				fields = append(fields, "protected")
+			}
+			if v.Masked != current.Masked {
+				fields = append(fields, "masked")
+			}
+			if v.Raw != current.Raw {
+				fields = append(fields, "raw")
+			}
+			if v.Description != current.Description {
+				fields = append(fields, "description")
+			}
+		} else {
+			// Keep the attributes of the live variable.
+			value := v.Value
+			v = current
+			v.Value = value
+		}
+		if len(fields) > 0 {
+			changes = append(changes, change{action: "update", variable: v, fields: fields})
+		}
+	}
+
+	if prune {
+		var deletes []change
+		for _, v := range live {
+			if !desiredIDs[v.id()] && (pruneScope == "" || v.Scope == pruneScope) {
+				deletes = append(deletes, change{action: "delete", variable: v})
+			}
+		}
+		sort.SliceStable(deletes, func(i, j int) bool {
+			return deletes[i].variable.Key < deletes[j].variable.Key
+		})
+		changes = append(changes, deletes...)
+	}
+	return changes
+}
+
+func printChanges(opts *ImportOpts, target string, changes []change) {
+	c := opts.IO.Color()
+	counts := map[string]int{}
+
+	fmt.Fprintf(opts.IO.StdOut, "Changes to the variables of %s:\n\n", target)
+	for _, ch := range changes {
+		counts[ch.action]++
+		name := fmt.Sprintf("%s (scope %s)", ch.variable.Key, ch.variable.Scope)
+		switch ch.action {
+		case "create":
+			fmt.Fprintf(opts.IO.StdOut, "%s %s\n", c.Green("+"), name)
+		case "update":
+			fmt.Fprintf(opts.IO.StdOut, "%s %s: %s\n", c.Yellow("~"), name, strings.Join(ch.fields, ", "))
+		case "delete":
+			fmt.Fprintf(opts.IO.StdOut, "%s %s\n", c.Red("-"), name)
+		}
+	}
+	fmt.Fprintf(opts.IO.StdOut, "\n%s to create, %s to update, %s to delete.\n",
+		utils.Pluralize(counts["create"], "variable"), utils.Pluralize(counts["update"], "variable"),
+		utils.Pluralize(counts["delete"], "variable"))
+}
+
+func applyChange(client *gitlab.Client, group, project string, ch change) error {
+	v := ch.variable
+	var err error
+	switch {
+	case group != "" && ch.action == "create":
+		_, err = api.CreateGroupVariable(client, group, &gitlab.CreateGroupVariableOptions{
+			Key:              gitlab.Ptr(v.Key),
+			Value:            gitlab.Ptr(v.Value),
+			Description:      gitlab.Ptr(v.Description),
+			EnvironmentScope: gitlab.Ptr(v.Scope),
+			Masked:           gitlab.Ptr(v.Masked),
+			Protected:        gitlab.Ptr(v.Protected),
+			Raw:              gitlab.Ptr(v.Raw),
+			VariableType:     gitlab.Ptr(gitlab.VariableTypeValue(v.Type)),
+		})
+	case group != "" && ch.action == "update":
+		_, err = api.UpdateGroupVariable(client, group, v.Key, &gitlab.UpdateGroupVariableOptions{
+			Value:            gitlab.Ptr(v.Value),
+			Description:      gitlab.Ptr(v.Description),
+			EnvironmentScope: gitlab.Ptr(v.Scope),
+			Masked:           gitlab.Ptr(v.Masked),
+			Protected:        gitlab.Ptr(v.Protected),
+			Raw:              gitlab.Ptr(v.Raw),
+			VariableType:     gitlab.Ptr(gitlab.VariableTypeValue(v.Type)),
+		})
+	case group != "":
+		err = api.DeleteGroupVariable(client, group, v.Key, v.Scope)
+	case ch.action == "create":
+		_, err = api.CreateProjectVariable(client, project, &gitlab.CreateProjectVariableOptions{
+			Key:              gitlab.Ptr(v.Key),
+			Value:            gitlab.Ptr(v.Value),
+			Description:      gitlab.Ptr(v.Description),
+			EnvironmentScope: gitlab.Ptr(v.Scope),
+			Masked:           gitlab.Ptr(v.Masked),
+			Protected:        gitlab.Ptr(v.Protected),
+			Raw:              gitlab.Ptr(v.Raw),
+			VariableType:     gitlab.Ptr(gitlab.VariableTypeValue(v.Type)),
+		})
+	case ch.action == "update":
+		_, err = api.UpdateProjectVariable(client, project, v.Key, &gitlab.UpdateProjectVariableOptions{
+			Value:            gitlab.Ptr(v.Value),
+			Description:      gitlab.Ptr(v.Description),
+			EnvironmentScope: gitlab.Ptr(v.Scope),
+			Masked:           gitlab.Ptr(v.Masked),
+			Protected:        gitlab.Ptr(v.Protected),
+			Raw:              gitlab.Ptr(v.Raw),
+			VariableType:     gitlab.Ptr(gitlab.VariableTypeValue(v.Type)),
+		})
+	default:
+		err = api.DeleteProjectVariable(client, project, v.Key, v.Scope)
+	}
+	return err
+}
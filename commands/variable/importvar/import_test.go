@@ -0,0 +1,185 @@
+package importvar
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(rt http.RoundTripper, isTTY bool, cli, stdin string) (*test.CmdOut, error) {
+	ios, in, stdout, stderr := cmdtest.InitIOStreams(isTTY, "")
+	in.WriteString(stdin)
+	factory := cmdtest.InitFactory(ios, rt)
+
+	// TODO: shouldn't be there but the stub doesn't work without it
+	_, _ = factory.HttpClient()
+
+	cmd := NewCmdImport(factory, nil)
+
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+const liveProjectVariables = `[
+	{"key": "KEEP", "value": "same", "variable_type": "env_var", "environment_scope": "*"},
+	{"key": "CHANGE", "value": "old", "variable_type": "env_var", "environment_scope": "*", "masked": true},
+	{"key": "STALE", "value": "x", "variable_type": "env_var", "environment_scope": "*"},
+	{"key": "STALE", "value": "y", "variable_type": "env_var", "environment_scope": "production"}
+]`
+
+const manifest = `
+variables:
+  KEEP: same
+  CHANGE:
+    value: new
+  NEW:
+    value: created
+    protected: true
+`
+
+func Test_diffVariables(t *testing.T) {
+	live := []Variable{
+		{Key: "A", Value: "1", Type: "env_var", Scope: "*", Masked: true},
+		{Key: "B", Value: "", Type: "env_var", Scope: "*", hidden: true},
+		{Key: "C", Value: "3", Type: "env_var", Scope: "production"},
+		{Key: "D", Value: "4", Type: "env_var", Scope: "*"},
+	}
+
+	t.Run("values only", func(t *testing.T) {
+		desired := []Variable{
+			{Key: "A", Value: "2", Type: "env_var", Scope: "*"},
+			{Key: "B", Value: "secret", Type: "env_var", Scope: "*"},
+		}
+		changes := diffVariables(desired, live, false, true, "*")
+		require.Len(t, changes, 2)
+		assert.Equal(t, "update", changes[0].action)
+		assert.Equal(t, []string{"value"}, changes[0].fields)
+		// The attributes of the live variable are kept.
+		assert.True(t, changes[0].variable.Masked)
+		assert.Equal(t, "delete", changes[1].action)
+		assert.Equal(t, "D", changes[1].variable.Key)
+	})
+
+	t.Run("attributes", func(t *testing.T) {
+		desired := []Variable{
+			{Key: "A", Value: "1", Type: "file", Scope: "*"},
+			{Key: "C", Value: "3", Type: "env_var", Scope: "staging"},
+		}
+		changes := diffVariables(desired, live, true, false, "")
+		require.Len(t, changes, 2)
+		assert.Equal(t, "update", changes[0].action)
+		assert.Equal(t, []string{"type", "masked"}, changes[0].fields)
+		assert.Equal(t, "create", changes[1].action)
+	})
+}
+
+func TestImportDryRun(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/variables",
+		httpmock.NewStringResponse(http.StatusOK, liveProjectVariables))
+
+	output, err := runCommand(fakeHTTP, false, "--format yaml --prune --dry-run", manifest)
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		Changes to the variables of project OWNER/REPO:
+
+		~ CHANGE (scope *): value, masked
+		+ NEW (scope *)
+		- STALE (scope *)
+		- STALE (scope production)
+
+		1 variable to create, 1 variable to update, 2 variables to delete.
+	`), output.String())
+}
+
+func TestImportApply(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/variables",
+		httpmock.NewStringResponse(http.StatusOK, liveProjectVariables))
+	fakeHTTP.RegisterResponderWithBody(http.MethodPut, "/api/v4/projects/OWNER/REPO/variables/CHANGE?filter%5Benvironment_scope%5D=%2A",
+		`{"value": "new", "description": "", "environment_scope": "*", "masked": false, "protected": false, "raw": false, "variable_type": "env_var"}`,
+		httpmock.NewStringResponse(http.StatusOK, `{}`))
+	fakeHTTP.RegisterResponderWithBody(http.MethodPost, "/api/v4/projects/OWNER/REPO/variables",
+		`{"key": "NEW", "value": "created", "description": "", "environment_scope": "*", "masked": false, "protected": true, "raw": false, "variable_type": "env_var"}`,
+		httpmock.NewStringResponse(http.StatusCreated, `{}`))
+	// The variables with the same key are deleted by environment scope.
+	fakeHTTP.MatchURL = httpmock.PathAndQuerystring
+	fakeHTTP.RegisterResponder(http.MethodDelete, "/api/v4/projects/OWNER/REPO/variables/STALE?filter%5Benvironment_scope%5D=%2A",
+		httpmock.NewStringResponse(http.StatusNoContent, ``))
+	fakeHTTP.RegisterResponder(http.MethodDelete, "/api/v4/projects/OWNER/REPO/variables/STALE?filter%5Benvironment_scope%5D=production",
+		httpmock.NewStringResponse(http.StatusNoContent, ``))
+
+	output, err := runCommand(fakeHTTP, false, "--format yaml --prune --yes", manifest)
+	require.NoError(t, err)
+	assert.Contains(t, output.String(), "Imported the variables of project OWNER/REPO.")
+}
+
+func TestImportEnvToGroup(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/acme/variables",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"key": "TOKEN", "value": "old", "variable_type": "env_var", "environment_scope": "*", "masked": true, "protected": true},
+			{"key": "OTHER", "value": "x", "variable_type": "env_var", "environment_scope": "production"},
+			{"key": "STALE", "value": "x", "variable_type": "env_var", "environment_scope": "*"}
+		]`))
+	// The group variables are updated and deleted by environment scope too.
+	fakeHTTP.MatchURL = httpmock.PathAndQuerystring
+	fakeHTTP.RegisterResponderWithBody(http.MethodPut, "/api/v4/groups/acme/variables/TOKEN?filter%5Benvironment_scope%5D=%2A",
+		`{"value": "new", "description": "", "environment_scope": "*", "masked": true, "protected": true, "raw": false, "variable_type": "env_var"}`,
+		httpmock.NewStringResponse(http.StatusOK, `{}`))
+	fakeHTTP.RegisterResponder(http.MethodDelete, "/api/v4/groups/acme/variables/STALE?filter%5Benvironment_scope%5D=%2A",
+		httpmock.NewStringResponse(http.StatusNoContent, ``))
+
+	// OTHER has another scope, so it isn't pruned.
+	output, err := runCommand(fakeHTTP, false, "--group acme --format env --prune --yes", "TOKEN=new\n")
+	require.NoError(t, err)
+	assert.Contains(t, output.String(), "~ TOKEN (scope *): value\n")
+	assert.Contains(t, output.String(), "- STALE (scope *)\n")
+}
+
+func TestImportUpToDate(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/variables",
+		httpmock.NewStringResponse(http.StatusOK, liveProjectVariables))
+
+	output, err := runCommand(fakeHTTP, false, "--format env", "KEEP=same\n")
+	require.NoError(t, err)
+	assert.Equal(t, "✓ The variables of project OWNER/REPO are up to date.\n", output.String())
+}
+
+func TestImportRequiresConfirmation(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/variables",
+		httpmock.NewStringResponse(http.StatusOK, liveProjectVariables))
+
+	_, err := runCommand(fakeHTTP, false, "--format env", "NEW=value\n")
+	var flagErr *cmdutils.FlagError
+	assert.ErrorAs(t, err, &flagErr)
+	assert.EqualError(t, err, "--yes is required to apply the changes when not running interactively.")
+}
+
+func TestImportFlags(t *testing.T) {
+	_, err := runCommand(&httpmock.Mocker{}, false, "variables.txt", "")
+	assert.EqualError(t, err, "can't detect the format of variables.txt. Use --format.")
+
+	_, err = runCommand(&httpmock.Mocker{}, false, "--format toml", "")
+	assert.EqualError(t, err, `invalid format "toml". Use json, export, env, yaml.`)
+}
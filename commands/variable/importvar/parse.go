@@ -0,0 +1,170 @@
+package importvar
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"gitlab.com/gitlab-org/cli/commands/variable/variableutils"
+)
+
+// Formats are the formats of the files to import: the formats of `variable export`, and YAML manifests.
+var Formats = []string{"json", "export", "env", "yaml"}
+
+// Variable is a variable of a file to import, or a live variable of a project or group.
+type Variable struct {
+	Key         string `json:"key" yaml:"-"`
+	Value       string `json:"value" yaml:"value"`
+	Type        string `json:"variable_type" yaml:"type"`
+	Scope       string `json:"environment_scope" yaml:"environment_scope"`
+	Protected   bool   `json:"protected" yaml:"protected"`
+	Masked      bool   `json:"masked" yaml:"masked"`
+	Raw         bool   `json:"raw" yaml:"raw"`
+	Description string `json:"description" yaml:"description"`
+
+	// hidden is true for live variables whose value the API doesn't return.
+	hidden bool
+}
+
+func (v Variable) id() string {
+	return v.Key + "\x00" + v.Scope
+}
+
+// detectFormat returns the format of a file from its extension.
+func detectFormat(filename string) string {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".json":
+		return "json"
+	case ".yml", ".yaml":
+		return "yaml"
+	case ".env":
+		return "env"
+	case ".sh":
+		return "export"
+	}
+	return ""
+}
+
+// parseVariables parses the variables of a file. Variables without an environment scope get scope.
+func parseVariables(data []byte, format, scope string) ([]Variable, error) {
+	var variables []Variable
+	var err error
+	switch format {
+	case "json":
+		if err = json.Unmarshal(data, &variables); err != nil {
+			return nil, fmt.Errorf("invalid JSON: %w", err)
+		}
+	case "yaml":
+		variables, err = parseManifest(data)
+	case "env", "export":
+		variables, err = parseEnv(data)
+	default:
+		return nil, fmt.Errorf("unsupported format: %s", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	for i := range variables {
+		v := &variables[i]
+		if v.Type == "" {
+			v.Type = "env_var"
+		}
+		if v.Scope == "" {
+			v.Scope = scope
+		}
+		if !variableutils.IsValidKey(v.Key) {
+			return nil, fmt.Errorf("invalid key %q. %s.", v.Key, variableutils.ValidKeyMsg)
+		}
+		if v.Type != "env_var" && v.Type != "file" {
+			return nil, fmt.Errorf("invalid type %q of %s. The type must be env_var or file.", v.Type, v.Key)
+		}
+		if seen[v.id()] {
+			return nil, fmt.Errorf("%s is defined more than once for the environment scope %s.", v.Key, v.Scope)
+		}
+		seen[v.id()] = true
+	}
+	return variables, nil
+}
+
+// parseManifest parses a YAML manifest. A variable is a value, a mapping with the value and the
+// attributes of the variable, or a list of mappings for multiple environment scopes:
+//
+//	variables:
+//	  LOG_LEVEL: info
+//	  DEPLOY_KEY:
+//	    value: ...
+//	    type: file
+//	    masked: true
+//	  API_URL:
+//	    - value: https://staging.example.com
+//	      environment_scope: staging
+//	    - value: https://example.com
+//	      environment_scope: production
+func parseManifest(data []byte) ([]Variable, error) {
+	var manifest struct {
+		Variables yaml.Node `yaml:"variables"`
+	}
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("invalid YAML: %w", err)
+	}
+	node := manifest.Variables
+	if node.Kind != yaml.MappingNode {
+		return nil, errors.New("the manifest must have a variables mapping.")
+	}
+
+	var variables []Variable
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i].Value, node.Content[i+1]
+		switch value.Kind {
+		case yaml.ScalarNode:
+			variables = append(variables, Variable{Key: key, Value: value.Value})
+		case yaml.MappingNode:
+			var v Variable
+			if err := value.Decode(&v); err != nil {
+				return nil, fmt.Errorf("invalid variable %s: %w", key, err)
+			}
+			v.Key = key
+			variables = append(variables, v)
+		case yaml.SequenceNode:
+			var list []Variable
+			if err := value.Decode(&list); err != nil {
+				return nil, fmt.Errorf("invalid variable %s: %w", key, err)
+			}
+			for _, v := range list {
+				v.Key = key
+				variables = append(variables, v)
+			}
+		default:
+			return nil, fmt.Errorf("invalid variable %s on line %d.", key, value.Line)
+		}
+	}
+	return variables, nil
+}
+
+// parseEnv parses KEY=value lines, with an optional export prefix, like the env and export
+// formats of `variable export`. Values can be quoted.
+func parseEnv(data []byte) ([]Variable, error) {
+	var variables []Variable
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected KEY=value.", i+1)
+		}
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+		variables = append(variables, Variable{Key: strings.TrimSpace(key), Value: value})
+	}
+	return variables, nil
+}
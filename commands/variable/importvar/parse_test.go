@@ -0,0 +1,123 @@
+package importvar
+
+import (
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseVariables(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		data    string
+		want    []Variable
+		wantErr string
+	}{
+		{
+			name:   "json export",
+			format: "json",
+			data: `[{"key": "TOKEN", "value": "secret", "variable_type": "env_var", "protected": true,
+				"masked": true, "raw": false, "environment_scope": "production", "description": "The token."}]`,
+			want: []Variable{{Key: "TOKEN", Value: "secret", Type: "env_var", Scope: "production", Protected: true, Masked: true, Description: "The token."}},
+		},
+		{
+			name:   "env export",
+			format: "env",
+			data: heredoc.Doc(`
+				# Comments and blank lines are ignored
+
+				PLAIN=value
+				QUOTED="quoted value"
+				EQUALS=a=b
+			`),
+			want: []Variable{
+				{Key: "PLAIN", Value: "value", Type: "env_var", Scope: "staging"},
+				{Key: "QUOTED", Value: "quoted value", Type: "env_var", Scope: "staging"},
+				{Key: "EQUALS", Value: "a=b", Type: "env_var", Scope: "staging"},
+			},
+		},
+		{
+			name:   "export",
+			format: "export",
+			data:   "export TOKEN=\"secret\"\n",
+			want:   []Variable{{Key: "TOKEN", Value: "secret", Type: "env_var", Scope: "staging"}},
+		},
+		{
+			name:   "manifest",
+			format: "yaml",
+			data: heredoc.Doc(`
+				variables:
+				  LOG_LEVEL: info
+				  DEPLOY_KEY:
+				    value: key
+				    type: file
+				    masked: true
+				    protected: true
+				  API_URL:
+				    - value: https://staging.example.com
+				      environment_scope: staging
+				    - value: https://example.com
+				      environment_scope: production
+			`),
+			want: []Variable{
+				{Key: "LOG_LEVEL", Value: "info", Type: "env_var", Scope: "staging"},
+				{Key: "DEPLOY_KEY", Value: "key", Type: "file", Scope: "staging", Masked: true, Protected: true},
+				{Key: "API_URL", Value: "https://staging.example.com", Type: "env_var", Scope: "staging"},
+				{Key: "API_URL", Value: "https://example.com", Type: "env_var", Scope: "production"},
+			},
+		},
+		{
+			name:    "manifest without variables",
+			format:  "yaml",
+			data:    "LOG_LEVEL: info\n",
+			wantErr: "the manifest must have a variables mapping.",
+		},
+		{
+			name:    "invalid key",
+			format:  "env",
+			data:    "MY-KEY=value\n",
+			wantErr: `invalid key "MY-KEY"`,
+		},
+		{
+			name:    "invalid type",
+			format:  "yaml",
+			data:    "variables:\n  KEY:\n    value: v\n    type: secret\n",
+			wantErr: `invalid type "secret" of KEY. The type must be env_var or file.`,
+		},
+		{
+			name:    "duplicate",
+			format:  "env",
+			data:    "KEY=a\nKEY=b\n",
+			wantErr: "KEY is defined more than once for the environment scope staging.",
+		},
+		{
+			name:    "invalid line",
+			format:  "env",
+			data:    "KEY=a\nvalue\n",
+			wantErr: "line 2: expected KEY=value.",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseVariables([]byte(tt.data), tt.format, "staging")
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_detectFormat(t *testing.T) {
+	assert.Equal(t, "json", detectFormat("variables.json"))
+	assert.Equal(t, "yaml", detectFormat("ci/variables.YML"))
+	assert.Equal(t, "yaml", detectFormat("variables.yaml"))
+	assert.Equal(t, "env", detectFormat(".env"))
+	assert.Equal(t, "export", detectFormat("variables.sh"))
+	assert.Equal(t, "", detectFormat("-"))
+}
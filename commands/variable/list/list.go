@@ -11,6 +11,7 @@ import (
 	"gitlab.com/gitlab-org/cli/api"
 	"gitlab.com/gitlab-org/cli/commands/cmdutils"
 	"gitlab.com/gitlab-org/cli/commands/flag"
+	"gitlab.com/gitlab-org/cli/commands/variable/variableutils"
 	"gitlab.com/gitlab-org/cli/internal/glrepo"
 	"gitlab.com/gitlab-org/cli/pkg/iostreams"
 	"gitlab.com/gitlab-org/cli/pkg/tableprinter"
@@ -23,6 +24,7 @@ type ListOpts struct {
 
 	ValueSet     bool
 	Group        string
+	Instance     bool
 	OutputFormat string
 }
 
@@ -33,12 +35,13 @@ func NewCmdSet(f *cmdutils.Factory, runE func(opts *ListOpts) error) *cobra.Comm
 
 	cmd := &cobra.Command{
 		Use:     "list",
-		Short:   "List variables for a project or group.",
+		Short:   "List variables for a project, group, or instance.",
 		Aliases: []string{"ls"},
 		Args:    cobra.ExactArgs(0),
 		Example: heredoc.Doc(
 			`
 			glab variable list
+			glab variable list --instance
 		`,
 		),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
@@ -52,6 +55,12 @@ func NewCmdSet(f *cmdutils.Factory, runE func(opts *ListOpts) error) *cobra.Comm
 			}
 			opts.Group = group
 
+			if opts.Instance {
+				if err = variableutils.ValidateInstanceFlags(cmd, opts.Group); err != nil {
+					return err
+				}
+			}
+
 			if runE != nil {
 				err = runE(opts)
 				return
@@ -68,6 +77,7 @@ func NewCmdSet(f *cmdutils.Factory, runE func(opts *ListOpts) error) *cobra.Comm
 		"",
 		"Select a group or subgroup. Ignored if a repository argument is set.",
 	)
+	cmd.Flags().BoolVar(&opts.Instance, "instance", false, "List variables for the instance. Requires administrator access.")
 	cmd.Flags().StringVarP(&opts.OutputFormat, "output", "F", "text", "Format output as: text, json.")
 
 	return cmd
@@ -80,15 +90,33 @@ func listRun(opts *ListOpts) error {
 		return err
 	}
 
-	repo, err := opts.BaseRepo()
-	if err != nil {
-		return err
-	}
-
 	table := tableprinter.NewTablePrinter()
 	table.AddRow("KEY", "PROTECTED", "MASKED", "EXPANDED", "SCOPE")
 
-	if opts.Group != "" {
+	if opts.Instance {
+		opts.IO.Logf("Listing variables for the instance:\n\n")
+		variables := []*gitlab.InstanceVariable{}
+		const perPage = 100
+		for page := 1; ; page++ {
+			vars, err := api.ListInstanceVariables(httpClient, &gitlab.ListInstanceVariablesOptions{Page: page, PerPage: perPage})
+			if err != nil {
+				return err
+			}
+			variables = append(variables, vars...)
+			if len(vars) < perPage {
+				break
+			}
+		}
+		if opts.OutputFormat == "json" {
+			varListJSON, _ := json.Marshal(variables)
+			fmt.Fprintln(opts.IO.StdOut, string(varListJSON))
+		} else {
+			// Instance variables apply to all environments.
+			for _, variable := range variables {
+				table.AddRow(variable.Key, variable.Protected, variable.Masked, !variable.Raw, "*")
+			}
+		}
+	} else if opts.Group != "" {
 		opts.IO.Logf("Listing variables for the %s group:\n\n", color.Bold(opts.Group))
 		createVarOpts := &gitlab.ListGroupVariablesOptions{}
 		variables, err := api.ListGroupVariables(httpClient, opts.Group, createVarOpts)
@@ -105,6 +133,11 @@ func listRun(opts *ListOpts) error {
 			}
 		}
 	} else {
+		repo, err := opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+
 		opts.IO.Logf("Listing variables for the %s project:\n\n", color.Bold(repo.FullName()))
 		createVarOpts := &gitlab.ListProjectVariablesOptions{}
 		variables, err := api.ListProjectVariables(httpClient, repo.FullName(), createVarOpts)
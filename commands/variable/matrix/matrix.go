@@ -0,0 +1,248 @@
+package matrix
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/variable/variableutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/pkg/tableprinter"
+)
+
+type MatrixOpts struct {
+	HTTPClient func() (*gitlab.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (glrepo.Interface, error)
+
+	Key          string
+	Environments []string
+	OutputFormat string
+}
+
+func NewCmdMatrix(f *cmdutils.Factory, runE func(opts *MatrixOpts) error) *cobra.Command {
+	opts := &MatrixOpts{
+		IO: f.IO,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "matrix <key>",
+		Short: "Show where the value of a variable comes from, for each environment.",
+		Args:  cobra.ExactArgs(1),
+		Long: heredoc.Docf(`
+			Show the project, group, and instance variables with a key, and which one the jobs of
+			each environment get.
+
+			The project overrides its groups, a group overrides its parent groups, and the groups
+			override the instance. At the same level, a variable scoped to the name of an environment
+			overrides a variable with a wildcard scope, like %[1]sreview/*%[1]s, which overrides %[1]s*%[1]s.
+
+			The values of masked variables are shown as %[1]s%[2]s%[1]s. Groups and the instance whose
+			variables you can't read are skipped with a warning. Instance variables require
+			administrator access.
+
+			Protected variables are only passed to pipelines that run on protected branches and tags.
+		`, "`", maskedValue),
+		Example: heredoc.Doc(`
+			# Show the sources of DATABASE_URL for the environment scopes where it's defined
+			glab variable matrix DATABASE_URL
+
+			# Show the value the jobs of the production and review/my-branch environments get
+			glab variable matrix DATABASE_URL -e production -e review/my-branch
+
+			glab variable matrix DATABASE_URL --repo group/project --output json
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HTTPClient = f.HttpClient
+			opts.BaseRepo = f.BaseRepo
+			opts.Key = args[0]
+
+			if !variableutils.IsValidKey(opts.Key) {
+				return cmdutils.FlagError{Err: fmt.Errorf("invalid key provided.\n%s", variableutils.ValidKeyMsg)}
+			}
+			if opts.OutputFormat != "text" && opts.OutputFormat != "json" {
+				return cmdutils.FlagError{Err: fmt.Errorf("--output must be text or json, not %q.", opts.OutputFormat)}
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+			return matrixRun(opts)
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(&opts.Environments, "environment", "e", nil, "Environments to resolve the variable for. Defaults to the environment scopes of the variable.")
+	cmd.Flags().StringVarP(&opts.OutputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return cmd
+}
+
+func matrixRun(opts *MatrixOpts) error {
+	client, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	definitions, err := collectDefinitions(opts, client, repo.FullName())
+	if err != nil {
+		return err
+	}
+
+	envs := opts.Environments
+	if len(envs) == 0 {
+		envs = environments(definitions)
+	}
+	resolutions := make([]Resolution, 0, len(envs))
+	for _, env := range envs {
+		resolutions = append(resolutions, resolve(definitions, env))
+	}
+
+	if opts.OutputFormat == "json" {
+		out, err := json.Marshal(resolutions)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(opts.IO.StdOut, string(out))
+		return nil
+	}
+
+	if len(definitions) == 0 {
+		fmt.Fprintf(opts.IO.StdErr, "No variable %s is defined for %s, its groups, or the instance.\n", opts.Key, repo.FullName())
+		return nil
+	}
+	printMatrix(opts, resolutions)
+	return nil
+}
+
+// collectDefinitions returns the variables with the key of the project, of its groups from the
+// closest to the top-level group, and of the instance.
+func collectDefinitions(opts *MatrixOpts, client *gitlab.Client, projectPath string) ([]Definition, error) {
+	c := opts.IO.Color()
+
+	project, err := api.GetProject(client, projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	const perPage = 100
+	var definitions []Definition
+	for page := 1; ; page++ {
+		vars, err := api.ListProjectVariables(client, project.ID, &gitlab.ListProjectVariablesOptions{Page: page, PerPage: perPage})
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range vars {
+			if v.Key == opts.Key {
+				definitions = append(definitions, newDefinition("project", project.PathWithNamespace, v.EnvironmentScope,
+					v.Value, string(v.VariableType), v.Protected, v.Masked || v.Hidden, v.Raw, 0))
+			}
+		}
+		if len(vars) < perPage {
+			break
+		}
+	}
+
+	depth := 0
+	if project.Namespace != nil && project.Namespace.Kind == "group" {
+		var groupID interface{} = project.Namespace.FullPath
+		for groupID != nil {
+			depth++
+			group, err := api.GetGroup(client, groupID)
+			if err != nil {
+				fmt.Fprintf(opts.IO.StdErr, "%s Skipped group %v and its parent groups: %s\n", c.WarnIcon(), groupID, err)
+				break
+			}
+			groupDefinitions, err := groupVariables(client, group, opts.Key, depth)
+			if err != nil {
+				fmt.Fprintf(opts.IO.StdErr, "%s Skipped group %s: %s\n", c.WarnIcon(), group.FullPath, err)
+			}
+			definitions = append(definitions, groupDefinitions...)
+
+			groupID = nil
+			if group.ParentID != 0 {
+				groupID = group.ParentID
+			}
+		}
+	}
+
+	v, err := api.GetInstanceVariable(client, opts.Key)
+	switch {
+	case err == nil:
+		definitions = append(definitions, newDefinition("instance", "", "*",
+			v.Value, string(v.VariableType), v.Protected, v.Masked, v.Raw, depth+1))
+	case !errors.Is(err, gitlab.ErrNotFound):
+		fmt.Fprintf(opts.IO.StdErr, "%s Skipped instance variables: %s\n", c.WarnIcon(), err)
+	}
+
+	return definitions, nil
+}
+
+func groupVariables(client *gitlab.Client, group *gitlab.Group, key string, depth int) ([]Definition, error) {
+	const perPage = 100
+	var definitions []Definition
+	for page := 1; ; page++ {
+		vars, err := api.ListGroupVariables(client, group.ID, &gitlab.ListGroupVariablesOptions{Page: page, PerPage: perPage})
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range vars {
+			if v.Key == key {
+				definitions = append(definitions, newDefinition("group", group.FullPath, v.EnvironmentScope,
+					v.Value, string(v.VariableType), v.Protected, v.Masked || v.Hidden, v.Raw, depth))
+			}
+		}
+		if len(vars) < perPage {
+			return definitions, nil
+		}
+	}
+}
+
+func newDefinition(level, path, scope, value, variableType string, protected, masked, raw bool, depth int) Definition {
+	if masked {
+		value = maskedValue
+	}
+	return Definition{
+		Level:     level,
+		Path:      path,
+		Scope:     scope,
+		Value:     value,
+		Type:      variableType,
+		Protected: protected,
+		Masked:    masked,
+		Raw:       raw,
+		depth:     depth,
+	}
+}
+
+func printMatrix(opts *MatrixOpts, resolutions []Resolution) {
+	c := opts.IO.Color()
+
+	table := tableprinter.NewTablePrinter()
+	table.AddRow("ENVIRONMENT", "SOURCE", "SCOPE", "VALUE", "PROTECTED", "OVERRIDES")
+	for _, r := range resolutions {
+		if r.Effective == nil {
+			table.AddRow(r.Environment, c.Gray("not set"), "", "", "", "")
+			continue
+		}
+		overrides := make([]string, 0, len(r.Overridden))
+		for _, d := range r.Overridden {
+			overrides = append(overrides, fmt.Sprintf("%s (%s)", d.Source(), d.Scope))
+		}
+		table.AddRow(r.Environment, r.Effective.Source(), r.Effective.Scope, r.Effective.Value,
+			r.Effective.Protected, strings.Join(overrides, ", "))
+	}
+	fmt.Fprint(opts.IO.StdOut, table.String())
+}
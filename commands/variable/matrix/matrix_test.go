@@ -0,0 +1,155 @@
+package matrix
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	ios, _, stdout, stderr := cmdtest.InitIOStreams(false, "")
+	factory := cmdtest.InitFactory(ios, rt)
+
+	// TODO: shouldn't be there but the stub doesn't work without it
+	_, _ = factory.HttpClient()
+
+	return cmdtest.ExecuteCommand(NewCmdMatrix(factory, nil), cli, stdout, stderr)
+}
+
+func registerVariables(fakeHTTP *httpmock.Mocker) {
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 7, "path_with_namespace": "OWNER/REPO", "namespace": {"id": 5, "kind": "group", "full_path": "OWNER"}}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/7/variables",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"key": "LOG_LEVEL", "value": "debug", "environment_scope": "*"},
+			{"key": "DATABASE_URL", "value": "postgres://prod", "environment_scope": "production", "masked": true, "protected": true}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/OWNER",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 5, "full_path": "OWNER", "parent_id": 3}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/5/variables",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"key": "DATABASE_URL", "value": "postgres://shared", "environment_scope": "*"},
+			{"key": "DATABASE_URL", "value": "postgres://review", "environment_scope": "review/*"}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/3",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 3, "full_path": "top"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/3/variables",
+		httpmock.NewStringResponse(http.StatusForbidden, `{"message": "403 Forbidden"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/admin/ci/variables/DATABASE_URL",
+		httpmock.NewStringResponse(http.StatusOK, `{"key": "DATABASE_URL", "value": "postgres://default"}`))
+}
+
+func TestMatrixAsText(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+	registerVariables(fakeHTTP)
+
+	output, err := runCommand(fakeHTTP, "DATABASE_URL")
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		ENVIRONMENT	SOURCE	SCOPE	VALUE	PROTECTED	OVERRIDES
+		*	group OWNER	*	postgres://shared	false	instance (*)
+		production	project OWNER/REPO	production	[MASKED]	true	group OWNER (*), instance (*)
+		review/*	group OWNER	review/*	postgres://review	false	group OWNER (*), instance (*)
+	`), output.String())
+	assert.Contains(t, output.Stderr(), "Skipped group top: GET")
+}
+
+func TestMatrixAsJSON(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+	registerVariables(fakeHTTP)
+
+	output, err := runCommand(fakeHTTP, "DATABASE_URL -e review/my-branch -e staging --output json")
+	require.NoError(t, err)
+
+	var resolutions []Resolution
+	require.NoError(t, json.Unmarshal([]byte(output.String()), &resolutions))
+	require.Len(t, resolutions, 2)
+
+	assert.Equal(t, "review/my-branch", resolutions[0].Environment)
+	require.NotNil(t, resolutions[0].Effective)
+	assert.Equal(t, "group", resolutions[0].Effective.Level)
+	assert.Equal(t, "review/*", resolutions[0].Effective.Scope)
+	assert.Equal(t, "postgres://review", resolutions[0].Effective.Value)
+	assert.Len(t, resolutions[0].Overridden, 2)
+
+	assert.Equal(t, "staging", resolutions[1].Environment)
+	require.NotNil(t, resolutions[1].Effective)
+	assert.Equal(t, "*", resolutions[1].Effective.Scope)
+}
+
+func TestMatrixNotDefined(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 7, "path_with_namespace": "OWNER/REPO", "namespace": {"id": 1, "kind": "user", "full_path": "OWNER"}}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/7/variables",
+		httpmock.NewStringResponse(http.StatusOK, `[]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/admin/ci/variables/DATABASE_URL",
+		httpmock.NewStringResponse(http.StatusForbidden, `{"message": "403 Forbidden"}`))
+
+	output, err := runCommand(fakeHTTP, "DATABASE_URL")
+	require.NoError(t, err)
+
+	assert.Empty(t, output.String())
+	assert.Contains(t, output.Stderr(), "Skipped instance variables: GET")
+	assert.Contains(t, output.Stderr(), "No variable DATABASE_URL is defined for OWNER/REPO, its groups, or the instance.")
+}
+
+func Test_scopeMatches(t *testing.T) {
+	tests := []struct {
+		scope       string
+		environment string
+		want        bool
+	}{
+		{scope: "*", environment: "production", want: true},
+		{scope: "production", environment: "production", want: true},
+		{scope: "production", environment: "production-eu", want: false},
+		{scope: "review/*", environment: "review/my-branch", want: true},
+		{scope: "review/*", environment: "review/feature/x", want: true},
+		{scope: "review/*", environment: "staging", want: false},
+		{scope: "*-eu", environment: "production-eu", want: true},
+		{scope: "*-eu", environment: "production-us", want: false},
+		{scope: "prod*-*", environment: "production-eu", want: true},
+		{scope: "a*a", environment: "a", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.scope+" "+tt.environment, func(t *testing.T) {
+			assert.Equal(t, tt.want, scopeMatches(tt.scope, tt.environment))
+		})
+	}
+}
+
+func Test_resolve(t *testing.T) {
+	definitions := []Definition{
+		{Level: "instance", Scope: "*", Value: "instance", depth: 3},
+		{Level: "group", Path: "top", Scope: "production", Value: "top", depth: 2},
+		{Level: "group", Path: "top/sub", Scope: "*", Value: "sub", depth: 1},
+		{Level: "project", Path: "top/sub/project", Scope: "prod*", Value: "wildcard", depth: 0},
+		{Level: "project", Path: "top/sub/project", Scope: "production", Value: "exact", depth: 0},
+	}
+
+	got := resolve(definitions, "production")
+	require.NotNil(t, got.Effective)
+	assert.Equal(t, "exact", got.Effective.Value)
+	values := []string{}
+	for _, d := range got.Overridden {
+		values = append(values, d.Value)
+	}
+	assert.Equal(t, []string{"wildcard", "sub", "top", "instance"}, values)
+
+	got = resolve(definitions[1:2], "staging")
+	assert.Nil(t, got.Effective)
+	assert.Empty(t, got.Overridden)
+}
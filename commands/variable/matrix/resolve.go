@@ -0,0 +1,112 @@
+package matrix
+
+import (
+	"sort"
+	"strings"
+)
+
+// maskedValue replaces the value of masked variables, like the job logs do.
+const maskedValue = "[MASKED]"
+
+// Definition is a variable with the key at one level: the project, one of its groups, or the instance.
+type Definition struct {
+	Level     string `json:"level"`
+	Path      string `json:"path,omitempty"`
+	Scope     string `json:"environment_scope"`
+	Value     string `json:"value"`
+	Type      string `json:"variable_type"`
+	Protected bool   `json:"protected"`
+	Masked    bool   `json:"masked"`
+	Raw       bool   `json:"raw"`
+
+	// depth is 0 for the project, 1 for its group, 2 for the parent of the group, and so on.
+	// The instance is the deepest level.
+	depth int
+}
+
+// Source describes the level of a definition, like "group my-group".
+func (d Definition) Source() string {
+	if d.Path == "" {
+		return d.Level
+	}
+	return d.Level + " " + d.Path
+}
+
+// Resolution is the definition of a variable that the jobs of an environment get, and the
+// definitions it overrides.
+type Resolution struct {
+	Environment string       `json:"environment"`
+	Effective   *Definition  `json:"effective"`
+	Overridden  []Definition `json:"overridden"`
+}
+
+// resolve returns the definition that the jobs of an environment get. Like in pipelines, the
+// project overrides its groups, a group overrides its parent groups, and the groups override the
+// instance. At the same level, an environment name overrides a wildcard scope, which overrides *.
+func resolve(definitions []Definition, environment string) Resolution {
+	var matching []Definition
+	for _, d := range definitions {
+		if scopeMatches(d.Scope, environment) {
+			matching = append(matching, d)
+		}
+	}
+	sort.SliceStable(matching, func(i, j int) bool {
+		if matching[i].depth != matching[j].depth {
+			return matching[i].depth < matching[j].depth
+		}
+		return scopeRank(matching[i].Scope) > scopeRank(matching[j].Scope)
+	})
+
+	resolution := Resolution{Environment: environment, Overridden: []Definition{}}
+	if len(matching) > 0 {
+		resolution.Effective = &matching[0]
+		resolution.Overridden = matching[1:]
+	}
+	return resolution
+}
+
+// scopeRank ranks the environment scopes by how specific they are.
+func scopeRank(scope string) int {
+	switch {
+	case scope == "*":
+		return 0
+	case strings.Contains(scope, "*"):
+		return 1 + len(scope)
+	}
+	return 1 << 16
+}
+
+// scopeMatches reports whether an environment scope matches the name of an environment. The
+// wildcard * matches any characters, including /.
+func scopeMatches(scope, environment string) bool {
+	parts := strings.Split(scope, "*")
+	if len(parts) == 1 {
+		return scope == environment
+	}
+	if !strings.HasPrefix(environment, parts[0]) {
+		return false
+	}
+	environment = environment[len(parts[0]):]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(environment, part)
+		if i < 0 {
+			return false
+		}
+		environment = environment[i+len(part):]
+	}
+	return strings.HasSuffix(environment, parts[len(parts)-1])
+}
+
+// environments returns the environment scopes of the definitions, with * first.
+func environments(definitions []Definition) []string {
+	scopes := []string{"*"}
+	seen := map[string]bool{"*": true}
+	for _, d := range definitions {
+		if !seen[d.Scope] {
+			seen[d.Scope] = true
+			scopes = append(scopes, d.Scope)
+		}
+	}
+	sort.Strings(scopes[1:])
+	return scopes
+}
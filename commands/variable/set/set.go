@@ -28,6 +28,7 @@ type SetOpts struct {
 	Masked    bool
 	Raw       bool
 	Group     string
+	Instance  bool
 }
 
 func NewCmdSet(f *cmdutils.Factory, runE func(opts *SetOpts) error) *cobra.Command {
@@ -37,7 +38,7 @@ func NewCmdSet(f *cmdutils.Factory, runE func(opts *SetOpts) error) *cobra.Comma
 
 	cmd := &cobra.Command{
 		Use:     "set <key> <value>",
-		Short:   "Create a new variable for a project or group.",
+		Short:   "Create a new variable for a project, group, or instance.",
 		Aliases: []string{"new", "create"},
 		Args:    cobra.RangeArgs(1, 2),
 		Example: heredoc.Doc(`
@@ -48,6 +49,7 @@ func NewCmdSet(f *cmdutils.Factory, runE func(opts *SetOpts) error) *cobra.Comma
 			glab variable set FROM_FILE < secret.txt
 			cat file.txt | glab variable set SERVER_TOKEN
 			cat token.txt | glab variable set GROUP_TOKEN -g mygroup --scope=prod
+			glab variable set INSTANCE_VAR "some value" --instance
 		`),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			// Supports repo override
@@ -66,6 +68,12 @@ func NewCmdSet(f *cmdutils.Factory, runE func(opts *SetOpts) error) *cobra.Comma
 				return
 			}
 
+			if opts.Instance {
+				if err = variableutils.ValidateInstanceFlags(cmd, opts.Group); err != nil {
+					return
+				}
+			}
+
 			opts.Value, err = variableutils.GetValue(opts.Value, opts.IO, args)
 			if err != nil {
 				return
@@ -91,6 +99,7 @@ func NewCmdSet(f *cmdutils.Factory, runE func(opts *SetOpts) error) *cobra.Comma
 	cmd.Flags().StringVarP(&opts.Type, "type", "t", "env_var", "The type of a variable: env_var, file.")
 	cmd.Flags().StringVarP(&opts.Scope, "scope", "s", "*", "The environment_scope of the variable. Values: all (*), or specific environments.")
 	cmd.Flags().StringVarP(&opts.Group, "group", "g", "", "Set variable for a group.")
+	cmd.Flags().BoolVar(&opts.Instance, "instance", false, "Set variable for the instance. Requires administrator access.")
 	cmd.Flags().BoolVarP(&opts.Masked, "masked", "m", false, "Whether the variable is masked.")
 	cmd.Flags().BoolVarP(&opts.Raw, "raw", "r", false, "Whether the variable is treated as a raw string.")
 	cmd.Flags().BoolVarP(&opts.Protected, "protected", "p", false, "Whether the variable is protected.")
@@ -104,6 +113,25 @@ func setRun(opts *SetOpts) error {
 		return err
 	}
 
+	if opts.Instance {
+		// creating instance-level variable
+		createVarOpts := &gitlab.CreateInstanceVariableOptions{
+			Key:          gitlab.Ptr(opts.Key),
+			Value:        gitlab.Ptr(opts.Value),
+			Masked:       gitlab.Ptr(opts.Masked),
+			Protected:    gitlab.Ptr(opts.Protected),
+			VariableType: gitlab.Ptr(gitlab.VariableTypeValue(opts.Type)),
+			Raw:          gitlab.Ptr(opts.Raw),
+		}
+		_, err = api.CreateInstanceVariable(httpClient, createVarOpts)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(opts.IO.StdOut, "%s Created variable %s for the instance.\n", c.GreenCheck(), opts.Key)
+		return nil
+	}
+
 	if opts.Group != "" {
 		// creating group-level variable
 		createVarOpts := &gitlab.CreateGroupVariableOptions{
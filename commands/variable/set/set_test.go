@@ -110,6 +110,24 @@ func Test_NewCmdSet(t *testing.T) {
 				Group: "coolGroup",
 			},
 		},
+		{
+			name: "instance variable",
+			cli:  `cool_secret -v"$variable_name" --instance`,
+			wants: SetOpts{
+				Key:   "cool_secret",
+				Value: "$variable_name",
+			},
+		},
+		{
+			name:     "instance variable with scope",
+			cli:      `cool_secret -v"$variable_name" --instance --scope prod`,
+			wantsErr: true,
+		},
+		{
+			name:     "instance and group variable",
+			cli:      `cool_secret -v"$variable_name" --instance --group coolGroup`,
+			wantsErr: true,
+		},
 		{
 			name: "raw is false by default",
 			cli:  `cool_secret -v"$variable_name"`,
@@ -237,3 +255,42 @@ func Test_setRun_group(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, stdout.String(), "✓ Created variable NEW_VARIABLE for group mygroup.\n")
 }
+
+func Test_setRun_instance(t *testing.T) {
+	reg := &httpmock.Mocker{}
+	defer reg.Verify(t)
+
+	reg.RegisterResponder(http.MethodPost, "/admin/ci/variables",
+		httpmock.NewStringResponse(http.StatusCreated, `
+			{
+				"key": "NEW_VARIABLE",
+				"value": "new value",
+				"variable_type": "env_var",
+				"protected": false,
+				"masked": false,
+				"raw": false
+			}
+		`),
+	)
+
+	io, _, stdout, _ := iostreams.Test()
+
+	opts := &SetOpts{
+		HTTPClient: func() (*gitlab.Client, error) {
+			a, _ := api.TestClient(&http.Client{Transport: reg}, "", "gitlab.com", false)
+			return a.Lab(), nil
+		},
+		BaseRepo: func() (glrepo.Interface, error) {
+			return glrepo.FromFullName("owner/repo")
+		},
+		IO:       io,
+		Key:      "NEW_VARIABLE",
+		Value:    "new value",
+		Instance: true,
+	}
+	_, _ = opts.HTTPClient()
+
+	err := setRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, stdout.String(), "✓ Created variable NEW_VARIABLE for the instance.\n")
+}
@@ -28,6 +28,7 @@ type UpdateOpts struct {
 	Masked    bool
 	Raw       bool
 	Group     string
+	Instance  bool
 }
 
 func NewCmdSet(f *cmdutils.Factory, runE func(opts *UpdateOpts) error) *cobra.Command {
@@ -37,7 +38,7 @@ func NewCmdSet(f *cmdutils.Factory, runE func(opts *UpdateOpts) error) *cobra.Co
 
 	cmd := &cobra.Command{
 		Use:   "update <key> <value>",
-		Short: "Update an existing variable for a project or group.",
+		Short: "Update an existing variable for a project, group, or instance.",
 		Args:  cobra.RangeArgs(1, 2),
 		Example: heredoc.Doc(`
 			glab variable update WITH_ARG "some value"
@@ -47,6 +48,7 @@ func NewCmdSet(f *cmdutils.Factory, runE func(opts *UpdateOpts) error) *cobra.Co
 			glab variable update FROM_FILE < secret.txt
 			cat file.txt | glab variable update SERVER_TOKEN
 			cat token.txt | glab variable update GROUP_TOKEN -g mygroup --scope=prod
+			glab variable update INSTANCE_VAR "some value" --instance
 		`),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			// Supports repo override
@@ -70,6 +72,12 @@ func NewCmdSet(f *cmdutils.Factory, runE func(opts *UpdateOpts) error) *cobra.Co
 				return
 			}
 
+			if opts.Instance {
+				if err = variableutils.ValidateInstanceFlags(cmd, opts.Group); err != nil {
+					return
+				}
+			}
+
 			opts.Value, err = variableutils.GetValue(opts.Value, opts.IO, args)
 			if err != nil {
 				return
@@ -95,6 +103,7 @@ func NewCmdSet(f *cmdutils.Factory, runE func(opts *UpdateOpts) error) *cobra.Co
 	cmd.Flags().StringVarP(&opts.Type, "type", "t", "env_var", "The type of a variable: env_var, file.")
 	cmd.Flags().StringVarP(&opts.Scope, "scope", "s", "*", "The environment_scope of the variable. Values: all (*), or specific environments.")
 	cmd.Flags().StringVarP(&opts.Group, "group", "g", "", "Set variable for a group.")
+	cmd.Flags().BoolVar(&opts.Instance, "instance", false, "Set variable for the instance. Requires administrator access.")
 	cmd.Flags().BoolVarP(&opts.Masked, "masked", "m", false, "Whether the variable is masked.")
 	cmd.Flags().BoolVarP(&opts.Raw, "raw", "r", false, "Whether the variable is treated as a raw string.")
 	cmd.Flags().BoolVarP(&opts.Protected, "protected", "p", false, "Whether the variable is protected.")
@@ -108,6 +117,25 @@ func updateRun(opts *UpdateOpts) error {
 		return err
 	}
 
+	if opts.Instance {
+		// update instance-level variable
+		updateInstanceVarOpts := &gitlab.UpdateInstanceVariableOptions{
+			Value:        gitlab.Ptr(opts.Value),
+			VariableType: gitlab.Ptr(gitlab.VariableTypeValue(opts.Type)),
+			Masked:       gitlab.Ptr(opts.Masked),
+			Protected:    gitlab.Ptr(opts.Protected),
+			Raw:          gitlab.Ptr(opts.Raw),
+		}
+
+		_, err = api.UpdateInstanceVariable(httpClient, opts.Key, updateInstanceVarOpts)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(opts.IO.StdOut, "%s Updated variable %s for the instance.\n", c.GreenCheck(), opts.Key)
+		return nil
+	}
+
 	if opts.Group != "" {
 		// update group-level variable
 		updateGroupVarOpts := &gitlab.UpdateGroupVariableOptions{
@@ -6,7 +6,9 @@ import (
 	deleteCmd "gitlab.com/gitlab-org/cli/commands/variable/delete"
 	exportCmd "gitlab.com/gitlab-org/cli/commands/variable/export"
 	getCmd "gitlab.com/gitlab-org/cli/commands/variable/get"
+	importCmd "gitlab.com/gitlab-org/cli/commands/variable/importvar"
 	listCmd "gitlab.com/gitlab-org/cli/commands/variable/list"
+	matrixCmd "gitlab.com/gitlab-org/cli/commands/variable/matrix"
 	setCmd "gitlab.com/gitlab-org/cli/commands/variable/set"
 	updateCmd "gitlab.com/gitlab-org/cli/commands/variable/update"
 )
@@ -14,7 +16,7 @@ import (
 func NewVariableCmd(f *cmdutils.Factory) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "variable",
-		Short:   "Manage variables for a GitLab project or group.",
+		Short:   "Manage variables for a GitLab project, group, or instance.",
 		Aliases: []string{"var"},
 	}
 
@@ -26,5 +28,7 @@ func NewVariableCmd(f *cmdutils.Factory) *cobra.Command {
 	cmd.AddCommand(updateCmd.NewCmdSet(f, nil))
 	cmd.AddCommand(getCmd.NewCmdSet(f, nil))
 	cmd.AddCommand(exportCmd.NewCmdExport(f, nil))
+	cmd.AddCommand(importCmd.NewCmdImport(f, nil))
+	cmd.AddCommand(matrixCmd.NewCmdMatrix(f, nil))
 	return cmd
 }
@@ -0,0 +1,23 @@
+package variableutils
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+)
+
+// ValidateInstanceFlags checks the flags of a command run with --instance. Instance variables
+// don't belong to a group, and aren't scoped to environments.
+func ValidateInstanceFlags(cmd *cobra.Command, group string) error {
+	if group != "" {
+		return cmdutils.FlagError{Err: errors.New("specify either --instance or --group.")}
+	}
+	if cmd.Flags().Lookup("scope") != nil && cmd.Flags().Changed("scope") {
+		if scope, _ := cmd.Flags().GetString("scope"); scope != "*" {
+			return cmdutils.FlagError{Err: errors.New("instance variables aren't scoped to environments. Remove --scope.")}
+		}
+	}
+	return nil
+}
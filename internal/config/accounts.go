@@ -0,0 +1,256 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/zalando/go-keyring"
+	"gopkg.in/yaml.v3"
+)
+
+// credentialKeys are the keys of a host that belong to an account, and not to the host.
+var credentialKeys = []string{"token", "job_token", "user", "is_oauth2", "oauth2_refresh_token", "oauth2_expiry_date", "oauth2_code_verifier", "git_paths"}
+
+// IsCredentialKey returns true if a key belongs to the accounts of a host.
+func IsCredentialKey(key string) bool {
+	key = ConfigKeyEquivalence(key)
+	for _, k := range credentialKeys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// UseAccount selects the account used for all the hosts, like with the --account flag.
+// The account isn't saved to the configuration file.
+func (c *fileConfig) UseAccount(name string) {
+	c.accountOverride = name
+}
+
+// AccountOverride returns the account selected with UseAccount.
+func (c *fileConfig) AccountOverride() string {
+	return c.accountOverride
+}
+
+// ForAccount returns a Config that uses an account for all the hosts. It shares the
+// settings of c, and changes to one are seen by the other.
+func (c *fileConfig) ForAccount(name string) Config {
+	accountCfg := *c
+	accountCfg.accountOverride = name
+	return &accountCfg
+}
+
+// AccountNotFoundError is returned when the selected account doesn't exist for a host.
+type AccountNotFoundError struct {
+	Hostname string
+	Account  string
+}
+
+func (e *AccountNotFoundError) Error() string {
+	return fmt.Sprintf("account %q not found for %s. Run `glab auth login --hostname %s --account %s` to add it.", e.Account, e.Hostname, e.Hostname, e.Account)
+}
+
+// Account returns the account used for a host: the account selected with --account or
+// GLAB_ACCOUNT, the account of the local configuration, or the active account of the host.
+// It is empty when the host has a single set of credentials, with no accounts.
+func (c *fileConfig) Account(hostname string) string {
+	if c.accountOverride != "" {
+		return c.accountOverride
+	}
+	if account := GetFromEnv("account"); account != "" {
+		return account
+	}
+	if l, err := c.Local(); err == nil {
+		if account, ok := l.Get("account"); ok {
+			return account
+		}
+	}
+
+	hostCfg, err := c.configForHost(hostname)
+	if err != nil {
+		return ""
+	}
+	account, _ := hostCfg.GetStringValue("account")
+	return account
+}
+
+// Accounts returns the names of the accounts of a host.
+func (c *fileConfig) Accounts(hostname string) ([]string, error) {
+	accounts, err := c.accountsMap(hostname, false)
+	if err != nil || accounts == nil {
+		return nil, err
+	}
+
+	var names []string
+	for i := 0; i < len(accounts.Root.Content)-1; i += 2 {
+		names = append(names, accounts.Root.Content[i].Value)
+	}
+	return names, nil
+}
+
+// AddAccount adds an account to a host, and makes it the active account. The credentials
+// of a host with no accounts are moved to an account named after their user.
+func (c *fileConfig) AddAccount(hostname, name string) error {
+	if name == "" {
+		return errors.New("the name of the account is required.")
+	}
+
+	accounts, err := c.accountsMap(hostname, true)
+	if err != nil {
+		return err
+	}
+
+	if len(accounts.Root.Content) == 0 {
+		// move the credentials of the host to its first account
+		hostCfg, _ := c.configForHost(hostname)
+		legacy := &ConfigMap{Root: &yaml.Node{Kind: yaml.MappingNode}}
+		for _, key := range credentialKeys {
+			if value, err := hostCfg.GetStringValue(key); err == nil {
+				_ = legacy.SetStringValue(key, value)
+				hostCfg.RemoveEntry(key)
+			}
+		}
+		if !legacy.Empty() {
+			legacyName, _ := legacy.GetStringValue("user")
+			if legacyName == "" {
+				legacyName = "default"
+			}
+			if legacyName != name {
+				accounts.Root.Content = append(accounts.Root.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: legacyName}, legacy.Root)
+			}
+		}
+	}
+
+	if _, err := accounts.FindEntry(name); isNotFoundError(err) {
+		accounts.Root.Content = append(accounts.Root.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: name}, &yaml.Node{Kind: yaml.MappingNode})
+	}
+
+	return c.Set(hostname, "account", name)
+}
+
+// SwitchAccount makes an account the active account of a host.
+func (c *fileConfig) SwitchAccount(hostname, name string) error {
+	if _, err := c.accountConfig(hostname, name); err != nil {
+		return err
+	}
+	return c.Set(hostname, "account", name)
+}
+
+// RemoveAccount removes an account of a host, with its token in the keyring. When the name
+// is empty, it removes the credentials of a host with no accounts.
+func (c *fileConfig) RemoveAccount(hostname, name string) error {
+	hostCfg, err := c.configForHost(hostname)
+	if err != nil {
+		return err
+	}
+
+	if name == "" {
+		for _, key := range credentialKeys {
+			hostCfg.RemoveEntry(key)
+		}
+		return c.deleteToken(hostname, "")
+	}
+
+	accounts, err := c.accountsMap(hostname, false)
+	if err != nil {
+		return err
+	}
+	if accounts == nil {
+		return &AccountNotFoundError{Hostname: hostname, Account: name}
+	}
+	if _, err := accounts.FindEntry(name); err != nil {
+		return &AccountNotFoundError{Hostname: hostname, Account: name}
+	}
+	accounts.RemoveEntry(name)
+
+	if active, _ := hostCfg.GetStringValue("account"); active == name {
+		// another account becomes the active account
+		hostCfg.RemoveEntry("account")
+		if len(accounts.Root.Content) > 0 {
+			_ = hostCfg.SetStringValue("account", accounts.Root.Content[0].Value)
+		}
+	}
+
+	return c.deleteToken(hostname, name)
+}
+
+// deleteToken removes the token of an account from the credential store of the host.
+func (c *fileConfig) deleteToken(hostname, account string) error {
+	store, err := c.CredentialStore(hostname)
+	if err != nil {
+		return err
+	}
+	if store != nil {
+		if err := store.Delete(hostname, account); err != nil {
+			return fmt.Errorf("failed to remove the token from %s: %w", store.Name(), err)
+		}
+		return nil
+	}
+	return deleteKeyringToken(hostname, account)
+}
+
+func deleteKeyringToken(hostname, account string) error {
+	err := keyring.Delete("glab:"+hostname, account)
+	if err != nil && !errors.Is(err, keyring.ErrNotFound) && !errors.Is(err, keyring.ErrUnsupportedPlatform) {
+		return fmt.Errorf("failed to remove the token from the keyring: %w", err)
+	}
+	return nil
+}
+
+// accountsMap returns the accounts of a host, or nil when the host has none. When create
+// is set, the host and its accounts are created when they don't exist.
+func (c *fileConfig) accountsMap(hostname string, create bool) (*ConfigMap, error) {
+	hostCfg, err := c.configForHost(hostname)
+	if isNotFoundError(err) && create {
+		hostCfg = c.makeConfigForHost(hostname)
+	} else if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	entry, err := hostCfg.FindEntry("accounts")
+	if err == nil && entry.ValueNode != nil && entry.ValueNode.Kind == yaml.MappingNode {
+		return &ConfigMap{Root: entry.ValueNode}, nil
+	}
+	if !create {
+		return nil, nil
+	}
+
+	hostCfg.RemoveEntry("accounts")
+	valueNode := &yaml.Node{Kind: yaml.MappingNode}
+	hostCfg.Root.Content = append(hostCfg.Root.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: "accounts"}, valueNode)
+	return &ConfigMap{Root: valueNode}, nil
+}
+
+// accountConfig returns the configuration of an account of a host.
+func (c *fileConfig) accountConfig(hostname, name string) (*ConfigMap, error) {
+	accounts, err := c.accountsMap(hostname, false)
+	if err != nil {
+		return nil, err
+	}
+	if accounts != nil {
+		if entry, err := accounts.FindEntry(name); err == nil && entry.ValueNode != nil && entry.ValueNode.Kind == yaml.MappingNode {
+			return &ConfigMap{Root: entry.ValueNode}, nil
+		}
+	}
+	return nil, &AccountNotFoundError{Hostname: hostname, Account: name}
+}
+
+// getFromAccount returns a credential of an account, from the configuration or the keyring.
+func (c *fileConfig) getFromAccount(hostname, account, key string) (string, string, error) {
+	accountCfg, err := c.accountConfig(hostname, account)
+	if err != nil {
+		return "", "", err
+	}
+
+	value, _ := accountCfg.GetStringValue(key)
+	if value == "" && key == "token" {
+		if token, err := keyring.Get("glab:"+hostname, account); err == nil {
+			return token, "keyring", nil
+		}
+	}
+	return value, ConfigFile(), nil
+}
@@ -0,0 +1,175 @@
+package config
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+)
+
+func Test_fileConfig_AddAccount(t *testing.T) {
+	defer StubConfig(`---
+hosts:
+  gitlab.com:
+    token: glpat-alice
+    git_protocol: ssh
+    user: alice
+`, "")()
+
+	mainBuf := bytes.Buffer{}
+	aliasesBuf := bytes.Buffer{}
+	defer StubWriteConfig(&mainBuf, &aliasesBuf)()
+
+	c, err := ParseConfig("config.yml")
+	require.NoError(t, err)
+
+	require.NoError(t, c.AddAccount("gitlab.com", "bot"))
+	require.NoError(t, c.Set("gitlab.com", "token", "glpat-bot"))
+	require.NoError(t, c.Write())
+
+	expected := heredoc.Doc(`
+		hosts:
+		    gitlab.com:
+		        git_protocol: ssh
+		        accounts:
+		            alice:
+		                token: glpat-alice
+		                user: alice
+		            bot:
+		                token: glpat-bot
+		        account: bot
+	`)
+	assert.Equal(t, expected, mainBuf.String())
+
+	accounts, err := c.Accounts("gitlab.com")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"alice", "bot"}, accounts)
+	assert.Equal(t, "bot", c.Account("gitlab.com"))
+
+	token, err := c.Get("gitlab.com", "token")
+	require.NoError(t, err)
+	assert.Equal(t, "glpat-bot", token)
+
+	protocol, err := c.Get("gitlab.com", "git_protocol")
+	require.NoError(t, err)
+	assert.Equal(t, "ssh", protocol)
+}
+
+func Test_fileConfig_accountSelection(t *testing.T) {
+	defer StubConfig(`---
+hosts:
+  gitlab.com:
+    account: alice
+    accounts:
+      alice:
+        token: glpat-alice
+      bot:
+        token: glpat-bot
+`, "")()
+
+	c, err := ParseConfig("config.yml")
+	require.NoError(t, err)
+
+	token, _ := c.Get("gitlab.com", "token")
+	assert.Equal(t, "glpat-alice", token)
+
+	t.Run("local config", func(t *testing.T) {
+		local, err := c.Local()
+		require.NoError(t, err)
+		require.NoError(t, local.SetStringValue("account", "bot"))
+		defer local.RemoveEntry("account")
+
+		token, _ := c.Get("gitlab.com", "token")
+		assert.Equal(t, "glpat-bot", token)
+	})
+
+	t.Run("environment variable", func(t *testing.T) {
+		t.Setenv("GLAB_ACCOUNT", "bot")
+
+		token, _ := c.Get("gitlab.com", "token")
+		assert.Equal(t, "glpat-bot", token)
+	})
+
+	t.Run("override", func(t *testing.T) {
+		t.Setenv("GLAB_ACCOUNT", "bot")
+		c.UseAccount("alice")
+		defer c.UseAccount("")
+
+		token, _ := c.Get("gitlab.com", "token")
+		assert.Equal(t, "glpat-alice", token)
+	})
+
+	t.Run("unknown account", func(t *testing.T) {
+		c.UseAccount("admin")
+		defer c.UseAccount("")
+
+		_, err := c.Get("gitlab.com", "token")
+		var accountErr *AccountNotFoundError
+		assert.ErrorAs(t, err, &accountErr)
+	})
+
+	t.Run("for account", func(t *testing.T) {
+		token, _ := c.ForAccount("bot").Get("gitlab.com", "token")
+		assert.Equal(t, "glpat-bot", token)
+		assert.Empty(t, c.AccountOverride())
+
+		token, _ = c.Get("gitlab.com", "token")
+		assert.Equal(t, "glpat-alice", token)
+	})
+
+	t.Run("keyring", func(t *testing.T) {
+		keyring.MockInit()
+		require.NoError(t, keyring.Set("glab:gitlab.com", "bot", "glpat-keyring"))
+		require.NoError(t, c.SwitchAccount("gitlab.com", "bot"))
+		require.NoError(t, c.Set("gitlab.com", "token", ""))
+
+		token, source, err := c.GetWithSource("gitlab.com", "token", false)
+		require.NoError(t, err)
+		assert.Equal(t, "glpat-keyring", token)
+		assert.Equal(t, "keyring", source)
+	})
+}
+
+func Test_fileConfig_RemoveAccount(t *testing.T) {
+	defer StubConfig(`---
+hosts:
+  gitlab.com:
+    account: alice
+    accounts:
+      alice:
+        token: glpat-alice
+      bot:
+        token: glpat-bot
+  gitlab.example.org:
+    token: glpat-example
+    api_protocol: https
+`, "")()
+
+	mainBuf := bytes.Buffer{}
+	aliasesBuf := bytes.Buffer{}
+	defer StubWriteConfig(&mainBuf, &aliasesBuf)()
+	keyring.MockInit()
+
+	c, err := ParseConfig("config.yml")
+	require.NoError(t, err)
+
+	require.NoError(t, c.RemoveAccount("gitlab.com", "alice"))
+	require.NoError(t, c.RemoveAccount("gitlab.example.org", ""))
+	assert.ErrorAs(t, c.RemoveAccount("gitlab.com", "admin"), new(*AccountNotFoundError))
+	require.NoError(t, c.Write())
+
+	expected := heredoc.Doc(`
+		hosts:
+		    gitlab.com:
+		        accounts:
+		            bot:
+		                token: glpat-bot
+		        account: bot
+		    gitlab.example.org:
+		        api_protocol: https
+	`)
+	assert.Equal(t, expected, mainBuf.String())
+}
@@ -21,15 +21,35 @@ const (
 	defaultAPIProtocol  = "https"
 )
 
+// DefaultSource is the source of the values that aren't set.
+const DefaultSource = "default"
+
 // A Config reads and writes persistent configuration for glab.
 type Config interface {
 	Get(string, string) (string, error)
 	GetWithSource(string, string, bool) (string, string, error)
 	Set(string, string, string) error
+	// Unset removes a key, from a host when the hostname isn't empty
+	Unset(string, string) error
 	UnsetHost(string)
 	Hosts() ([]string, error)
 	Aliases() (*AliasConfig, error)
 	Local() (*LocalConfig, error)
+	// Account returns the account used for a host, or an empty string for a host with no accounts
+	Account(string) string
+	// UseAccount selects the account used for all the hosts, without saving it
+	UseAccount(string)
+	AccountOverride() string
+	// ForAccount returns the config with an account selected for all the hosts
+	ForAccount(string) Config
+	Accounts(string) ([]string, error)
+	AddAccount(string, string) error
+	SwitchAccount(string, string) error
+	RemoveAccount(string, string) error
+	// CredentialStore returns the store of the tokens of a host, or nil for the config file
+	CredentialStore(string) (CredentialStore, error)
+	// MigrateCredentials moves the tokens of a host from the config file to a credential store
+	MigrateCredentials(string, string) (int, error)
 	// Write writes to the config.yml file
 	Write() error
 	// WriteAll saves all the available configuration file types
@@ -167,6 +187,10 @@ func NewBlankRoot() *yaml.Node {
 type fileConfig struct {
 	ConfigMap
 	documentRoot *yaml.Node
+	profiles     []*Profile
+
+	// accountOverride is the account selected for the current command, with the --account flag.
+	accountOverride string
 }
 
 func (c *fileConfig) Root() *yaml.Node {
@@ -190,6 +214,22 @@ func (c *fileConfig) GetWithSource(hostname, key string, searchENVVars bool) (va
 
 	var cfgError error
 
+	if hostname != "" && key == "token" {
+		store, err := c.CredentialStore(hostname)
+		if err != nil {
+			return "", "", err
+		}
+		if store != nil {
+			return c.getFromStore(store, hostname)
+		}
+	}
+
+	if hostname != "" && IsCredentialKey(key) {
+		if account := c.Account(hostname); account != "" {
+			return c.getFromAccount(hostname, account, key)
+		}
+	}
+
 	if hostname != "" {
 		hostCfg, err := c.configForHost(hostname)
 		if err != nil && !isNotFoundError(err) {
@@ -224,9 +264,13 @@ func (c *fileConfig) GetWithSource(hostname, key string, searchENVVars bool) (va
 	value, err = l.GetStringValue(key)
 
 	if (err != nil && isNotFoundError(err)) || value == "" {
+		if profileValue, profileSource := c.profileValue(hostname, key); profileValue != "" {
+			return profileValue, profileSource, cfgError
+		}
+
 		value, err = c.GetStringValue(key)
 		if err != nil && isNotFoundError(err) {
-			return defaultFor(key), source, cfgError
+			return defaultFor(key), DefaultSource, cfgError
 		} else if err != nil {
 			if hostname != "" {
 				err = cfgError
@@ -238,7 +282,7 @@ func (c *fileConfig) GetWithSource(hostname, key string, searchENVVars bool) (va
 	}
 
 	if value == "" {
-		return defaultFor(key), source, cfgError
+		return defaultFor(key), DefaultSource, cfgError
 	}
 
 	return value, source, cfgError
@@ -249,6 +293,26 @@ func (c *fileConfig) Set(hostname, key, value string) error {
 	if hostname == "" {
 		return c.SetStringValue(key, value)
 	} else {
+		if key == "token" {
+			store, err := c.CredentialStore(hostname)
+			if err != nil {
+				return err
+			}
+			if store != nil {
+				return c.setInStore(store, hostname, value)
+			}
+		}
+
+		if IsCredentialKey(key) {
+			if account := c.Account(hostname); account != "" {
+				accountCfg, err := c.accountConfig(hostname, account)
+				if err != nil {
+					return err
+				}
+				return accountCfg.SetStringValue(key, value)
+			}
+		}
+
 		hostCfg, err := c.configForHost(hostname)
 		if isNotFoundError(err) {
 			hostCfg = c.makeConfigForHost(hostname)
@@ -259,6 +323,44 @@ func (c *fileConfig) Set(hostname, key, value string) error {
 	}
 }
 
+func (c *fileConfig) Unset(hostname, key string) error {
+	key = ConfigKeyEquivalence(key)
+	if hostname == "" {
+		c.RemoveEntry(key)
+		return nil
+	}
+
+	if key == "token" {
+		store, err := c.CredentialStore(hostname)
+		if err != nil {
+			return err
+		}
+		// tokens of the keyring are deleted with the tokens of the file
+		if err := c.deleteToken(hostname, c.Account(hostname)); err != nil || store != nil {
+			return err
+		}
+	}
+	if IsCredentialKey(key) {
+		if account := c.Account(hostname); account != "" {
+			accountCfg, err := c.accountConfig(hostname, account)
+			if err != nil {
+				return err
+			}
+			accountCfg.RemoveEntry(key)
+			return nil
+		}
+	}
+
+	hostCfg, err := c.configForHost(hostname)
+	if isNotFoundError(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	hostCfg.RemoveEntry(key)
+	return nil
+}
+
 func (c *fileConfig) UnsetHost(hostname string) {
 	if hostname == "" {
 		return
@@ -166,7 +166,14 @@ func ParseConfig(filename string) (Config, error) {
 		return nil, err
 	}
 
-	return NewConfig(root), confError
+	profiles, err := loadProfiles()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := NewConfig(root).(*fileConfig)
+	cfg.profiles = profiles
+	return cfg, confError
 }
 
 func pathError(err error) error {
@@ -47,6 +47,12 @@ func EnvKeyEquivalence(key string) []string {
 		return []string{"GIT_REMOTE_URL_VAR", "GIT_REMOTE_ALIAS", "REMOTE_ALIAS", "REMOTE_NICKNAME", "GIT_REMOTE_NICKNAME"}
 	case "client_id":
 		return []string{"GITLAB_CLIENT_ID"}
+	case "account":
+		return []string{"GLAB_ACCOUNT"}
+	case "credential_store":
+		return []string{"GLAB_CREDENTIAL_STORE"}
+	case "token_command":
+		return []string{"GLAB_TOKEN_COMMAND"}
 	default:
 		return []string{strings.ToUpper(key)}
 	}
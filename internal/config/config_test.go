@@ -82,6 +82,42 @@ hosts:
 	assert.Equal(t, expected, mainBuf.String())
 }
 
+func Test_fileConfig_Unset(t *testing.T) {
+	defer StubConfig(`---
+editor: nano
+git_protocol: https
+hosts:
+  gitlab.com:
+    token: glpat-123
+    git_protocol: ssh
+`, "")()
+
+	mainBuf := bytes.Buffer{}
+	aliasesBuf := bytes.Buffer{}
+	defer StubWriteConfig(&mainBuf, &aliasesBuf)()
+
+	c, err := ParseConfig("config.yml")
+	require.NoError(t, err)
+
+	assert.NoError(t, c.Unset("", "editor"))
+	assert.NoError(t, c.Unset("gitlab.com", "git_protocol"))
+	assert.NoError(t, c.Unset("gitlab.com", "token"))
+	assert.NoError(t, c.Unset("example.com", "git_protocol"))
+	assert.NoError(t, c.Write())
+
+	expected := heredoc.Doc(`
+git_protocol: https
+hosts:
+    gitlab.com: {}
+`)
+	assert.Equal(t, expected, mainBuf.String())
+
+	value, source, err := c.GetWithSource("", "editor", false)
+	require.NoError(t, err)
+	assert.Equal(t, "", value)
+	assert.Equal(t, DefaultSource, source)
+}
+
 func Test_defaultConfig(t *testing.T) {
 	mainBuf := bytes.Buffer{}
 	hostsBuf := bytes.Buffer{}
@@ -0,0 +1,338 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+
+	"github.com/google/shlex"
+	"github.com/zalando/go-keyring"
+
+	"gitlab.com/gitlab-org/cli/internal/run"
+)
+
+// CredentialStores are the names of the stores of tokens, for the credential_store key.
+// The config store keeps the tokens in plain text in the configuration file.
+var CredentialStores = []string{"config", "keyring", "pass", "1password", "env", "command"}
+
+// A CredentialStore stores the tokens of the hosts outside of the configuration file.
+type CredentialStore interface {
+	// Name is the name of the store, shown as the source of the tokens.
+	Name() string
+	Get(hostname, account string) (string, error)
+	Set(hostname, account, token string) error
+	Delete(hostname, account string) error
+}
+
+// ReadOnlyStoreError is returned when saving a token to a store that can only read tokens.
+type ReadOnlyStoreError struct {
+	Store string
+}
+
+func (e *ReadOnlyStoreError) Error() string {
+	return fmt.Sprintf("the %s credential store is read-only. Save the token where the store reads it from.", e.Store)
+}
+
+// NewCredentialStore returns the store of tokens named name. The command store runs
+// tokenCommand, and prints the token.
+func NewCredentialStore(name, tokenCommand string) (CredentialStore, error) {
+	switch name {
+	case "keyring":
+		return keyringStore{}, nil
+	case "pass":
+		return passStore{}, nil
+	case "1password":
+		return onePasswordStore{}, nil
+	case "env":
+		return envStore{}, nil
+	case "command":
+		if tokenCommand == "" {
+			return nil, errors.New("the command credential store requires a token_command.")
+		}
+		return commandStore{command: tokenCommand}, nil
+	}
+	return nil, fmt.Errorf("invalid credential_store %q: use %s.", name, strings.Join(CredentialStores, ", "))
+}
+
+// CredentialStore returns the store of the tokens of a host, or nil when the tokens are
+// in the configuration file.
+func (c *fileConfig) CredentialStore(hostname string) (CredentialStore, error) {
+	name, _, _ := c.GetWithSource(hostname, "credential_store", true)
+	tokenCommand, _, _ := c.GetWithSource(hostname, "token_command", true)
+	if name == "" && tokenCommand != "" {
+		name = "command"
+	}
+	if name == "" || name == "config" {
+		return nil, nil
+	}
+	return NewCredentialStore(name, tokenCommand)
+}
+
+// credentialName returns the name of the token of an account of a host in the stores.
+func credentialName(hostname, account string) string {
+	if account == "" {
+		return "glab/" + hostname
+	}
+	return "glab/" + hostname + "/" + account
+}
+
+// runCredentialCommand runs a command of a store, with stdin as its input, and returns its output.
+func runCredentialCommand(stdin string, name string, args ...string) (string, error) {
+	cmd := exec.Command(name, args...)
+	if stdin != "" {
+		cmd.Stdin = strings.NewReader(stdin)
+	}
+	out, err := run.PrepareCmd(cmd).Output()
+	if err != nil {
+		return "", err
+	}
+	return string(bytes.TrimSpace(out)), nil
+}
+
+// keyringStore stores the tokens in the keyring of the operating system.
+type keyringStore struct{}
+
+func (keyringStore) Name() string { return "keyring" }
+
+func (keyringStore) Get(hostname, account string) (string, error) {
+	return keyring.Get("glab:"+hostname, account)
+}
+
+func (keyringStore) Set(hostname, account, token string) error {
+	return keyring.Set("glab:"+hostname, account, token)
+}
+
+func (keyringStore) Delete(hostname, account string) error {
+	return deleteKeyringToken(hostname, account)
+}
+
+// passStore stores the tokens in pass, the standard Unix password manager, as glab/<host>.
+type passStore struct{}
+
+func (passStore) Name() string { return "pass" }
+
+func (passStore) Get(hostname, account string) (string, error) {
+	out, err := runCredentialCommand("", "pass", "show", credentialName(hostname, account))
+	if err != nil {
+		return "", err
+	}
+	// the password is the first line, the other lines are metadata
+	token, _, _ := strings.Cut(out, "\n")
+	return token, nil
+}
+
+func (passStore) Set(hostname, account, token string) error {
+	_, err := runCredentialCommand(token+"\n", "pass", "insert", "--multiline", "--force", credentialName(hostname, account))
+	return err
+}
+
+func (passStore) Delete(hostname, account string) error {
+	_, err := runCredentialCommand("", "pass", "rm", "--force", credentialName(hostname, account))
+	return err
+}
+
+// onePasswordStore stores the tokens in 1Password with its CLI, op, as password items.
+type onePasswordStore struct{}
+
+func (onePasswordStore) Name() string { return "1password" }
+
+func (onePasswordStore) Get(hostname, account string) (string, error) {
+	return runCredentialCommand("", "op", "item", "get", credentialName(hostname, account), "--fields", "label=password", "--reveal")
+}
+
+// Set passes the token to op in an item template on stdin, so it isn't in the arguments
+// of the command, which other users can read.
+func (s onePasswordStore) Set(hostname, account, token string) error {
+	title := credentialName(hostname, account)
+	template, err := onePasswordTemplate(title, token)
+	if err != nil {
+		return err
+	}
+	if _, err := s.Get(hostname, account); err == nil {
+		_, err = runCredentialCommand(template, "op", "item", "edit", title, "--template", "-")
+		return err
+	}
+	_, err = runCredentialCommand(template, "op", "item", "create", "--template", "-")
+	return err
+}
+
+// onePasswordTemplate returns the JSON template of a password item of 1Password.
+func onePasswordTemplate(title, token string) (string, error) {
+	type field struct {
+		ID      string `json:"id"`
+		Type    string `json:"type"`
+		Purpose string `json:"purpose"`
+		Label   string `json:"label"`
+		Value   string `json:"value"`
+	}
+	template, err := json.Marshal(struct {
+		Title    string  `json:"title"`
+		Category string  `json:"category"`
+		Fields   []field `json:"fields"`
+	}{
+		Title:    title,
+		Category: "PASSWORD",
+		Fields:   []field{{ID: "password", Type: "CONCEALED", Purpose: "PASSWORD", Label: "password", Value: token}},
+	})
+	return string(template), err
+}
+
+func (onePasswordStore) Delete(hostname, account string) error {
+	_, err := runCredentialCommand("", "op", "item", "delete", credentialName(hostname, account))
+	return err
+}
+
+var envNameReplacer = regexp.MustCompile(`[^A-Z0-9]+`)
+
+// envStore reads the tokens from GLAB_TOKEN_<HOST>, or GLAB_TOKEN_<HOST>_<ACCOUNT>
+// for accounts, like GLAB_TOKEN_GITLAB_COM. It is read-only.
+type envStore struct{}
+
+func (envStore) Name() string { return "env" }
+
+// EnvName returns the environment variable of the token of an account of a host.
+func (envStore) EnvName(hostname, account string) string {
+	name := "GLAB_TOKEN_" + hostname
+	if account != "" {
+		name += "_" + account
+	}
+	return envNameReplacer.ReplaceAllString(strings.ToUpper(name), "_")
+}
+
+func (s envStore) Get(hostname, account string) (string, error) {
+	name := s.EnvName(hostname, account)
+	token := os.Getenv(name)
+	if token == "" {
+		return "", fmt.Errorf("%s is not set.", name)
+	}
+	return token, nil
+}
+
+func (envStore) Set(string, string, string) error { return &ReadOnlyStoreError{Store: "env"} }
+func (envStore) Delete(string, string) error      { return nil }
+
+// commandStore runs the token_command of a host, and reads the token from its output.
+// GLAB_HOST and GLAB_ACCOUNT are set for the command. It is read-only.
+type commandStore struct {
+	command string
+}
+
+func (commandStore) Name() string { return "token_command" }
+
+func (s commandStore) Get(hostname, account string) (string, error) {
+	args, err := shlex.Split(s.command)
+	if err != nil || len(args) == 0 {
+		return "", fmt.Errorf("invalid token_command %q.", s.command)
+	}
+
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Env = append(os.Environ(), "GLAB_HOST="+hostname, "GLAB_ACCOUNT="+account)
+	out, err := run.PrepareCmd(cmd).Output()
+	if err != nil {
+		return "", fmt.Errorf("token_command failed: %w", err)
+	}
+	return string(bytes.TrimSpace(out)), nil
+}
+
+func (commandStore) Set(string, string, string) error { return &ReadOnlyStoreError{Store: "command"} }
+func (commandStore) Delete(string, string) error      { return nil }
+
+// CredentialStoreError is returned when a credential store can't return a token.
+type CredentialStoreError struct {
+	Store string
+	Err   error
+}
+
+func (e *CredentialStoreError) Error() string {
+	return fmt.Sprintf("failed to get the token from %s: %s", e.Store, e.Err)
+}
+
+func (e *CredentialStoreError) Unwrap() error {
+	return e.Err
+}
+
+// getFromStore returns the token of the selected account of a host from its store.
+func (c *fileConfig) getFromStore(store CredentialStore, hostname string) (string, string, error) {
+	account := c.Account(hostname)
+	if account != "" {
+		if _, err := c.accountConfig(hostname, account); err != nil {
+			return "", "", err
+		}
+	}
+
+	token, err := store.Get(hostname, account)
+	if err != nil {
+		return "", store.Name(), &CredentialStoreError{Store: store.Name(), Err: err}
+	}
+	return token, store.Name(), nil
+}
+
+// setInStore saves the token of the selected account of a host to its store, so the
+// token is never written to the configuration file.
+func (c *fileConfig) setInStore(store CredentialStore, hostname, token string) error {
+	account := c.Account(hostname)
+	if account != "" {
+		if _, err := c.accountConfig(hostname, account); err != nil {
+			return err
+		}
+	}
+
+	if token == "" {
+		return store.Delete(hostname, account)
+	}
+	return store.Set(hostname, account, token)
+}
+
+// MigrateCredentials moves the tokens of a host and of its accounts from the configuration
+// file to a credential store, and makes it the store of the host. It returns the number of
+// tokens moved.
+func (c *fileConfig) MigrateCredentials(hostname, storeName string) (int, error) {
+	if storeName == "config" || storeName == "env" || storeName == "command" {
+		return 0, fmt.Errorf("can't migrate tokens to the %s credential store: use keyring, pass, or 1password.", storeName)
+	}
+	store, err := NewCredentialStore(storeName, "")
+	if err != nil {
+		return 0, err
+	}
+
+	hostCfg, err := c.configForHost(hostname)
+	if err != nil {
+		return 0, err
+	}
+
+	migrated := 0
+	move := func(cm *ConfigMap, account string) error {
+		token, _ := cm.GetStringValue("token")
+		if token == "" {
+			return nil
+		}
+		if err := store.Set(hostname, account, token); err != nil {
+			return fmt.Errorf("failed to save the token of %s to %s: %w", hostname, store.Name(), err)
+		}
+		cm.RemoveEntry("token")
+		migrated++
+		return nil
+	}
+
+	if err := move(&hostCfg.ConfigMap, ""); err != nil {
+		return migrated, err
+	}
+	accounts, err := c.accountsMap(hostname, false)
+	if err != nil {
+		return migrated, err
+	}
+	if accounts != nil {
+		for i := 0; i < len(accounts.Root.Content)-1; i += 2 {
+			if err := move(&ConfigMap{Root: accounts.Root.Content[i+1]}, accounts.Root.Content[i].Value); err != nil {
+				return migrated, err
+			}
+		}
+	}
+
+	return migrated, hostCfg.SetStringValue("credential_store", storeName)
+}
@@ -0,0 +1,182 @@
+package config
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func Test_credentialStores(t *testing.T) {
+	defer StubConfig(`---
+hosts:
+  gitlab.com:
+    credential_store: pass
+  gitlab.example.org:
+    credential_store: env
+  gitlab.command.org:
+    token_command: vault read -field=token secret/gitlab
+  gitlab.plain.org:
+    token: glpat-plain
+`, "")()
+
+	c, err := ParseConfig("config.yml")
+	require.NoError(t, err)
+
+	t.Run("pass", func(t *testing.T) {
+		cs, teardown := test.InitCmdStubber()
+		defer teardown()
+		cs.Stub("glpat-pass\nurl: https://gitlab.com\n")
+
+		token, source, err := c.GetWithSource("gitlab.com", "token", false)
+		require.NoError(t, err)
+		assert.Equal(t, "glpat-pass", token)
+		assert.Equal(t, "pass", source)
+		assert.Equal(t, []string{"pass", "show", "glab/gitlab.com"}, cs.Calls[0].Args)
+	})
+
+	t.Run("pass saves the token", func(t *testing.T) {
+		cs, teardown := test.InitCmdStubber()
+		defer teardown()
+		cs.Stub("")
+
+		require.NoError(t, c.Set("gitlab.com", "token", "glpat-new"))
+		assert.Equal(t, []string{"pass", "insert", "--multiline", "--force", "glab/gitlab.com"}, cs.Calls[0].Args)
+		stdin, _ := io.ReadAll(cs.Calls[0].Stdin)
+		assert.Equal(t, "glpat-new\n", string(stdin))
+
+		hostCfg, err := c.(*fileConfig).configForHost("gitlab.com")
+		require.NoError(t, err)
+		_, err = hostCfg.FindEntry("token")
+		assert.True(t, isNotFoundError(err), "the token must not be saved in the config file")
+	})
+
+	t.Run("1password saves the token on stdin", func(t *testing.T) {
+		t.Setenv("GLAB_CREDENTIAL_STORE", "1password")
+		cs, teardown := test.InitCmdStubber()
+		defer teardown()
+		cs.StubError("isn't an item")
+		cs.Stub("")
+
+		require.NoError(t, c.Set("gitlab.com", "token", "glpat-new"))
+		assert.Equal(t, []string{"op", "item", "get", "glab/gitlab.com", "--fields", "label=password", "--reveal"}, cs.Calls[0].Args)
+		assert.Equal(t, []string{"op", "item", "create", "--template", "-"}, cs.Calls[1].Args)
+		stdin, _ := io.ReadAll(cs.Calls[1].Stdin)
+		assert.JSONEq(t, `{"title": "glab/gitlab.com", "category": "PASSWORD", "fields": [
+			{"id": "password", "type": "CONCEALED", "purpose": "PASSWORD", "label": "password", "value": "glpat-new"}
+		]}`, string(stdin))
+	})
+
+	t.Run("env", func(t *testing.T) {
+		t.Setenv("GLAB_TOKEN_GITLAB_EXAMPLE_ORG", "glpat-env")
+
+		token, source, err := c.GetWithSource("gitlab.example.org", "token", false)
+		require.NoError(t, err)
+		assert.Equal(t, "glpat-env", token)
+		assert.Equal(t, "env", source)
+
+		err = c.Set("gitlab.example.org", "token", "glpat-new")
+		assert.ErrorAs(t, err, new(*ReadOnlyStoreError))
+	})
+
+	t.Run("env not set", func(t *testing.T) {
+		t.Setenv("GLAB_TOKEN_GITLAB_EXAMPLE_ORG", "")
+
+		_, _, err := c.GetWithSource("gitlab.example.org", "token", false)
+		assert.EqualError(t, err, "failed to get the token from env: GLAB_TOKEN_GITLAB_EXAMPLE_ORG is not set.")
+	})
+
+	t.Run("token_command", func(t *testing.T) {
+		cs, teardown := test.InitCmdStubber()
+		defer teardown()
+		cs.Stub("glpat-vault\n")
+
+		token, source, err := c.GetWithSource("gitlab.command.org", "token", false)
+		require.NoError(t, err)
+		assert.Equal(t, "glpat-vault", token)
+		assert.Equal(t, "token_command", source)
+		assert.Equal(t, []string{"vault", "read", "-field=token", "secret/gitlab"}, cs.Calls[0].Args)
+		assert.Contains(t, cs.Calls[0].Env, "GLAB_HOST=gitlab.command.org")
+	})
+
+	t.Run("config", func(t *testing.T) {
+		token, _, err := c.GetWithSource("gitlab.plain.org", "token", false)
+		require.NoError(t, err)
+		assert.Equal(t, "glpat-plain", token)
+	})
+
+	t.Run("invalid store", func(t *testing.T) {
+		t.Setenv("GLAB_CREDENTIAL_STORE", "vault")
+
+		_, _, err := c.GetWithSource("gitlab.plain.org", "token", false)
+		assert.EqualError(t, err, `invalid credential_store "vault": use config, keyring, pass, 1password, env, command.`)
+	})
+}
+
+func Test_fileConfig_MigrateCredentials(t *testing.T) {
+	defer StubConfig(`---
+hosts:
+  gitlab.com:
+    account: alice
+    accounts:
+      alice:
+        token: glpat-alice
+        user: alice
+      bot:
+        token: glpat-bot
+  gitlab.example.org:
+    token: glpat-example
+    git_protocol: ssh
+`, "")()
+
+	mainBuf := bytes.Buffer{}
+	defer StubWriteConfig(&mainBuf, &bytes.Buffer{})()
+	keyring.MockInit()
+
+	c, err := ParseConfig("config.yml")
+	require.NoError(t, err)
+
+	migrated, err := c.MigrateCredentials("gitlab.com", "keyring")
+	require.NoError(t, err)
+	assert.Equal(t, 2, migrated)
+
+	migrated, err = c.MigrateCredentials("gitlab.example.org", "keyring")
+	require.NoError(t, err)
+	assert.Equal(t, 1, migrated)
+
+	_, err = c.MigrateCredentials("gitlab.example.org", "env")
+	assert.EqualError(t, err, "can't migrate tokens to the env credential store: use keyring, pass, or 1password.")
+
+	require.NoError(t, c.Write())
+	expected := heredoc.Doc(`
+		hosts:
+		    gitlab.com:
+		        account: alice
+		        accounts:
+		            alice:
+		                user: alice
+		            bot: {}
+		        credential_store: keyring
+		    gitlab.example.org:
+		        git_protocol: ssh
+		        credential_store: keyring
+	`)
+	assert.Equal(t, expected, mainBuf.String())
+
+	for account, want := range map[string]string{"alice": "glpat-alice", "bot": "glpat-bot"} {
+		token, err := keyring.Get("glab:gitlab.com", account)
+		require.NoError(t, err)
+		assert.Equal(t, want, token)
+	}
+
+	token, source, err := c.GetWithSource("gitlab.example.org", "token", false)
+	require.NoError(t, err)
+	assert.Equal(t, "glpat-example", token)
+	assert.Equal(t, "keyring", source)
+}
@@ -0,0 +1,93 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ProfileFileName is the name of the configuration files shared by the repositories of a
+// directory, like the repositories of a monorepo or of a workspace.
+const ProfileFileName = ".glab.yml"
+
+// A Profile is a .glab.yml file. Its settings apply to the repositories of its directory,
+// and its hosts section applies to a single GitLab host.
+type Profile struct {
+	ConfigMap
+	Path string
+}
+
+// ProfileFiles returns the paths of the .glab.yml files of the working directory and of
+// its parent directories, the nearest first.
+var ProfileFiles = func() []string {
+	dir, err := os.Getwd()
+	if err != nil {
+		return nil
+	}
+
+	var files []string
+	for {
+		file := filepath.Join(dir, ProfileFileName)
+		if CheckFileExists(file) {
+			files = append(files, file)
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return files
+		}
+		dir = parent
+	}
+}
+
+func loadProfiles() ([]*Profile, error) {
+	var profiles []*Profile
+	for _, file := range ProfileFiles() {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, pathError(err)
+		}
+		root, err := parseConfigData(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", file, err)
+		}
+		profiles = append(profiles, &Profile{ConfigMap: ConfigMap{Root: root.Content[0]}, Path: file})
+	}
+	return profiles, nil
+}
+
+// Get returns the value of a key of the profile. With a hostname, the value in the hosts
+// section takes precedence.
+func (p *Profile) Get(hostname, key string) string {
+	if hostname != "" {
+		if hosts, err := p.FindEntry("hosts"); err == nil && hosts.ValueNode.Kind == yaml.MappingNode {
+			hostsCfg := ConfigMap{Root: hosts.ValueNode}
+			if host, err := hostsCfg.FindEntry(hostname); err == nil && host.ValueNode.Kind == yaml.MappingNode {
+				hostCfg := ConfigMap{Root: host.ValueNode}
+				if value, _ := hostCfg.GetStringValue(key); value != "" {
+					return value
+				}
+			}
+		}
+	}
+
+	value, _ := p.GetStringValue(key)
+	return value
+}
+
+// profileValue returns the value of a key from the nearest profile that sets it, and the
+// path of the profile. Only the profile keys are read from profiles, because the profiles
+// can come from any repository.
+func (c *fileConfig) profileValue(hostname, key string) (string, string) {
+	if info, ok := LookupKey(key); !ok || !info.Profile {
+		return "", ""
+	}
+	for _, p := range c.profiles {
+		if value := p.Get(hostname, key); value != "" {
+			return value, p.Path
+		}
+	}
+	return "", ""
+}
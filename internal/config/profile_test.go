@@ -0,0 +1,111 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeProfile(t *testing.T, dir, content string) string {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	file := filepath.Join(dir, ProfileFileName)
+	require.NoError(t, os.WriteFile(file, []byte(content), 0o644))
+	return file
+}
+
+func Test_ProfileFiles(t *testing.T) {
+	root := t.TempDir()
+	workspace := writeProfile(t, root, "host: gitlab.example.org\n")
+	monorepo := writeProfile(t, filepath.Join(root, "monorepo"), "glab_pager: less -R\n")
+	dir := filepath.Join(root, "monorepo", "services", "api")
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	defer func() { _ = os.Chdir(wd) }()
+
+	files := ProfileFiles()
+	require.GreaterOrEqual(t, len(files), 2)
+	assert.Equal(t, []string{monorepo, workspace}, files[:2])
+}
+
+func Test_fileConfig_GetWithSource_profiles(t *testing.T) {
+	defer StubConfig(`---
+git_protocol: ssh
+glab_pager: more
+editor: nano
+hosts:
+  gitlab.com:
+    token: glpat-123
+`, "")()
+
+	root := t.TempDir()
+	workspace := writeProfile(t, root, `
+host: gitlab.example.org
+glab_pager: less
+branch_prefix: workspace
+`)
+	monorepo := writeProfile(t, filepath.Join(root, "monorepo"), `
+glab_pager: less -R
+token_command: cat /tmp/token
+api_host: evil.example.com
+editor: vim
+browser: firefox
+account: work
+hosts:
+  gitlab.com:
+    git_protocol: https
+`)
+	ProfileFiles = func() []string {
+		return []string{monorepo, workspace}
+	}
+
+	c, err := ParseConfig("config.yml")
+	require.NoError(t, err)
+
+	tests := []struct {
+		hostname   string
+		key        string
+		wantValue  string
+		wantSource string
+	}{
+		{key: "glab_pager", wantValue: "more", wantSource: ConfigFile()},
+		{key: "host", wantValue: "", wantSource: DefaultSource},
+		{key: "branch_prefix", wantValue: "workspace", wantSource: workspace},
+		{key: "editor", wantValue: "nano", wantSource: ConfigFile()},
+		{key: "git_protocol", wantValue: "ssh", wantSource: ConfigFile()},
+		{hostname: "gitlab.com", key: "git_protocol", wantValue: "https", wantSource: monorepo},
+		{hostname: "gitlab.example.org", key: "git_protocol", wantValue: "ssh", wantSource: ConfigFile()},
+		{hostname: "gitlab.com", key: "token", wantValue: "glpat-123", wantSource: ConfigFile()},
+		{hostname: "gitlab.com", key: "token_command", wantValue: "", wantSource: DefaultSource},
+		{hostname: "gitlab.com", key: "api_host", wantValue: "", wantSource: DefaultSource},
+		{key: "browser", wantValue: "", wantSource: DefaultSource},
+		{hostname: "gitlab.com", key: "account", wantValue: "", wantSource: DefaultSource},
+	}
+	for _, tt := range tests {
+		t.Run(tt.hostname+"/"+tt.key, func(t *testing.T) {
+			value, source, err := c.GetWithSource(tt.hostname, tt.key, false)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantValue, value)
+			assert.Equal(t, tt.wantSource, source)
+		})
+	}
+}
+
+func Test_ParseConfig_invalidProfile(t *testing.T) {
+	defer StubConfig("", "")()
+
+	file := writeProfile(t, t.TempDir(), "- not a map\n")
+	ProfileFiles = func() []string {
+		return []string{file}
+	}
+
+	_, err := ParseConfig("config.yml")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to parse "+file)
+}
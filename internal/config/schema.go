@@ -0,0 +1,182 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+)
+
+// KeyInfo describes a configuration key that glab reads.
+type KeyInfo struct {
+	Name        string
+	Description string
+	// Values are the valid values of the key, also offered for completion. A key without
+	// values or Validate accepts any value.
+	Values []string
+	// Validate validates the values that aren't in Values. When it is nil, only Values are valid.
+	Validate func(value string) error
+	// Host is set for the keys that can be set per host.
+	Host bool
+	// Internal keys are set by glab, and aren't listed.
+	Internal bool
+	// Profile keys are also read from .glab.yml files. Only the keys that can't run commands
+	// or change where the credentials are sent are profile keys, because a .glab.yml file
+	// can come from any repository.
+	Profile bool
+}
+
+var booleanValues = []string{"true", "false"}
+
+// KnownKeys are the configuration keys that glab reads.
+var KnownKeys = []KeyInfo{
+	{Name: "token", Description: "Your GitLab access token.", Host: true},
+	{Name: "host", Description: "The default GitLab hostname."},
+	{Name: "api_host", Description: "The host of the API endpoint. Defaults to the host itself.", Host: true},
+	{Name: "api_protocol", Description: "The protocol of the API endpoint.", Values: []string{"https", "http"}, Host: true},
+	{Name: "git_protocol", Description: "The protocol of Git operations.", Values: []string{"ssh", "https", "http"}, Host: true, Profile: true},
+	{Name: "browser", Description: "The web browser to open links with."},
+	{Name: "editor", Description: "The editor to author text with."},
+	{Name: "visual", Description: "The editor to author text with. Takes precedence over editor."},
+	{Name: "glamour_style", Description: "The style of the Markdown renderer, or the path to a custom style.", Values: []string{"dark", "light", "notty"}, Validate: validateGlamourStyle},
+	{Name: "glab_pager", Description: "The pager command, such as 'less -R'."},
+	{Name: "check_update", Description: "Notify of new versions of glab.", Values: booleanValues},
+	{Name: "display_hyperlinks", Description: "Display hyperlinks in the lists of a TTY.", Values: booleanValues, Profile: true},
+	{Name: "no_prompt", Description: "Disable the prompts.", Values: booleanValues, Validate: validateNumericBoolean},
+	{Name: "remote_alias", Description: "The Git remote of the GitLab repository.", Profile: true},
+	{Name: "branch_prefix", Description: "The prefix of the branches of stacked diffs.", Profile: true},
+	{Name: "debug", Description: "Print debug output.", Values: booleanValues},
+	{Name: "group", Description: "The default group of new projects.", Profile: true},
+	{Name: "mr_labels", Description: "The comma-separated labels of new merge requests.", Profile: true},
+	{Name: "mr_reviewers", Description: "The comma-separated usernames of the reviewers of new merge requests.", Profile: true},
+	{Name: "account", Description: "The account used for the host, instead of the active account.", Host: true},
+	{Name: "credential_store", Description: "Where the tokens are stored.", Values: CredentialStores, Host: true},
+	{Name: "git_credential", Description: "The token the Git credential helper gives to Git: the token of the account, or a short-lived project access token.", Values: []string{"token", "project_token"}, Host: true},
+	{Name: "git_paths", Description: "The comma-separated groups and projects the Git credential helper uses the account for.", Host: true},
+	{Name: "token_command", Description: "A command that prints the token of the host.", Host: true},
+	{Name: "client_id", Description: "The ID of the OAuth application, for self-managed instances.", Host: true},
+	{Name: "ca_cert", Description: "The path to a CA certificate to verify the host with.", Host: true},
+	{Name: "client_cert", Description: "The path to a client certificate.", Host: true},
+	{Name: "client_key", Description: "The path to the key of the client certificate.", Host: true},
+	{Name: "proxy", Description: "The URL of the proxy of the host. Takes precedence over HTTPS_PROXY.", Validate: validateProxy, Host: true},
+	{Name: "no_proxy", Description: "The comma-separated hosts to connect to without the proxy. Takes precedence over NO_PROXY.", Host: true},
+	{Name: "skip_tls_verify", Description: "Skip the verification of the TLS certificate of the host.", Values: booleanValues, Host: true},
+	{Name: "job_token", Description: "A CI job token.", Host: true, Internal: true},
+	{Name: "user", Description: "The user of the token.", Host: true, Internal: true},
+	{Name: "is_oauth2", Host: true, Internal: true},
+	{Name: "oauth2_refresh_token", Host: true, Internal: true},
+	{Name: "oauth2_expiry_date", Host: true, Internal: true},
+	{Name: "oauth2_code_verifier", Host: true, Internal: true},
+}
+
+// LookupKey returns the description of a known key.
+func LookupKey(key string) (KeyInfo, bool) {
+	key = ConfigKeyEquivalence(key)
+	for _, k := range KnownKeys {
+		if k.Name == key {
+			return k, true
+		}
+	}
+	return KeyInfo{}, false
+}
+
+// ValidateKey returns an error if the key is unknown, or if the value isn't valid for it.
+func ValidateKey(key, value string) error {
+	info, ok := LookupKey(key)
+	if !ok {
+		var names []string
+		for _, k := range KnownKeys {
+			if !k.Internal {
+				names = append(names, k.Name)
+			}
+		}
+		sort.Strings(names)
+		return fmt.Errorf("unknown configuration key %q. Known keys: %s.", key, strings.Join(names, ", "))
+	}
+
+	if value == "" || (len(info.Values) == 0 && info.Validate == nil) {
+		return nil
+	}
+	for _, v := range info.Values {
+		if v == value {
+			return nil
+		}
+	}
+	if info.Validate != nil && info.Validate(value) == nil {
+		return nil
+	}
+
+	if info.Validate != nil {
+		return fmt.Errorf("invalid value %q for %s: %w", value, info.Name, info.Validate(value))
+	}
+	values := info.Values[0]
+	if n := len(info.Values); n == 2 {
+		values += " or " + info.Values[1]
+	} else if n > 2 {
+		values = strings.Join(info.Values[:n-1], ", ") + ", or " + info.Values[n-1]
+	}
+	return fmt.Errorf("invalid value %q for %s: use %s.", value, info.Name, values)
+}
+
+func validateGlamourStyle(value string) error {
+	if _, err := os.Stat(value); err != nil {
+		return errors.New("use dark, light, notty, or the path to a style file.")
+	}
+	return nil
+}
+
+func validateProxy(value string) error {
+	u, err := url.Parse(value)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https" && u.Scheme != "socks5") {
+		return errors.New("use an http, https, or socks5 URL, like http://proxy.example.com:3128.")
+	}
+	return nil
+}
+
+func validateNumericBoolean(value string) error {
+	if value != "1" && value != "0" {
+		return errors.New("use true, false, 1, or 0.")
+	}
+	return nil
+}
+
+// ValidateData parses the data of a configuration file, and returns the errors of its keys,
+// with their hosts. The error is returned when the data isn't a valid configuration file.
+func ValidateData(data []byte) ([]error, error) {
+	root, err := parseConfigData(data)
+	if err != nil {
+		return nil, err
+	}
+	c := NewConfig(root).(*fileConfig)
+
+	var errs []error
+	validate := func(cm *ConfigMap, host string) {
+		for i := 0; i < len(cm.Root.Content)-1; i += 2 {
+			key, value := cm.Root.Content[i].Value, cm.Root.Content[i+1]
+			if host == "" && (key == "hosts" || key == "aliases") {
+				continue
+			}
+			if host != "" && key == "accounts" {
+				continue
+			}
+			if err := ValidateKey(key, value.Value); err != nil {
+				if host != "" {
+					err = fmt.Errorf("host %s: %w", host, err)
+				}
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	validate(&c.ConfigMap, "")
+	hosts, err := c.hostEntries()
+	if err != nil && !isNotFoundError(err) {
+		return nil, err
+	}
+	for _, h := range hosts {
+		validate(&h.ConfigMap, h.Host)
+	}
+	return errs, nil
+}
@@ -0,0 +1,70 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateKey(t *testing.T) {
+	tests := []struct {
+		key     string
+		value   string
+		wantErr string
+	}{
+		{key: "git_protocol", value: "ssh"},
+		{key: "git_protocol", value: "ftp", wantErr: `invalid value "ftp" for git_protocol: use ssh, https, or http.`},
+		{key: "glamour_style", value: "light"},
+		{key: "glamour_style", value: "schema_test.go"},
+		{key: "glamour_style", value: "pink", wantErr: `invalid value "pink" for glamour_style: use dark, light, notty, or the path to a style file.`},
+		{key: "check_update", value: "false"},
+		{key: "check_update", value: "no", wantErr: `invalid value "no" for check_update: use true or false.`},
+		{key: "display_hyperlinks", value: "1", wantErr: `invalid value "1" for display_hyperlinks: use true or false.`},
+		{key: "no_prompt", value: "1"},
+		{key: "prompt_disabled", value: "yes", wantErr: `invalid value "yes" for no_prompt: use true, false, 1, or 0.`},
+		{key: "editor", value: "vim"},
+		{key: "GITLAB_TOKEN", value: "glpat-123"},
+		{key: "git_protocol", value: ""},
+		{key: "editr", value: "vim", wantErr: `unknown configuration key "editr". Known keys:`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key+"="+tt.value, func(t *testing.T) {
+			err := ValidateKey(tt.key, tt.value)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func Test_ValidateData(t *testing.T) {
+	errs, err := ValidateData([]byte(heredoc.Doc(`
+		git_protocol: ssh
+		check_update: maybe
+		colour: blue
+		hosts:
+		  gitlab.com:
+		    token: glpat-123
+		    api_protocol: ftp
+		    accounts:
+		      work:
+		        token: glpat-456
+	`)))
+	require.NoError(t, err)
+	require.Len(t, errs, 3)
+	assert.EqualError(t, errs[0], `invalid value "maybe" for check_update: use true or false.`)
+	assert.Contains(t, errs[1].Error(), `unknown configuration key "colour".`)
+	assert.EqualError(t, errs[2], `host gitlab.com: invalid value "ftp" for api_protocol: use https or http.`)
+
+	errs, err = ValidateData([]byte("editor: vim\n"))
+	require.NoError(t, err)
+	assert.Empty(t, errs)
+
+	_, err = ValidateData([]byte("- editor\n"))
+	assert.Error(t, err)
+}
@@ -29,6 +29,10 @@ func StubWriteConfig(wc io.Writer, wh io.Writer) func() {
 func StubConfig(main, aliases string) func() {
 	orig := ReadConfigFile
 	origLoc := LocalConfigFile
+	origProfiles := ProfileFiles
+	ProfileFiles = func() []string {
+		return nil
+	}
 	LocalConfigFile = func() string {
 		return path.Join(LocalConfigDir()...)
 	}
@@ -53,5 +57,6 @@ func StubConfig(main, aliases string) func() {
 	return func() {
 		ReadConfigFile = orig
 		LocalConfigFile = origLoc
+		ProfileFiles = origProfiles
 	}
 }
@@ -35,10 +35,10 @@ type Stack struct {
 
 func (s Stack) Empty() bool { return len(s.Refs) == 0 }
 
-func (s *Stack) RemoveRef(ref StackRef) error {
+func (s *Stack) RemoveRef(ref StackRef, gr GitRunner) error {
 	if ref.IsFirst() && ref.IsLast() {
 		// this is the only ref, so just remove it
-		err := DeleteStackRefFile(s.Title, ref)
+		err := s.deleteStackRefFile(ref, gr)
 		delete(s.Refs, ref.SHA)
 		if err != nil {
 			return fmt.Errorf("could not delete reference file %v:", err)
@@ -47,17 +47,17 @@ func (s *Stack) RemoveRef(ref StackRef) error {
 		return nil
 	}
 
-	err := s.adjustAdjacentRefs(ref)
+	err := s.adjustAdjacentRefs(ref, gr)
 	if err != nil {
 		return fmt.Errorf("error adjusting next reference %v:", err)
 	}
 
-	err = DeleteStackRefFile(s.Title, ref)
+	err = s.deleteStackRefFile(ref, gr)
 	if err != nil {
 		return fmt.Errorf("could not delete reference file %v:", err)
 	}
 
-	err = s.RemoveBranch(ref)
+	err = s.RemoveBranch(ref, gr)
 	if err != nil {
 		return fmt.Errorf("could not remove branch %v:", err)
 	}
@@ -67,12 +67,12 @@ func (s *Stack) RemoveRef(ref StackRef) error {
 	return nil
 }
 
-func (s *Stack) RemoveBranch(ref StackRef) error {
+func (s *Stack) RemoveBranch(ref StackRef, gr GitRunner) error {
 	var branch string
 	var err error
 
 	if ref.IsFirst() {
-		branch, err = GetDefaultBranch(DefaultRemote)
+		branch, err = DefaultBranchWithRunner(DefaultRemote, gr)
 		if err != nil {
 			return err
 		}
@@ -81,12 +81,12 @@ func (s *Stack) RemoveBranch(ref StackRef) error {
 		branch = s.Refs[ref.Prev].Branch
 	}
 
-	err = CheckoutBranch(branch)
+	_, err = gr.Git("checkout", branch)
 	if err != nil {
 		return err
 	}
 
-	err = DeleteLocalBranch(ref.Branch)
+	_, err = gr.Git("branch", "-D", ref.Branch)
 	if err != nil {
 		return err
 	}
@@ -94,7 +94,7 @@ func (s *Stack) RemoveBranch(ref StackRef) error {
 	return nil
 }
 
-func (s *Stack) adjustAdjacentRefs(ref StackRef) error {
+func (s *Stack) adjustAdjacentRefs(ref StackRef, gr GitRunner) error {
 	refs := s.Refs
 
 	if ref.Prev != "" {
@@ -104,7 +104,7 @@ func (s *Stack) adjustAdjacentRefs(ref StackRef) error {
 		prev.Next = ref.Next
 		refs[ref.Prev] = prev
 
-		err := UpdateStackRefFile(s.Title, prev)
+		err := s.updateStackRefFile(prev, gr)
 		if err != nil {
 			return fmt.Errorf("could not update reference file %v:", err)
 		}
@@ -117,7 +117,7 @@ func (s *Stack) adjustAdjacentRefs(ref StackRef) error {
 		next.Prev = ref.Prev
 		refs[ref.Next] = next
 
-		err := UpdateStackRefFile(s.Title, next)
+		err := s.updateStackRefFile(next, gr)
 		if err != nil {
 			return fmt.Errorf("could not update reference file %v:", err)
 		}
@@ -126,6 +126,18 @@ func (s *Stack) adjustAdjacentRefs(ref StackRef) error {
 	return nil
 }
 
+func (s *Stack) updateStackRefFile(ref StackRef, gr GitRunner) error {
+	return RunOperation(gr, StackRefFileOperation("update", s.Title, ref), func() error {
+		return UpdateStackRefFile(s.Title, ref)
+	})
+}
+
+func (s *Stack) deleteStackRefFile(ref StackRef, gr GitRunner) error {
+	return RunOperation(gr, StackRefFileOperation("delete", s.Title, ref), func() error {
+		return DeleteStackRefFile(s.Title, ref)
+	})
+}
+
 func (s *Stack) IndexAt(ref StackRef) int {
 	for i, r := range s.Iter2() {
 		if r == ref {
@@ -291,13 +303,13 @@ func validateStackRefs(s Stack) error {
 	return nil
 }
 
-func CurrentStackRefFromCurrentBranch(title string) (StackRef, error) {
+func CurrentStackRefFromCurrentBranch(title string, gr GitRunner) (StackRef, error) {
 	stack, err := GatherStackRefs(title)
 	if err != nil {
 		return StackRef{}, err
 	}
 
-	branch, err := CurrentBranch()
+	branch, err := CurrentBranchWithRunner(gr)
 	if err != nil {
 		return StackRef{}, err
 	}
@@ -8,7 +8,6 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gitlab.com/gitlab-org/cli/internal/config"
-	"gitlab.com/gitlab-org/cli/internal/run"
 )
 
 func Test_StackRemoveRef(t *testing.T) {
@@ -91,27 +90,22 @@ func Test_StackRemoveRef(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			dir := InitGitRepoWithCommit(t)
+			dir := InitFakeGitRepo(t)
 
 			err := CreateRefFiles(tt.args.stack.Refs, tt.args.stack.Title)
 			require.Nil(t, err)
 
-			var branches []string
-
-			branches = append(branches, tt.args.remove.Branch)
-			if tt.args.remove.Prev != "" {
-				branches = append(branches, tt.args.stack.Refs[tt.args.remove.Prev].Branch)
+			var wantCommands []string
+			if !tt.args.remove.IsFirst() || !tt.args.remove.IsLast() {
+				wantCommands = []string{"checkout " + tt.args.stack.Refs[tt.args.remove.Prev].Branch, "branch -D " + tt.args.remove.Branch}
 			}
 
-			CreateBranches(t, branches)
-
-			err = CheckoutBranch("main")
-			require.NoError(t, err)
-
-			err = tt.args.stack.RemoveRef(tt.args.remove)
+			fake := &FakeGitRunner{}
+			err = tt.args.stack.RemoveRef(tt.args.remove, fake)
 			require.Nil(t, err)
 
 			require.Equal(t, tt.expected, tt.args.stack.Refs)
+			require.Equal(t, wantCommands, fake.Commands)
 
 			wantpath := path.Join(dir, StackLocation, tt.args.remove.Branch, ".json")
 			require.False(t, config.CheckFileExists(wantpath))
@@ -197,10 +191,10 @@ func Test_StackEmpty(t *testing.T) {
 
 func Test_StackRemoveBranch(t *testing.T) {
 	tests := []struct {
-		name    string
-		stack   Stack
-		ref     StackRef
-		wantErr bool
+		name         string
+		stack        Stack
+		ref          StackRef
+		wantCommands []string
 	}{
 		{
 			name: "remove single ref",
@@ -208,7 +202,8 @@ func Test_StackRemoveBranch(t *testing.T) {
 				Title: "test-stack",
 				Refs:  map[string]StackRef{"sha1": {SHA: "sha1", Branch: "branch123"}},
 			},
-			ref: StackRef{SHA: "sha1", Branch: "branch123"},
+			ref:          StackRef{SHA: "sha1", Branch: "branch123"},
+			wantCommands: []string{"remote show origin", "checkout main", "branch -D branch123"},
 		},
 		{
 			name: "remove first ref",
@@ -219,7 +214,8 @@ func Test_StackRemoveBranch(t *testing.T) {
 					"sha2": {SHA: "sha2", Prev: "sha1", Branch: "branch456"},
 				},
 			},
-			ref: StackRef{SHA: "sha1", Next: "sha2", Branch: "branch123"},
+			ref:          StackRef{SHA: "sha1", Next: "sha2", Branch: "branch123"},
+			wantCommands: []string{"remote show origin", "checkout main", "branch -D branch123"},
 		},
 		{
 			name: "remove middle ref",
@@ -231,7 +227,8 @@ func Test_StackRemoveBranch(t *testing.T) {
 					"sha3": {SHA: "sha3", Prev: "sha2", Branch: "branch789"},
 				},
 			},
-			ref: StackRef{SHA: "sha2", Prev: "sha1", Next: "sha3", Branch: "branch456"},
+			ref:          StackRef{SHA: "sha2", Prev: "sha1", Next: "sha3", Branch: "branch456"},
+			wantCommands: []string{"checkout branch123", "branch -D branch456"},
 		},
 		{
 			name: "remove last ref",
@@ -242,32 +239,20 @@ func Test_StackRemoveBranch(t *testing.T) {
 					"sha2": {SHA: "sha2", Prev: "sha1", Branch: "branch456"},
 				},
 			},
-			ref: StackRef{SHA: "sha2", Prev: "sha1", Branch: "branch456"},
+			ref:          StackRef{SHA: "sha2", Prev: "sha1", Branch: "branch456"},
+			wantCommands: []string{"checkout branch123", "branch -D branch456"},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			InitGitRepoWithCommit(t)
-
-			gitAddRemote := GitCommand("remote", "add", "origin", "http://gitlab.com/gitlab-org/cli.git")
-			_, err := run.PrepareCmd(gitAddRemote).Output()
-			require.Nil(t, err)
-
-			CreateBranches(t, tt.stack.Branches())
+			fake := &FakeGitRunner{Outputs: map[string]string{
+				"remote show origin": "  HEAD branch: main\n",
+			}}
 
-			err = tt.stack.RemoveBranch(tt.ref)
-
-			require.Nil(t, err)
-			if tt.wantErr {
-				require.Error(t, err)
-			} else {
-				require.Nil(t, err)
-
-				showref := GitCommand("show-ref", "--verify", "--quiet", "refs/heads/"+tt.ref.Branch)
-				_, err := run.PrepareCmd(showref).Output()
-				require.Error(t, err)
-			}
+			err := tt.stack.RemoveBranch(tt.ref, fake)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantCommands, fake.Commands)
 		})
 	}
 }
@@ -376,7 +361,7 @@ func Test_GatherStackRefs(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			InitGitRepo(t)
+			InitFakeGitRepo(t)
 
 			for _, stack := range tt.stacks {
 				err := AddStackRefFile(tt.args.title, stack)
@@ -487,7 +472,7 @@ func Test_adjustAdjacentRefs(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			InitGitRepo(t)
+			InitFakeGitRepo(t)
 
 			for _, stack := range tt.stacks {
 				err := AddStackRefFile(tt.args.title, stack)
@@ -497,7 +482,7 @@ func Test_adjustAdjacentRefs(t *testing.T) {
 			originalStack, err := GatherStackRefs(tt.args.title)
 			require.Nil(t, err)
 
-			err = originalStack.adjustAdjacentRefs(tt.args.adjust)
+			err = originalStack.adjustAdjacentRefs(tt.args.adjust, &FakeGitRunner{})
 			require.Nil(t, err)
 
 			if tt.wantErr {
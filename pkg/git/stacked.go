@@ -3,8 +3,12 @@ package git
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"strings"
+
+	"github.com/kballard/go-shellquote"
 
 	"gitlab.com/gitlab-org/cli/internal/run"
 )
@@ -19,6 +23,10 @@ type StandardGitCommand struct{}
 
 func (gitc StandardGitCommand) Git(args ...string) (string, error) {
 	cmd := GitCommand(args...)
+
+	// Ensure output from git is in English, as some of it is parsed
+	cmd.Env = append(os.Environ(), "LC_ALL=C")
+
 	output, err := run.PrepareCmd(cmd).Output()
 	if err != nil {
 		return "", err
@@ -27,6 +35,145 @@ func (gitc StandardGitCommand) Git(args ...string) (string, error) {
 	return string(output), nil
 }
 
+// RecordingGitRunner runs Git commands with Runner, and records them,
+// together with the other operations passed to RunOperation.
+// In dry-run mode, only the Git commands that read from the repository
+// are run. The other commands and operations are printed to Out instead.
+type RecordingGitRunner struct {
+	Runner GitRunner
+	Out    io.Writer
+	DryRun bool
+
+	Operations []string
+}
+
+func NewRecordingGitRunner(out io.Writer, dryRun bool) *RecordingGitRunner {
+	return &RecordingGitRunner{
+		Runner: StandardGitCommand{},
+		Out:    out,
+		DryRun: dryRun,
+	}
+}
+
+func (r *RecordingGitRunner) Git(args ...string) (string, error) {
+	command := "git " + shellquote.Join(args...)
+	r.Operations = append(r.Operations, command)
+
+	if r.DryRun && !isReadOnlyGitCommand(args) {
+		fmt.Fprintln(r.Out, command)
+		return "", nil
+	}
+
+	return r.Runner.Git(args...)
+}
+
+func (r *RecordingGitRunner) run(operation string, fn func() error) error {
+	r.Operations = append(r.Operations, operation)
+
+	if r.DryRun {
+		fmt.Fprintln(r.Out, operation)
+		return nil
+	}
+
+	return fn()
+}
+
+// RunOperation runs fn, an operation of a stack command that is not a Git command,
+// like writing a stack ref file or calling the GitLab API. The operation is
+// described by operation. If gr is a RecordingGitRunner, the operation is recorded,
+// and fn is not run in dry-run mode.
+func RunOperation(gr GitRunner, operation string, fn func() error) error {
+	if r, ok := gr.(*RecordingGitRunner); ok {
+		return r.run(operation, fn)
+	}
+
+	return fn()
+}
+
+// IsDryRun returns true if gr is a RecordingGitRunner in dry-run mode.
+func IsDryRun(gr GitRunner) bool {
+	r, ok := gr.(*RecordingGitRunner)
+	return ok && r.DryRun
+}
+
+// isReadOnlyGitCommand returns true if the Git command only reads from the repository.
+func isReadOnlyGitCommand(args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+
+	switch args[0] {
+	case "status", "log", "diff", "rev-parse", "rev-list", "merge-base", "for-each-ref", "show-ref":
+		return true
+	case "symbolic-ref":
+		// reading a ref, like `git symbolic-ref --short HEAD`. With a second
+		// argument or --delete, it writes the ref.
+		var refs int
+		for _, arg := range args[1:] {
+			switch {
+			case arg == "-d" || arg == "--delete" || arg == "-m":
+				return false
+			case !strings.HasPrefix(arg, "-"):
+				refs++
+			}
+		}
+		return refs == 1
+	case "remote":
+		return len(args) > 1 && args[1] == "show"
+	case "config":
+		// reading a single key, like `git config glab.currentstack`
+		return len(args) == 2 && !strings.HasPrefix(args[1], "-")
+	}
+
+	return false
+}
+
+// StackRefFileOperation describes an operation on the file of a stack ref,
+// to be passed to RunOperation.
+func StackRefFileOperation(action, title string, ref StackRef) string {
+	return fmt.Sprintf("%s stack ref file %s", action, filepath.Join(StackLocation, title, ref.SHA+".json"))
+}
+
+// GetCurrentStackTitle returns the title of the stack currently in use.
+func GetCurrentStackTitle(gr GitRunner) (string, error) {
+	title, err := gr.Git("config", "glab.currentstack")
+	if err != nil {
+		return "", fmt.Errorf("unknown config key: %s", "glab.currentstack")
+	}
+
+	return strings.TrimSpace(title), nil
+}
+
+// SetCurrentStackTitle sets the title of the stack currently in use.
+func SetCurrentStackTitle(title string, gr GitRunner) error {
+	_, err := gr.Git("config", "--local", "glab.currentstack", title)
+	if err != nil {
+		return fmt.Errorf("setting local Git config: %w", err)
+	}
+
+	return nil
+}
+
+// CurrentBranchWithRunner reads the checked-out branch like CurrentBranch, using gr.
+func CurrentBranchWithRunner(gr GitRunner) (string, error) {
+	branch, err := gr.Git("symbolic-ref", "--quiet", "--short", "HEAD")
+	if err != nil {
+		return "", ErrNotOnAnyBranch
+	}
+
+	return strings.TrimSpace(branch), nil
+}
+
+// DefaultBranchWithRunner finds and returns the remote's default branch like GetDefaultBranch, using gr.
+func DefaultBranchWithRunner(remote string, gr GitRunner) (string, error) {
+	output, err := gr.Git("remote", "show", remote)
+	if err != nil {
+		return "", err
+	}
+
+	return ParseDefaultBranch([]byte(output))
+}
+
 func SetLocalConfig(key, value string) error {
 	found, err := configValueExists(key, value)
 	if err != nil {
@@ -45,11 +192,6 @@ func SetLocalConfig(key, value string) error {
 	return nil
 }
 
-func GetCurrentStackTitle() (title string, err error) {
-	title, err = Config("glab.currentstack")
-	return
-}
-
 func AddStackRefDir(dir string) (string, error) {
 	baseDir, err := ToplevelDir()
 	if err != nil {
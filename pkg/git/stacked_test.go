@@ -235,3 +235,74 @@ func Test_GetStacks(t *testing.T) {
 		require.Equal(t, want, got)
 	})
 }
+
+func TestRecordingGitRunner(t *testing.T) {
+	tests := []struct {
+		name       string
+		dryRun     bool
+		wantOutput string
+		wantRun    []string
+	}{
+		{
+			name:    "runs all commands and operations",
+			wantRun: []string{"config glab.currentstack", "checkout -b new branch", "operation"},
+		},
+		{
+			name:       "dry run only runs read-only commands",
+			dryRun:     true,
+			wantOutput: "git checkout -b 'new branch'\nwrite a file\n",
+			wantRun:    []string{"config glab.currentstack"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &FakeGitRunner{Outputs: map[string]string{"config glab.currentstack": "my-stack\n"}}
+			out := &strings.Builder{}
+			gr := &RecordingGitRunner{Runner: fake, Out: out, DryRun: tt.dryRun}
+
+			title, err := GetCurrentStackTitle(gr)
+			require.NoError(t, err)
+			require.Equal(t, "my-stack", title)
+
+			_, err = gr.Git("checkout", "-b", "new branch")
+			require.NoError(t, err)
+
+			err = RunOperation(gr, "write a file", func() error {
+				fake.Commands = append(fake.Commands, "operation")
+				return nil
+			})
+			require.NoError(t, err)
+
+			require.Equal(t, tt.dryRun, IsDryRun(gr))
+			require.Equal(t, tt.wantOutput, out.String())
+			require.Equal(t, tt.wantRun, fake.Commands)
+			require.Equal(t, []string{"git config glab.currentstack", "git checkout -b 'new branch'", "write a file"}, gr.Operations)
+		})
+	}
+}
+
+func Test_isReadOnlyGitCommand(t *testing.T) {
+	tests := []struct {
+		args []string
+		want bool
+	}{
+		{args: []string{"status", "-uno"}, want: true},
+		{args: []string{"remote", "show", "origin"}, want: true},
+		{args: []string{"remote", "add", "origin", "url"}, want: false},
+		{args: []string{"config", "glab.currentstack"}, want: true},
+		{args: []string{"config", "--local", "glab.currentstack", "stack"}, want: false},
+		{args: []string{"symbolic-ref", "--quiet", "--short", "HEAD"}, want: true},
+		{args: []string{"symbolic-ref", "HEAD", "refs/heads/main"}, want: false},
+		{args: []string{"symbolic-ref", "-m", "switch", "HEAD", "refs/heads/main"}, want: false},
+		{args: []string{"symbolic-ref", "--delete", "HEAD"}, want: false},
+		{args: []string{"checkout", "main"}, want: false},
+		{args: nil, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.args, " "), func(t *testing.T) {
+			require.Equal(t, tt.want, isReadOnlyGitCommand(tt.args))
+		})
+	}
+}
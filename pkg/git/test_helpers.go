@@ -6,6 +6,7 @@ import (
 	"os"
 	"os/exec"
 	"strconv"
+	"strings"
 	"testing"
 
 	"gitlab.com/gitlab-org/cli/internal/run"
@@ -196,3 +197,101 @@ func MrMockStackData(branch, iid string) string {
 				"state": "opened"
 			}`
 }
+
+func MockListOpenStackMRsByBranchAndTarget(branch, target, iid string) HttpMock {
+	return HttpMock{
+		method: http.MethodGet,
+		path:   "/api/v4/projects/stack_guy%2Fstackproject/merge_requests?per_page=30&source_branch=" + branch + "&state=opened",
+		status: http.StatusOK,
+		body: `[{
+				"id": ` + iid + `,
+				"iid": ` + iid + `,
+				"project_id": 3,
+				"title": "test mr title` + iid + `",
+				"target_branch": "` + target + `",
+				"source_branch": "` + branch + `",
+				"web_url": "https://gitlab.com/stack_guy/stackproject/-/merge_requests/` + iid + `",
+				"state": "opened"
+			}]`,
+	}
+}
+
+func MockListNoOpenStackMRsByBranch(branch string) HttpMock {
+	return HttpMock{
+		method: http.MethodGet,
+		path:   "/api/v4/projects/stack_guy%2Fstackproject/merge_requests?per_page=30&source_branch=" + branch + "&state=opened",
+		status: http.StatusOK,
+		body:   "[]",
+	}
+}
+
+func MockGetStackMRWithState(branch, target, iid, state, pipelineStatus string) HttpMock {
+	return HttpMock{
+		method: http.MethodGet,
+		path:   "/api/v4/projects/stack_guy%2Fstackproject/merge_requests/" + iid,
+		status: http.StatusOK,
+		body:   mrMockStackDataWithState(branch, target, iid, state, pipelineStatus),
+	}
+}
+
+func MockMergeStackMR(branch, iid, state string) HttpMock {
+	return HttpMock{
+		method: http.MethodPut,
+		path:   "/api/v4/projects/stack_guy%2Fstackproject/merge_requests/" + iid + "/merge",
+		status: http.StatusOK,
+		body:   mrMockStackDataWithState(branch, "main", iid, state, ""),
+	}
+}
+
+func mrMockStackDataWithState(branch, target, iid, state, pipelineStatus string) string {
+	pipeline := "null"
+	if pipelineStatus != "" {
+		pipeline = `{ "id": 1, "status": "` + pipelineStatus + `" }`
+	}
+
+	return `{
+				"id": ` + iid + `,
+				"iid": ` + iid + `,
+				"project_id": 3,
+				"title": "test mr title",
+				"target_branch": "` + target + `",
+				"source_branch": "` + branch + `",
+				"state": "` + state + `",
+				"pipeline": ` + pipeline + `
+			}`
+}
+
+// FakeGitRunner is a GitRunner that does not run Git. It records the commands
+// it receives in Commands, and returns the output or error set for them.
+// Commands are the Git arguments joined with spaces, like "status -uno".
+type FakeGitRunner struct {
+	Outputs map[string]string
+	Errors  map[string]error
+
+	Commands []string
+}
+
+func (r *FakeGitRunner) Git(args ...string) (string, error) {
+	command := strings.Join(args, " ")
+	r.Commands = append(r.Commands, command)
+
+	if err, ok := r.Errors[command]; ok {
+		return "", err
+	}
+
+	return r.Outputs[command], nil
+}
+
+// InitFakeGitRepo points ToplevelDir to a temporary directory, so that stack
+// ref files can be used in tests that run Git with a FakeGitRunner.
+func InitFakeGitRepo(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	toplevelDir := ToplevelDir
+	ToplevelDir = func() (string, error) { return dir, nil }
+	t.Cleanup(func() { ToplevelDir = toplevelDir })
+
+	return dir
+}
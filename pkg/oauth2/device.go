@@ -0,0 +1,179 @@
+package oauth2
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+const deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+// defaultPollInterval is the polling interval when the server doesn't return one.
+const defaultPollInterval = 5 * time.Second
+
+// sleep waits between two polls of the token endpoint. Tests replace it to avoid waiting.
+var sleep = time.Sleep
+
+// DeviceCode is the response of the device authorization endpoint, described in RFC 8628.
+type DeviceCode struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+// tokenError is the error response of the token endpoint.
+type tokenError struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// StartDeviceFlow authenticates with the device authorization grant. It shows a code for the
+// user to enter in a browser, on any device, and polls the token endpoint until the user
+// authorizes glab. Unlike StartFlow, it needs no browser or local listener.
+func StartDeviceFlow(cfg config.Config, io *iostreams.IOStreams, hostname, protocol string) (string, error) {
+	clientID, err := oAuthClientID(cfg, hostname)
+	if err != nil {
+		return "", err
+	}
+
+	code, err := requestDeviceCode(hostname, protocol, clientID)
+	if err != nil {
+		return "", err
+	}
+
+	c := io.Color()
+	fmt.Fprintf(io.StdErr, "%s First, copy your one-time code: %s\n", c.Yellow("!"), c.Bold(code.UserCode))
+	fmt.Fprintf(io.StdErr, "Then open %s in a browser, on any device, and enter the code.\n", c.Bold(code.VerificationURI))
+	fmt.Fprintln(io.StdErr, "- Waiting for authorization...")
+
+	token, err := pollDeviceToken(hostname, protocol, clientID, code)
+	if err != nil {
+		return "", err
+	}
+
+	err = token.SetConfig(hostname, cfg)
+	if err != nil {
+		return "", err
+	}
+
+	return token.AccessToken, nil
+}
+
+func requestDeviceCode(hostname, protocol, clientID string) (*DeviceCode, error) {
+	deviceURL := fmt.Sprintf("%s://%s/oauth/authorize_device", protocol, hostname)
+
+	form := url.Values{
+		"client_id": []string{clientID},
+		"scope":     []string{strings.ReplaceAll(scopes, "+", " ")},
+	}
+
+	resp, err := http.PostForm(deviceURL, form)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to request a device code: %s", tokenErrorMessage(resp.Status, respBytes))
+	}
+
+	code := &DeviceCode{}
+	err = json.Unmarshal(respBytes, code)
+	if err != nil {
+		return nil, err
+	}
+	if code.DeviceCode == "" || code.UserCode == "" {
+		return nil, errors.New("failed to request a device code: the response has no code.")
+	}
+	return code, nil
+}
+
+// pollDeviceToken polls the token endpoint until the user authorizes or denies the device,
+// or the code expires. It waits 5 more seconds between polls after each slow_down error.
+func pollDeviceToken(hostname, protocol, clientID string, code *DeviceCode) (*AuthToken, error) {
+	tokenURL := fmt.Sprintf("%s://%s/oauth/token", protocol, hostname)
+
+	form := url.Values{
+		"client_id":   []string{clientID},
+		"device_code": []string{code.DeviceCode},
+		"grant_type":  []string{deviceCodeGrantType},
+	}
+
+	interval := defaultPollInterval
+	if code.Interval > 0 {
+		interval = time.Duration(code.Interval) * time.Second
+	}
+
+	var deadline time.Time
+	if code.ExpiresIn > 0 {
+		deadline = time.Now().Add(time.Duration(code.ExpiresIn) * time.Second)
+	}
+
+	for {
+		sleep(interval)
+		if !deadline.IsZero() && time.Now().After(deadline) {
+			return nil, errors.New("the device code expired. Run `glab auth login --device` again.")
+		}
+
+		resp, err := http.PostForm(tokenURL, form)
+		if err != nil {
+			return nil, err
+		}
+		respBytes, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode == http.StatusOK {
+			at := &AuthToken{}
+			err = json.Unmarshal(respBytes, at)
+			if err != nil {
+				return nil, err
+			}
+			at.CalcExpiresDate()
+			return at, nil
+		}
+
+		tokenErr := tokenError{}
+		_ = json.Unmarshal(respBytes, &tokenErr)
+		switch tokenErr.Error {
+		case "authorization_pending":
+		case "slow_down":
+			interval += 5 * time.Second
+		case "access_denied":
+			return nil, errors.New("the authorization was denied.")
+		case "expired_token":
+			return nil, errors.New("the device code expired. Run `glab auth login --device` again.")
+		default:
+			return nil, fmt.Errorf("failed to request the access token: %s", tokenErrorMessage(resp.Status, respBytes))
+		}
+	}
+}
+
+func tokenErrorMessage(status string, body []byte) string {
+	tokenErr := tokenError{}
+	if err := json.Unmarshal(body, &tokenErr); err != nil || tokenErr.Error == "" {
+		return fmt.Sprintf("%s: %s", status, strings.TrimSpace(string(body)))
+	}
+	if tokenErr.ErrorDescription != "" {
+		return fmt.Sprintf("%s: %s", tokenErr.Error, tokenErr.ErrorDescription)
+	}
+	return tokenErr.Error
+}
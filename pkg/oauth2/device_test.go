@@ -0,0 +1,123 @@
+package oauth2
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+// deviceServer is a stand-in OAuth server for the device flow, answering the token
+// requests with the responses, in order.
+func deviceServer(t *testing.T, responses ...string) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "321", r.PostForm.Get("client_id"))
+
+		switch r.URL.Path {
+		case "/oauth/authorize_device":
+			assert.Equal(t, "openid profile read_user write_repository api", r.PostForm.Get("scope"))
+			_, _ = w.Write([]byte(`{
+				"device_code": "dc",
+				"user_code": "ABCD-EFGH",
+				"verification_uri": "https://gitlab.example.com/oauth/device",
+				"expires_in": 300,
+				"interval": 2
+			}`))
+		case "/oauth/token":
+			assert.Equal(t, deviceCodeGrantType, r.PostForm.Get("grant_type"))
+			assert.Equal(t, "dc", r.PostForm.Get("device_code"))
+			require.NotEmpty(t, responses, "unexpected token request")
+			response := responses[0]
+			responses = responses[1:]
+			if !strings.Contains(response, "access_token") {
+				w.WriteHeader(http.StatusBadRequest)
+			}
+			_, _ = w.Write([]byte(response))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+}
+
+func TestStartDeviceFlow(t *testing.T) {
+	tests := []struct {
+		name      string
+		responses []string
+		wantSleep []time.Duration
+		wantErr   string
+	}{
+		{
+			name: "authorized",
+			responses: []string{
+				`{"error": "authorization_pending"}`,
+				`{"error": "slow_down"}`,
+				`{"error": "authorization_pending"}`,
+				`{"access_token": "at", "refresh_token": "rt", "expires_in": 7200}`,
+			},
+			wantSleep: []time.Duration{2 * time.Second, 2 * time.Second, 7 * time.Second, 7 * time.Second},
+		},
+		{
+			name:      "denied",
+			responses: []string{`{"error": "access_denied"}`},
+			wantSleep: []time.Duration{2 * time.Second},
+			wantErr:   "the authorization was denied.",
+		},
+		{
+			name:      "expired",
+			responses: []string{`{"error": "expired_token"}`},
+			wantSleep: []time.Duration{2 * time.Second},
+			wantErr:   "the device code expired. Run `glab auth login --device` again.",
+		},
+		{
+			name:      "invalid client",
+			responses: []string{`{"error": "invalid_client", "error_description": "Client authentication failed"}`},
+			wantSleep: []time.Duration{2 * time.Second},
+			wantErr:   "failed to request the access token: invalid_client: Client authentication failed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			svr := deviceServer(t, tc.responses...)
+			defer svr.Close()
+
+			var slept []time.Duration
+			origSleep := sleep
+			sleep = func(d time.Duration) { slept = append(slept, d) }
+			defer func() { sleep = origSleep }()
+
+			hostname := strings.Split(svr.URL, "://")[1]
+			cfg := stubConfig{
+				hosts: map[string]map[string]string{
+					hostname: {"client_id": "321"},
+				},
+			}
+
+			ios, _, _, stderr := iostreams.Test()
+			token, err := StartDeviceFlow(cfg, ios, hostname, "http")
+			assert.Equal(t, tc.wantSleep, slept)
+			assert.Contains(t, stderr.String(), "copy your one-time code: ABCD-EFGH")
+			assert.Contains(t, stderr.String(), "https://gitlab.example.com/oauth/device")
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, "at", token)
+			assert.Equal(t, "at", cfg.hosts[hostname]["token"])
+			assert.Equal(t, "rt", cfg.hosts[hostname]["oauth2_refresh_token"])
+			assert.Equal(t, "true", cfg.hosts[hostname]["is_oauth2"])
+			assert.NotEmpty(t, cfg.hosts[hostname]["oauth2_expiry_date"])
+		})
+	}
+}
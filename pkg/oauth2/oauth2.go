@@ -8,7 +8,6 @@ import (
 	"io"
 	"net/http"
 	"net/url"
-	"time"
 
 	"gitlab.com/gitlab-org/cli/internal/config"
 	"gitlab.com/gitlab-org/cli/pkg/glinstance"
@@ -151,20 +150,26 @@ func requestToken(hostname, protocol, clientID, code, codeVerifier string) (*Aut
 	return &at, nil
 }
 
+// RefreshToken refreshes the OAuth token of a host when it expires soon, and saves it.
 func RefreshToken(hostname string, cfg config.Config, protocol string) error {
 	token, err := tokenFromConfig(hostname, cfg)
 	if err != nil {
 		return err
 	}
 
-	// Check if token has expired
-	if token.ExpiryDate.After(time.Now()) {
+	if !token.ExpiresSoon() {
 		return nil
 	}
 
+	_, err = refreshToken(hostname, cfg, protocol, token)
+	return err
+}
+
+// refreshToken exchanges the refresh token for a new token, and saves it.
+func refreshToken(hostname string, cfg config.Config, protocol string, token *AuthToken) (*AuthToken, error) {
 	clientID, err := oAuthClientID(cfg, hostname)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	form := url.Values{
@@ -178,32 +183,38 @@ func RefreshToken(hostname string, cfg config.Config, protocol string) error {
 	tokenURL := fmt.Sprintf("%s://%s/oauth/token", protocol, hostname)
 	resp, err := http.PostForm(tokenURL, form)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	defer resp.Body.Close()
 
 	respBytes, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to refresh the OAuth token: %s", tokenErrorMessage(resp.Status, respBytes))
 	}
 
 	at := AuthToken{}
 
 	err = json.Unmarshal(respBytes, &at)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	at.CalcExpiresDate()
+	at.CodeVerifier = token.CodeVerifier
 
 	err = at.SetConfig(hostname, cfg)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	err = cfg.Write()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return &at, nil
 }
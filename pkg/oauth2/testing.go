@@ -20,9 +20,20 @@ func (s stubConfig) Set(host string, key string, value string) error {
 	return nil
 }
 
-func (s stubConfig) UnsetHost(string)                      {}
-func (s stubConfig) Hosts() ([]string, error)              { return nil, nil }
-func (s stubConfig) Aliases() (*config.AliasConfig, error) { return nil, nil }
-func (s stubConfig) Local() (*config.LocalConfig, error)   { return nil, nil }
-func (s stubConfig) Write() error                          { return nil }
-func (s stubConfig) WriteAll() error                       { return nil }
+func (s stubConfig) Unset(string, string) error                             { return nil }
+func (s stubConfig) UnsetHost(string)                                       {}
+func (s stubConfig) Hosts() ([]string, error)                               { return nil, nil }
+func (s stubConfig) Aliases() (*config.AliasConfig, error)                  { return nil, nil }
+func (s stubConfig) Local() (*config.LocalConfig, error)                    { return nil, nil }
+func (s stubConfig) Account(string) string                                  { return "" }
+func (s stubConfig) UseAccount(string)                                      {}
+func (s stubConfig) AccountOverride() string                                { return "" }
+func (s stubConfig) ForAccount(string) config.Config                        { return s }
+func (s stubConfig) Accounts(string) ([]string, error)                      { return nil, nil }
+func (s stubConfig) AddAccount(string, string) error                        { return nil }
+func (s stubConfig) SwitchAccount(string, string) error                     { return nil }
+func (s stubConfig) RemoveAccount(string, string) error                     { return nil }
+func (s stubConfig) CredentialStore(string) (config.CredentialStore, error) { return nil, nil }
+func (s stubConfig) MigrateCredentials(string, string) (int, error)         { return 0, nil }
+func (s stubConfig) Write() error                                           { return nil }
+func (s stubConfig) WriteAll() error                                        { return nil }
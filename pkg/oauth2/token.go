@@ -14,20 +14,33 @@ type AuthToken struct {
 	CodeVerifier string    `json:"code_verifier"`
 }
 
+// expiryLeeway is how long before its expiry date a token is refreshed, so it doesn't
+// expire during a request.
+const expiryLeeway = time.Minute
+
 func (t *AuthToken) CalcExpiresDate() {
 	t.ExpiryDate = time.Now().Add(time.Second * time.Duration(t.ExpiresIn))
 }
 
-func tokenFromConfig(hostname string, cfg config.Config) (*AuthToken, error) {
-	result := &AuthToken{}
-	var err error
+// ExpiresSoon returns true if the token expired, or expires in less than a minute.
+func (t *AuthToken) ExpiresSoon() bool {
+	return time.Now().Add(expiryLeeway).After(t.ExpiryDate)
+}
 
+// ExpiryDate returns the expiry date of the OAuth token of a host.
+func ExpiryDate(hostname string, cfg config.Config) (time.Time, error) {
 	expiryDateString, err := cfg.Get(hostname, "oauth2_expiry_date")
 	if err != nil {
-		return nil, err
+		return time.Time{}, err
 	}
+	return time.Parse(time.RFC822, expiryDateString)
+}
+
+func tokenFromConfig(hostname string, cfg config.Config) (*AuthToken, error) {
+	result := &AuthToken{}
+	var err error
 
-	result.ExpiryDate, err = time.Parse(time.RFC822, expiryDateString)
+	result.ExpiryDate, err = ExpiryDate(hostname, cfg)
 	if err != nil {
 		return nil, err
 	}
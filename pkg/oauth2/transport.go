@@ -0,0 +1,127 @@
+package oauth2
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"gitlab.com/gitlab-org/cli/internal/config"
+)
+
+// Transport is an http.RoundTripper that authenticates the requests with the OAuth token of
+// a host. It refreshes the token before it expires, so long-running commands keep working,
+// and refreshes it once more when a request fails with 401 Unauthorized. Only the requests to
+// the host and to its API host are authenticated.
+type Transport struct {
+	Base     http.RoundTripper
+	Hostname string
+	APIHost  string
+	Protocol string
+	Config   config.Config
+
+	mu    sync.Mutex
+	token *AuthToken
+}
+
+// NewTransport returns a Transport for the OAuth token of the host saved in the config.
+func NewTransport(base http.RoundTripper, hostname, protocol string, cfg config.Config) (*Transport, error) {
+	token, err := tokenFromConfig(hostname, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	apiHost, _ := cfg.Get(hostname, "api_host")
+
+	return &Transport{
+		Base:     base,
+		Hostname: hostname,
+		APIHost:  apiHost,
+		Protocol: protocol,
+		Config:   cfg,
+		token:    token,
+	}, nil
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// the token must not leak to other hosts, like the target of a redirect
+	if !t.isGitLabHost(req.URL) {
+		return t.base().RoundTrip(req)
+	}
+
+	accessToken, err := t.accessToken("")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.base().RoundTrip(withToken(req, accessToken))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	// a request with a body can be sent again only if the body can be read again
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return resp, nil
+	}
+
+	refreshed, err := t.accessToken(accessToken)
+	if err != nil || refreshed == accessToken {
+		return resp, nil
+	}
+
+	retry := withToken(req, refreshed)
+	if req.GetBody != nil {
+		retry.Body, err = req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+	}
+	resp.Body.Close()
+
+	return t.base().RoundTrip(retry)
+}
+
+// accessToken returns the current access token, refreshed first when it expires soon, or
+// when it is still the rejected token. Concurrent requests wait for a single refresh.
+func (t *Transport) accessToken(rejected string) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.token.ExpiresSoon() || (rejected != "" && t.token.AccessToken == rejected) {
+		token, err := refreshToken(t.Hostname, t.Config, t.Protocol, t.token)
+		if err != nil {
+			return "", err
+		}
+		t.token = token
+	}
+	return t.token.AccessToken, nil
+}
+
+// isGitLabHost reports whether a URL is on the host or on its API host. A host saved without
+// a port matches any port.
+func (t *Transport) isGitLabHost(u *url.URL) bool {
+	for _, host := range []string{t.Hostname, t.APIHost} {
+		if host == "" {
+			continue
+		}
+		if strings.EqualFold(u.Host, host) || (!strings.Contains(host, ":") && strings.EqualFold(u.Hostname(), host)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *Transport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+// withToken returns a copy of the request, authenticated with the access token.
+func withToken(req *http.Request, accessToken string) *http.Request {
+	r := req.Clone(req.Context())
+	r.Header.Del("PRIVATE-TOKEN")
+	r.Header.Set("Authorization", "Bearer "+accessToken)
+	return r
+}
@@ -0,0 +1,174 @@
+package oauth2
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// oauthServer is a stand-in GitLab instance: its token endpoint refreshes the token to
+// "new-token", and its API accepts only "new-token".
+func oauthServer(t *testing.T, refreshes *int32) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			atomic.AddInt32(refreshes, 1)
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "refresh_token", r.PostForm.Get("grant_type"))
+			assert.Equal(t, "old-refresh-token", r.PostForm.Get("refresh_token"))
+			_, _ = w.Write([]byte(`{"access_token": "new-token", "refresh_token": "new-refresh-token", "expires_in": 7200}`))
+		case "/api/v4/user":
+			if r.Header.Get("Authorization") != "Bearer new-token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			body, _ := io.ReadAll(r.Body)
+			_, _ = w.Write(body)
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+}
+
+func transportConfig(hostname string, expiry time.Time) stubConfig {
+	return stubConfig{
+		hosts: map[string]map[string]string{
+			hostname: {
+				"is_oauth2":            "true",
+				"token":                "old-token",
+				"oauth2_refresh_token": "old-refresh-token",
+				"oauth2_code_verifier": "123",
+				"oauth2_expiry_date":   expiry.Format(time.RFC822),
+				"client_id":            "321",
+			},
+		},
+	}
+}
+
+func TestTransport(t *testing.T) {
+	tests := []struct {
+		name   string
+		expiry time.Duration
+		body   string
+	}{
+		{
+			name:   "token expiring soon is refreshed before the request",
+			expiry: 30 * time.Second,
+		},
+		{
+			name:   "request is retried after a 401",
+			expiry: time.Hour,
+		},
+		{
+			name:   "request with a body is retried after a 401",
+			expiry: time.Hour,
+			body:   `{"name": "glab"}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var refreshes int32
+			svr := oauthServer(t, &refreshes)
+			defer svr.Close()
+
+			hostname := strings.Split(svr.URL, "://")[1]
+			cfg := transportConfig(hostname, time.Now().Add(tc.expiry))
+
+			transport, err := NewTransport(nil, hostname, "http", cfg)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, svr.URL+"/api/v4/user", strings.NewReader(tc.body))
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer old-token")
+
+			resp, err := (&http.Client{Transport: transport}).Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			body, _ := io.ReadAll(resp.Body)
+
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, tc.body, string(body))
+			assert.Equal(t, "Bearer old-token", req.Header.Get("Authorization"), "the request must not be modified")
+			assert.Equal(t, int32(1), refreshes)
+			assert.Equal(t, "new-token", cfg.hosts[hostname]["token"])
+			assert.Equal(t, "new-refresh-token", cfg.hosts[hostname]["oauth2_refresh_token"])
+		})
+	}
+}
+
+func TestTransport_concurrentRequests(t *testing.T) {
+	var refreshes int32
+	svr := oauthServer(t, &refreshes)
+	defer svr.Close()
+
+	hostname := strings.Split(svr.URL, "://")[1]
+	transport, err := NewTransport(nil, hostname, "http", transportConfig(hostname, time.Now().Add(time.Hour)))
+	require.NoError(t, err)
+	client := &http.Client{Transport: transport}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(svr.URL + "/api/v4/user")
+			if assert.NoError(t, err) {
+				resp.Body.Close()
+				assert.Equal(t, http.StatusOK, resp.StatusCode)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), refreshes)
+}
+
+func TestTransport_otherHost(t *testing.T) {
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+	}))
+	defer other.Close()
+
+	transport, err := NewTransport(nil, "gitlab.example.com", "https", transportConfig("gitlab.example.com", time.Now().Add(time.Hour)))
+	require.NoError(t, err)
+
+	resp, err := (&http.Client{Transport: transport}).Get(other.URL + "/archive.zip")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestTransport_isGitLabHost(t *testing.T) {
+	transport := &Transport{Hostname: "gitlab.example.com", APIHost: "api.example.com:8443"}
+
+	tests := []struct {
+		url  string
+		want bool
+	}{
+		{url: "https://gitlab.example.com/api/v4/user", want: true},
+		{url: "https://GitLab.example.com:443/api/v4/user", want: true},
+		{url: "https://api.example.com:8443/api/v4/user", want: true},
+		{url: "https://api.example.com/api/v4/user", want: false},
+		{url: "https://objects.example.com/artifacts.zip", want: false},
+		{url: "https://gitlab.example.com.evil.com/", want: false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.url, func(t *testing.T) {
+			u, err := url.Parse(tc.url)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, transport.isGitLabHost(u))
+		})
+	}
+}
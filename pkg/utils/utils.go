@@ -128,6 +128,19 @@ func FmtDuration(d time.Duration) string {
 	return fmt.Sprintf("%02dm %02ds", m, s)
 }
 
+// FormatSeconds formats a duration in hours and minutes, like "12h 30m".
+func FormatSeconds(seconds int) string {
+	hours := seconds / 3600
+	minutes := seconds % 3600 / 60
+	if hours == 0 {
+		return fmt.Sprintf("%dm", minutes)
+	}
+	if minutes == 0 {
+		return fmt.Sprintf("%dh", hours)
+	}
+	return fmt.Sprintf("%dh %dm", hours, minutes)
+}
+
 func Humanize(s string) string {
 	// Replaces - and _ with spaces.
 	replace := "_-"
@@ -254,3 +254,10 @@ func TestPtr(t *testing.T) {
 		require.Equal(t, Ptr(tt.val), &tt.val, "TestPtr() got = %s want = %s")
 	}
 }
+
+func TestFormatSeconds(t *testing.T) {
+	require.Equal(t, "0m", FormatSeconds(0))
+	require.Equal(t, "45m", FormatSeconds(2700))
+	require.Equal(t, "3h", FormatSeconds(10800))
+	require.Equal(t, "1h 30m", FormatSeconds(5400))
+}